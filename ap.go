@@ -213,6 +213,10 @@ func (ap *AP) F() bool { return ap.o.IsColMajor() && ap.o.IsContiguous() }
 
 // S returns the metadata of the sliced tensor.
 func (ap *AP) S(size int, slices ...Slice) (newAP AP, ndStart, ndEnd int, err error) {
+	var newAxes []int
+	if slices, newAxes, err = expandSlices(slices, len(ap.shape)); err != nil {
+		return
+	}
 	if len(slices) > len(ap.shape) {
 		// error
 		err = errors.Errorf(dimMismatch, len(ap.shape), len(slices))
@@ -224,6 +228,11 @@ func (ap *AP) S(size int, slices ...Slice) (newAP AP, ndStart, ndEnd int, err er
 	dims := ap.Dims()              // reported dimensions
 	newStrides := BorrowInts(dims) // the new strides
 
+	// first is the offset of the first element of the view from the first element of ap.
+	// It is only used when there are negative strides.
+	var first int
+	negative := ap.origin() > 0
+
 	var outerDim int
 	order := ap.o
 	if ap.o.IsRowMajor() || ap.IsVector() {
@@ -257,47 +266,94 @@ func (ap *AP) S(size int, slices ...Slice) (newAP AP, ndStart, ndEnd int, err er
 		// a slice where start == end is []
 		ndStart = ndStart + start*stride
 		ndEnd = ndEnd - (size-end)*stride
+		first += start * stride
 
-		if step > 0 {
-			if newShape[i] = (end - start) / step; (end-start)%step > 0 && i > 0 {
-				newShape[i]++
-			}
+		newShape[i] = sliceLen(start, end, step)
+		switch {
+		case step > 0:
 			newStrides[i] = stride * step
 
 			//fix
 			if newShape[i] <= 0 {
 				newShape[i] = 1
 			}
-		} else {
-			newShape[i] = (end - start)
+		case step < 0:
+			newStrides[i] = stride * step
+			negative = true
+		default:
 			newStrides[i] = stride
 		}
 
-		if (sl != nil && (!ap.IsVector() && i != outerDim)) || step > 1 {
+		if (sl != nil && (!ap.IsVector() && i != outerDim)) || step > 1 || step < 0 {
 			order = MakeDataOrder(order, NonContiguous)
 		}
 	}
 
+	if negative {
+		// the data of the view starts at the lowest index that it accesses, which is not its first element
+		// when some of its strides are negative. Both are counted from the start of the data of ap.
+		lo, hi := first, first
+		for i, stride := range newStrides {
+			if extent := (newShape[i] - 1) * stride; extent < 0 {
+				lo += extent
+			} else {
+				hi += extent
+			}
+		}
+		origin := ap.origin()
+		ndStart, ndEnd = origin+lo, origin+hi+1
+	}
+
+	var extra int
+	for _, n := range newAxes {
+		extra += n
+	}
+
 	if ndEnd-ndStart == 1 {
 		// scalars are a special case
 		newAP = AP{}
 		newAP.SetShape() // make it a Scalar
 		newAP.lock()
+		if extra > 0 {
+			shape, strides := make(Shape, extra), make([]int, extra)
+			for i := range shape {
+				shape[i], strides[i] = 1, 1
+			}
+			newAP = MakeAP(shape, strides, order, ap.Δ)
+		}
 	} else {
 
-		// drop any dimension with size 1, except the last dimension
-		offset := 0
-		for d := 0; d < dims; d++ {
-			if newShape[d] == 1 && offset+d <= len(slices)-1 && slices[offset+d] != nil /*&& d != t.dims-1  && dims > 2*/ {
-				newShape = append(newShape[:d], newShape[d+1:]...)
-				newStrides = append(newStrides[:d], newStrides[d+1:]...)
-				d--
-				dims--
-				offset++
+		// drop any dimension with size 1, except the last dimension, and insert the new axes
+		shape, strides := make(Shape, 0, dims+extra), make([]int, 0, dims+extra)
+		for d := 0; d <= dims; d++ {
+			for k := 0; newAxes != nil && k < newAxes[d]; k++ {
+				stride := 1
+				if d < dims {
+					stride = newStrides[d] * newShape[d]
+				}
+				shape = append(shape, 1)
+				strides = append(strides, stride)
+			}
+			if d == dims || newShape[d] == 1 && d <= len(slices)-1 && slices[d] != nil /*&& d != t.dims-1  && dims > 2*/ {
+				continue
 			}
+			shape = append(shape, newShape[d])
+			strides = append(strides, newStrides[d])
 		}
+		ReturnInts(newStrides)
 
-		newAP = MakeAP(newShape, newStrides, order, ap.Δ)
+		newAP = MakeAP(shape, strides, order, ap.Δ)
+	}
+	return
+}
+
+// origin returns the index of the first element (the one at the coordinates (0, ..., 0)) in the data.
+// It is 0 unless some of the strides are negative.
+func (ap *AP) origin() (retVal int) {
+	for i, stride := range ap.strides {
+		if stride < 0 && i < len(ap.shape) {
+			retVal -= (ap.shape[i] - 1) * stride
+		}
 	}
 	return
 }
@@ -0,0 +1,148 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// exported API for the logical and bitwise operations. The operands are overloaded the same way as the arithmetic ones.
+
+// And performs an elementwise logical and on Bool Tensors. These operations are supported:
+//
+//	And(*Dense, scalar)
+//	And(scalar, *Dense)
+//	And(*Dense, *Dense)
+//
+// If the Unsafe flag is passed in, the data of the first tensor will be overwritten
+func And(a, b interface{}, opts ...FuncOpt) (retVal Tensor, err error) {
+	return bitOp(a, b, "And", func(e Engine) (bitOpFns, bool) {
+		ander, ok := e.(Ander)
+		if !ok {
+			return bitOpFns{}, false
+		}
+		return bitOpFns{ander.And, ander.AndScalar}, true
+	}, opts...)
+}
+
+// Or performs an elementwise logical or on Bool Tensors. The operands are the same as And's.
+func Or(a, b interface{}, opts ...FuncOpt) (retVal Tensor, err error) {
+	return bitOp(a, b, "Or", func(e Engine) (bitOpFns, bool) {
+		orer, ok := e.(Orer)
+		if !ok {
+			return bitOpFns{}, false
+		}
+		return bitOpFns{orer.Or, orer.OrScalar}, true
+	}, opts...)
+}
+
+// Xor performs an elementwise logical exclusive or on Bool Tensors. The operands are the same as And's.
+func Xor(a, b interface{}, opts ...FuncOpt) (retVal Tensor, err error) {
+	return bitOp(a, b, "Xor", func(e Engine) (bitOpFns, bool) {
+		xorer, ok := e.(Xorer)
+		if !ok {
+			return bitOpFns{}, false
+		}
+		return bitOpFns{xorer.Xor, xorer.XorScalar}, true
+	}, opts...)
+}
+
+// BitAnd performs an elementwise bitwise and on integer Tensors. The operands are the same as And's.
+func BitAnd(a, b interface{}, opts ...FuncOpt) (retVal Tensor, err error) {
+	return bitOp(a, b, "BitAnd", func(e Engine) (bitOpFns, bool) {
+		bitAnder, ok := e.(BitAnder)
+		if !ok {
+			return bitOpFns{}, false
+		}
+		return bitOpFns{bitAnder.BitAnd, bitAnder.BitAndScalar}, true
+	}, opts...)
+}
+
+// BitOr performs an elementwise bitwise or on integer Tensors. The operands are the same as And's.
+func BitOr(a, b interface{}, opts ...FuncOpt) (retVal Tensor, err error) {
+	return bitOp(a, b, "BitOr", func(e Engine) (bitOpFns, bool) {
+		bitOrer, ok := e.(BitOrer)
+		if !ok {
+			return bitOpFns{}, false
+		}
+		return bitOpFns{bitOrer.BitOr, bitOrer.BitOrScalar}, true
+	}, opts...)
+}
+
+// BitXor performs an elementwise bitwise exclusive or on integer Tensors. The operands are the same as And's.
+func BitXor(a, b interface{}, opts ...FuncOpt) (retVal Tensor, err error) {
+	return bitOp(a, b, "BitXor", func(e Engine) (bitOpFns, bool) {
+		bitXorer, ok := e.(BitXorer)
+		if !ok {
+			return bitOpFns{}, false
+		}
+		return bitOpFns{bitXorer.BitXor, bitXorer.BitXorScalar}, true
+	}, opts...)
+}
+
+// Shl shifts the values of a to the left by the values of b, elementwise. The operands are integers, and are the same as And's.
+// Shifting a signed integer by a negative count is an error: the value is set to 0 and its index is reported in the returned error.
+func Shl(a, b interface{}, opts ...FuncOpt) (retVal Tensor, err error) {
+	return bitOp(a, b, "Shl", func(e Engine) (bitOpFns, bool) {
+		shler, ok := e.(Shler)
+		if !ok {
+			return bitOpFns{}, false
+		}
+		return bitOpFns{shler.Shl, shler.ShlScalar}, true
+	}, opts...)
+}
+
+// Shr shifts the values of a to the right by the values of b, elementwise. Signed integers are shifted arithmetically.
+// The operands are the same as Shl's.
+func Shr(a, b interface{}, opts ...FuncOpt) (retVal Tensor, err error) {
+	return bitOp(a, b, "Shr", func(e Engine) (bitOpFns, bool) {
+		shrer, ok := e.(Shrer)
+		if !ok {
+			return bitOpFns{}, false
+		}
+		return bitOpFns{shrer.Shr, shrer.ShrScalar}, true
+	}, opts...)
+}
+
+// bitOpFns are the tensor-tensor and tensor-scalar methods of an engine that performs a logical or bitwise op.
+type bitOpFns struct {
+	vv func(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+	vs func(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
+// bitOp dispatches the op to the engine of the first Tensor operand that supports it, following the semantics of Add.
+func bitOp(a, b interface{}, op string, fns func(Engine) (bitOpFns, bool), opts ...FuncOpt) (retVal Tensor, err error) {
+	var f bitOpFns
+	var ok bool
+	switch at := a.(type) {
+	case Tensor:
+		switch bt := b.(type) {
+		case Tensor:
+			if f, ok = fns(at.Engine()); !ok {
+				if f, ok = fns(bt.Engine()); !ok {
+					return nil, errors.Errorf("Neither engines of either operand support %v", op)
+				}
+			}
+			if !bt.Shape().IsScalar() && !at.Shape().IsScalar() {
+				return f.vv(at, bt, opts...)
+			}
+
+			// at least one of the operands is a scalar
+			if !bt.Shape().IsScalar() {
+				return f.vs(bt, at, false, opts...)
+			}
+			return f.vs(at, bt, true, opts...)
+		default:
+			if f, ok = fns(at.Engine()); !ok {
+				return nil, errors.Errorf("Operand A's engine does not support %v", op)
+			}
+			return f.vs(at, bt, true, opts...)
+		}
+	default:
+		switch bt := b.(type) {
+		case Tensor:
+			if f, ok = fns(bt.Engine()); !ok {
+				return nil, errors.Errorf("Operand B's engine does not support %v", op)
+			}
+			return f.vs(bt, at, false, opts...)
+		default:
+			return nil, errors.Errorf("Cannot perform %v of %T and %T", op, a, b)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package tensor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogicalOps(t *testing.T) {
+	assert := assert.New(t)
+	a := New(WithShape(2, 2), WithBacking([]bool{true, true, false, false}))
+	b := New(WithShape(2, 2), WithBacking([]bool{true, false, true, false}))
+
+	r, err := And(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, false, false, false}, r.Data())
+	r, err = Or(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, true, true, false}, r.Data())
+	r, err = Xor(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{false, true, true, false}, r.Data())
+	assert.Equal([]bool{true, true, false, false}, a.Data())
+
+	// scalars on either side
+	r, err = And(a, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{false, false, false, false}, r.Data())
+	r, err = Xor(true, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{false, true, false, true}, r.Data())
+	r, err = Or(New(FromScalar(true)), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, true, true, true}, r.Data())
+
+	// reuse and unsafe
+	reuse := New(WithShape(2, 2), Of(Bool))
+	r, err = Or(a, b, WithReuse(reuse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(r == reuse)
+	assert.Equal([]bool{true, true, true, false}, reuse.Data())
+	r, err = Xor(a, b, UseUnsafe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(r == a)
+	assert.Equal([]bool{false, true, true, false}, a.Data())
+
+	r, err = Not(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{false, true, false, true}, r.Data())
+
+	_, err = And(New(WithShape(2), Of(Int)), New(WithShape(2), Of(Int)))
+	assert.NotNil(err)
+	_, err = And(a, b, WithIncr(reuse))
+	assert.NotNil(err)
+	_, err = Not(New(WithShape(2), Of(Int)))
+	assert.NotNil(err)
+}
+
+func TestBitwiseOps(t *testing.T) {
+	assert := assert.New(t)
+	a := New(WithShape(4), WithBacking([]uint8{0xf0, 0x0f, 0xff, 0x00}))
+	b := New(WithShape(4), WithBacking([]uint8{0x3c, 0x3c, 0x3c, 0x3c}))
+
+	r, err := BitAnd(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]uint8{0x30, 0x0c, 0x3c, 0x00}, r.Data())
+	r, err = BitOr(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]uint8{0xfc, 0x3f, 0xff, 0x3c}, r.Data())
+	r, err = BitXor(a, uint8(0xff))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]uint8{0x0f, 0xf0, 0x00, 0xff}, r.Data())
+	r, err = BitNot(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]uint8{0x0f, 0xf0, 0x00, 0xff}, r.Data())
+
+	// shifts. Signed values are shifted arithmetically, and negative counts are errors
+	s := New(WithShape(4), WithBacking([]int{-8, 1, 3, 16}))
+	r, err = Shr(s, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{-4, 0, 1, 8}, r.Data())
+	r, err = Shl(1, s)
+	if err == nil {
+		t.Fatal("Expected an error shifting by a negative count")
+	}
+	if me, ok := err.(MathError); assert.True(ok) {
+		assert.Equal([]int{0}, me.Indices())
+	}
+	assert.Equal([]int{0, 2, 8, 65536}, r.Data())
+
+	// views are iterated
+	m := New(WithShape(2, 3), WithBacking([]int32{1, 2, 3, 4, 5, 6}))
+	v, err := m.Slice(nil, S(1, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = Shl(v, New(WithShape(2, 2), WithBacking([]int32{1, 2, 3, 4})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []int32{4, 12, 40, 96} {
+		got, _ := r.At(i/2, i%2)
+		assert.Equal(want, got)
+	}
+
+	_, err = BitAnd(New(WithShape(2), Of(Float64)), New(WithShape(2), Of(Float64)))
+	assert.NotNil(err)
+	_, err = Shl(a, New(WithShape(4), Of(Int8)))
+	assert.NotNil(err)
+}
+
+func TestAnyAll(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking([]bool{true, false, true, true, true, true}))
+
+	r, err := Any(T, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, true, true}, r.Data())
+	r, err = All(T, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{false, true}, r.Data())
+	r, err = All(T, -2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, false, true}, r.Data())
+
+	// all the axes
+	r, err = All(T)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(false, r.Data())
+	r, err = Any(T, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(true, r.Data())
+
+	// a view
+	v, err := T.Slice(nil, S(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = Any(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(true, r.Data())
+	r, err = All(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(false, r.Data())
+
+	_, err = Any(T, 2)
+	assert.NotNil(err)
+	_, err = All(New(WithShape(2), Of(Int)))
+	assert.NotNil(err)
+}
@@ -0,0 +1,27 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// IsClose compares the values of two float or complex tensors of the same shape and Dtype up to a tolerance, and returns a Bool tensor.
+// Like Numpy, a value of a is close to the value of b when
+//
+//	|a - b| <= atol + rtol * |b|
+//
+// Infinities are only close to the same infinity. NaNs are never close, unless equalNaN is true, in which case NaNs are close to each other.
+func IsClose(a, b Tensor, rtol, atol float64, equalNaN bool) (retVal Tensor, err error) {
+	if c, ok := a.Engine().(IsCloser); ok {
+		return c.IsClose(a, b, rtol, atol, equalNaN)
+	}
+	return nil, errors.Errorf("Unable to perform IsClose. Engine %T does not support that.", a.Engine())
+}
+
+// AllClose checks that all the values of a and b are close, as defined by IsClose.
+//
+// When they are not, the returned error describes the worst mismatch - its coordinates, the values, and how far apart they are.
+// The error is a MathError, which lists the indices of all the values that are not close.
+func AllClose(a, b Tensor, rtol, atol float64, equalNaN bool) (bool, error) {
+	if c, ok := a.Engine().(IsCloser); ok {
+		return c.AllClose(a, b, rtol, atol, equalNaN)
+	}
+	return false, errors.Errorf("Unable to perform AllClose. Engine %T does not support that.", a.Engine())
+}
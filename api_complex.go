@@ -0,0 +1,55 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// Real returns the real parts of the values of a complex tensor.
+// Complex64 tensors give Float32 tensors, and Complex128 tensors give Float64 tensors. Masks are kept.
+func Real(t Tensor) (retVal Tensor, err error) {
+	if c, ok := t.Engine().(Complexer); ok {
+		return c.Real(t)
+	}
+	return nil, errors.Errorf("Unable to perform Real. Engine %T does not support that.", t.Engine())
+}
+
+// Imag returns the imaginary parts of the values of a complex tensor, with the same Dtypes as Real.
+func Imag(t Tensor) (retVal Tensor, err error) {
+	if c, ok := t.Engine().(Complexer); ok {
+		return c.Imag(t)
+	}
+	return nil, errors.Errorf("Unable to perform Imag. Engine %T does not support that.", t.Engine())
+}
+
+// Conj returns a new tensor with the complex conjugates of the values of a complex tensor.
+// To conjugate and transpose a matrix without copying its data, use (*Dense).H.
+func Conj(t Tensor) (retVal Tensor, err error) {
+	if c, ok := t.Engine().(Complexer); ok {
+		return c.Conj(t)
+	}
+	return nil, errors.Errorf("Unable to perform Conj. Engine %T does not support that.", t.Engine())
+}
+
+// Angle returns the arguments of the values of a complex tensor, in radians in [-π, π], with the same Dtypes as Real.
+func Angle(t Tensor) (retVal Tensor, err error) {
+	if c, ok := t.Engine().(Complexer); ok {
+		return c.Angle(t)
+	}
+	return nil, errors.Errorf("Unable to perform Angle. Engine %T does not support that.", t.Engine())
+}
+
+// Magnitude returns the absolute values of the values of a complex tensor, with the same Dtypes as Real.
+// Unlike Abs, the result is a real valued tensor.
+func Magnitude(t Tensor) (retVal Tensor, err error) {
+	if c, ok := t.Engine().(Complexer); ok {
+		return c.Magnitude(t)
+	}
+	return nil, errors.Errorf("Unable to perform Magnitude. Engine %T does not support that.", t.Engine())
+}
+
+// Complex builds a complex tensor from two float tensors of the same shape and Dtype, holding the real and imaginary parts of its values.
+// Float32 tensors give a Complex64 tensor, and Float64 tensors give a Complex128 tensor. A value is masked if either of its parts is.
+func Complex(re, im Tensor) (retVal Tensor, err error) {
+	if c, ok := re.Engine().(Complexer); ok {
+		return c.Complex(re, im)
+	}
+	return nil, errors.Errorf("Unable to perform Complex. Engine %T does not support that.", re.Engine())
+}
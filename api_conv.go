@@ -0,0 +1,149 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// ImageLayout describes how a batch of images is laid out in a 4-D tensor.
+type ImageLayout byte
+
+const (
+	// NCHW is (batch, channels, height, width). This is the default layout.
+	NCHW ImageLayout = iota
+	// NHWC is (batch, height, width, channels).
+	NHWC
+)
+
+func (l ImageLayout) String() string {
+	switch l {
+	case NCHW:
+		return "NCHW"
+	case NHWC:
+		return "NHWC"
+	}
+	return "UnknownLayout"
+}
+
+// Window2D describes a window sliding across the two spatial dimensions of a batch of images.
+// A zero Stride or Dilation is treated as 1.
+type Window2D struct {
+	KernelH, KernelW     int
+	StrideH, StrideW     int
+	PadH, PadW           int
+	DilationH, DilationW int
+	Layout               ImageLayout
+}
+
+// Window1D describes a window sliding across a batch of sequences.
+// NCHW is interpreted as (batch, channels, length) and NHWC as (batch, length, channels).
+// A zero Stride or Dilation is treated as 1.
+type Window1D struct {
+	Kernel, Stride, Pad, Dilation int
+	Layout                        ImageLayout
+}
+
+// norm returns a copy of the window with the defaults filled in.
+func (w Window2D) norm() Window2D {
+	if w.StrideH == 0 {
+		w.StrideH = 1
+	}
+	if w.StrideW == 0 {
+		w.StrideW = 1
+	}
+	if w.DilationH == 0 {
+		w.DilationH = 1
+	}
+	if w.DilationW == 0 {
+		w.DilationW = 1
+	}
+	return w
+}
+
+func (w Window2D) check() error {
+	if w.KernelH <= 0 || w.KernelW <= 0 {
+		return errors.Errorf("Expected a positive kernel size. Got (%d, %d)", w.KernelH, w.KernelW)
+	}
+	if w.StrideH < 0 || w.StrideW < 0 || w.DilationH < 0 || w.DilationW < 0 || w.PadH < 0 || w.PadW < 0 {
+		return errors.Errorf("Stride, padding and dilation cannot be negative. Got %+v", w)
+	}
+	if w.Layout != NCHW && w.Layout != NHWC {
+		return errors.Errorf("Unknown layout %v", w.Layout)
+	}
+	return nil
+}
+
+// outputSize returns the height and width of the result of sliding the window over an image of h × w.
+// It returns an error if the dilated window does not fit in the padded image.
+func (w Window2D) outputSize(h, wd int) (oh, ow int, err error) {
+	w = w.norm()
+	spanH := h + 2*w.PadH - w.DilationH*(w.KernelH-1) - 1
+	spanW := wd + 2*w.PadW - w.DilationW*(w.KernelW-1) - 1
+	if spanH < 0 || spanW < 0 {
+		return 0, 0, errors.Errorf("Window %+v is larger than the padded image of %d×%d", w, h, wd)
+	}
+	return spanH/w.StrideH + 1, spanW/w.StrideW + 1, nil
+}
+
+// to2D expresses a 1-D window as a 2-D window over images of height 1.
+func (w Window1D) to2D() Window2D {
+	return Window2D{
+		KernelH: 1, KernelW: w.Kernel,
+		StrideH: 1, StrideW: w.Stride,
+		PadH: 0, PadW: w.Pad,
+		DilationH: 1, DilationW: w.Dilation,
+		Layout: w.Layout,
+	}
+}
+
+// Im2Col unrolls every window position of a batch of images into columns, such that a convolution can be expressed as a matrix multiplication.
+//
+// For a NCHW input of (N, C, H, W) the result has shape (N, C×KernelH×KernelW, OutH×OutW).
+// For a NHWC input of (N, H, W, C) the result has shape (N, OutH×OutW, KernelH×KernelW×C).
+// Padded positions are filled with zeroes.
+func Im2Col(im Tensor, w Window2D, opts ...FuncOpt) (retVal Tensor, err error) {
+	if ic, ok := im.Engine().(Im2Coler); ok {
+		return ic.Im2Col(im, w, opts...)
+	}
+	return nil, errors.Errorf("Unable to perform Im2Col. Engine %T does not support that.", im.Engine())
+}
+
+// Col2Im is the adjoint of Im2Col. It folds the columns back into a batch of images of the given shape, summing up values that come from overlapping windows.
+func Col2Im(col Tensor, imShape Shape, w Window2D, opts ...FuncOpt) (retVal Tensor, err error) {
+	if ic, ok := col.Engine().(Im2Coler); ok {
+		return ic.Col2Im(col, imShape, w, opts...)
+	}
+	return nil, errors.Errorf("Unable to perform Col2Im. Engine %T does not support that.", col.Engine())
+}
+
+// Conv2D performs a 2D convolution (strictly speaking, a cross-correlation) of x with the given filter.
+// The kernel size of the window is inferred from the filter, whose shape is expected to be
+// (OutChannels, InChannels, KernelH, KernelW) for NCHW, and (OutChannels, KernelH, KernelW, InChannels) for NHWC.
+func Conv2D(x, filter Tensor, w Window2D) (retVal Tensor, err error) {
+	if c, ok := x.Engine().(Convolver); ok {
+		return c.Conv2D(x, filter, w)
+	}
+	return nil, errors.Errorf("Unable to perform Conv2D. Engine %T does not support that.", x.Engine())
+}
+
+// Conv2DB computes the gradients of the input and the filter of Conv2D, given the gradient of its output.
+func Conv2DB(x, filter, grad Tensor, w Window2D) (gradX, gradFilter Tensor, err error) {
+	if c, ok := x.Engine().(Convolver); ok {
+		return c.Conv2DB(x, filter, grad, w)
+	}
+	return nil, nil, errors.Errorf("Unable to perform Conv2DB. Engine %T does not support that.", x.Engine())
+}
+
+// Conv1D performs a 1D convolution of x with the given filter.
+// The filter's shape is expected to be (OutChannels, InChannels, Kernel) for NCHW, and (OutChannels, Kernel, InChannels) for NHWC.
+func Conv1D(x, filter Tensor, w Window1D) (retVal Tensor, err error) {
+	if c, ok := x.Engine().(Convolver); ok {
+		return c.Conv1D(x, filter, w)
+	}
+	return nil, errors.Errorf("Unable to perform Conv1D. Engine %T does not support that.", x.Engine())
+}
+
+// Conv1DB computes the gradients of the input and the filter of Conv1D, given the gradient of its output.
+func Conv1DB(x, filter, grad Tensor, w Window1D) (gradX, gradFilter Tensor, err error) {
+	if c, ok := x.Engine().(Convolver); ok {
+		return c.Conv1DB(x, filter, grad, w)
+	}
+	return nil, nil, errors.Errorf("Unable to perform Conv1DB. Engine %T does not support that.", x.Engine())
+}
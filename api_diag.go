@@ -0,0 +1,48 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// Triu returns a copy of a matrix, or of a batch of matrices along the last two axes, with the values below the k-th diagonal set to zero.
+// k = 0 is the main diagonal, k > 0 is above it and k < 0 below it. Matrices that end up upper triangular are marked as such.
+func Triu(t Tensor, k int) (retVal Tensor, err error) {
+	if tr, ok := t.Engine().(Triangler); ok {
+		return tr.Triu(t, k)
+	}
+	return nil, errors.Errorf("Unable to perform Triu. Engine %T does not support that.", t.Engine())
+}
+
+// Tril returns a copy of a matrix, or of a batch of matrices along the last two axes, with the values above the k-th diagonal set to zero.
+// k = 0 is the main diagonal, k > 0 is above it and k < 0 below it. Matrices that end up lower triangular are marked as such.
+func Tril(t Tensor, k int) (retVal Tensor, err error) {
+	if tr, ok := t.Engine().(Triangler); ok {
+		return tr.Tril(t, k)
+	}
+	return nil, errors.Errorf("Unable to perform Tril. Engine %T does not support that.", t.Engine())
+}
+
+// Diagonal returns the offset-th diagonals of the planes spanned by axis1 and axis2, in a new last axis.
+// For a *Dense, the result is a strided view of the input. See (*Dense).Diagonal.
+func Diagonal(t Tensor, offset, axis1, axis2 int) (retVal Tensor, err error) {
+	switch tt := t.(type) {
+	case *Dense:
+		return tt.Diagonal(offset, axis1, axis2)
+	}
+	return nil, errors.Errorf(typeNYI, "Diagonal", t)
+}
+
+// DiagEmbed is the opposite of Diagonal: it builds square matrices, zero everywhere but on their offset-th diagonal,
+// which holds the values along the last axis of t. A t of shape (..., n) gives a result of shape (..., n+|offset|, n+|offset|).
+func DiagEmbed(t Tensor, offset int) (retVal Tensor, err error) {
+	if d, ok := t.Engine().(DiagEmbedder); ok {
+		return d.DiagEmbed(t, offset)
+	}
+	return nil, errors.Errorf("Unable to perform DiagEmbed. Engine %T does not support that.", t.Engine())
+}
+
+// Eye creates a n×n matrix with ones on its k-th diagonal and zeroes elsewhere, marked with its Triangle.
+// See I for non square matrices.
+func Eye(dt Dtype, n, k int) *Dense {
+	retVal := I(dt, n, n, k)
+	retVal.Δ = diagTriangle(k)
+	return retVal
+}
@@ -0,0 +1,35 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// Diff computes the n-th discrete difference of t along the axis, like Numpy's diff. The first difference is
+//
+//	out[i] = t[i+1] - t[i]
+//
+// and the higher ones are computed by taking the difference of the previous one, so the axis shrinks by n. The differences of Bool tensors are computed with Xor.
+//
+// pre and post are concatenated before and after t along the axis before the differences are taken, and may be nil.
+// They must have the shape of t except along the axis, or be scalars, which are taken to be of size 1 along the axis.
+//
+// t may be a view. When n is 0, t is returned as is.
+func Diff(t Tensor, n, axis int, pre, post Tensor) (retVal Tensor, err error) {
+	if d, ok := t.Engine().(Differ); ok {
+		return d.Diff(t, n, axis, pre, post)
+	}
+	return nil, errors.Errorf("Unable to perform Diff. Engine %T does not support that.", t.Engine())
+}
+
+// Gradient computes the gradients of a float tensor along the axes, like Numpy's gradient. nil axes stand for all of the axes, and one tensor is returned per axis.
+// The gradients are computed with central differences in the interior and with one-sided differences at the edges:
+//
+//	out[i] = (t[i+1] - t[i-1]) / 2h
+//	out[0] = (t[1] - t[0]) / h
+//	out[n-1] = (t[n-1] - t[n-2]) / h
+//
+// The spacing h between the values is 1 if spacing is empty, the same for every axis if it has a single value, or else given per axis.
+func Gradient(t Tensor, spacing []float64, axes ...int) (retVal []Tensor, err error) {
+	if d, ok := t.Engine().(Differ); ok {
+		return d.Gradient(t, spacing, axes...)
+	}
+	return nil, errors.Errorf("Unable to perform Gradient. Engine %T does not support that.", t.Engine())
+}
@@ -0,0 +1,95 @@
+package tensor
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+type distanceKind byte
+
+const (
+	euclideanDist distanceKind = iota
+	sqEuclideanDist
+	manhattanDist
+	chebyshevDist
+	minkowskiDist
+)
+
+// DistanceMetric describes how Cdist measures the distance between two vectors. Use the constructors to create one.
+type DistanceMetric struct {
+	kind distanceKind
+	p    float64
+}
+
+// Euclidean is the L2 distance between two vectors: sqrt(Σ(x-y)²).
+func Euclidean() DistanceMetric { return DistanceMetric{kind: euclideanDist} }
+
+// SqEuclidean is the squared Euclidean distance: Σ(x-y)².
+func SqEuclidean() DistanceMetric { return DistanceMetric{kind: sqEuclideanDist} }
+
+// Manhattan is the L1 distance between two vectors: Σ|x-y|.
+func Manhattan() DistanceMetric { return DistanceMetric{kind: manhattanDist} }
+
+// Chebyshev is the L∞ distance between two vectors: max|x-y|.
+func Chebyshev() DistanceMetric { return DistanceMetric{kind: chebyshevDist} }
+
+// Minkowski is the Lp distance between two vectors: (Σ|x-y|ᵖ)^(1/p). p has to be positive.
+// Minkowski(1), Minkowski(2) and Minkowski(+Inf) are the Manhattan, Euclidean and Chebyshev distances.
+func Minkowski(p float64) DistanceMetric {
+	switch {
+	case p == 1:
+		return Manhattan()
+	case p == 2:
+		return Euclidean()
+	case math.IsInf(p, 1):
+		return Chebyshev()
+	}
+	return DistanceMetric{kind: minkowskiDist, p: p}
+}
+
+func (m DistanceMetric) check() error {
+	if m.kind > minkowskiDist || (m.kind == minkowskiDist && !(m.p > 0)) {
+		return errors.Errorf("Invalid distance metric %v", m)
+	}
+	return nil
+}
+
+func (m DistanceMetric) String() string {
+	switch m.kind {
+	case euclideanDist:
+		return "Euclidean"
+	case sqEuclideanDist:
+		return "SqEuclidean"
+	case manhattanDist:
+		return "Manhattan"
+	case chebyshevDist:
+		return "Chebyshev"
+	case minkowskiDist:
+		return fmt.Sprintf("Minkowski(%v)", m.p)
+	}
+	return "UnknownMetric"
+}
+
+// Cdist computes the distances between every row of a and every row of b.
+// a has shape (..., m, d) and b has shape (..., n, d), with the same leading batch axes, and the result has shape (..., m, n).
+//
+// For the Euclidean and squared Euclidean distances of larger inputs, the distances are expanded as ‖x‖² + ‖y‖² - 2x·y,
+// so that the bulk of the work is a single matrix multiplication. This is less accurate for points that are very close to each other.
+func Cdist(a, b Tensor, metric DistanceMetric) (retVal Tensor, err error) {
+	if d, ok := a.Engine().(Distancer); ok {
+		return d.Cdist(a, b, metric)
+	}
+	return nil, errors.Errorf("Unable to perform Cdist. Engine %T does not support that.", a.Engine())
+}
+
+// CosineSimilarity computes x·y / (max(‖x‖, ε) max(‖y‖, ε)) along the given axis, with ε = 1e-8.
+// a and b are broadcast against each other, so that for instance a of shape (m, 1, d) and b of shape (n, d)
+// give the (m, n) matrix of the similarities between their rows.
+func CosineSimilarity(a, b Tensor, axis int) (retVal Tensor, err error) {
+	if d, ok := a.Engine().(Distancer); ok {
+		return d.CosineSimilarity(a, b, axis)
+	}
+	return nil, errors.Errorf("Unable to perform CosineSimilarity. Engine %T does not support that.", a.Engine())
+}
@@ -0,0 +1,95 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// FFTNorm describes how the discrete Fourier transforms are scaled. The modes are the same as Numpy's.
+type FFTNorm byte
+
+const (
+	// FFTNormBackward leaves the forward transforms unscaled and scales the inverse transforms by 1/n. This is the default.
+	FFTNormBackward FFTNorm = iota
+	// FFTNormOrtho scales both directions by 1/√n, which makes the transforms unitary.
+	FFTNormOrtho
+	// FFTNormForward scales the forward transforms by 1/n and leaves the inverse transforms unscaled.
+	FFTNormForward
+)
+
+func (n FFTNorm) String() string {
+	switch n {
+	case FFTNormBackward:
+		return "Backward"
+	case FFTNormOrtho:
+		return "Ortho"
+	case FFTNormForward:
+		return "Forward"
+	}
+	return "UnknownFFTNorm"
+}
+
+// FFT computes the one dimensional discrete Fourier transform of t along the given axis.
+//
+// n is the length of the transform: the input is cropped or padded with zeroes to n values along the axis.
+// n < 1 uses the length of the axis. Any length is supported, not just powers of two.
+// Complex64 and Float32 tensors give Complex64 results, and Complex128 and Float64 tensors give Complex128 results.
+func FFT(t Tensor, n, axis int, norm FFTNorm) (retVal Tensor, err error) {
+	if f, ok := t.Engine().(FFTer); ok {
+		return f.FFT(t, n, axis, norm)
+	}
+	return nil, errors.Errorf("Unable to perform FFT. Engine %T does not support that.", t.Engine())
+}
+
+// IFFT computes the one dimensional inverse discrete Fourier transform of t along the given axis, such that IFFT(FFT(t)) == t.
+// n is the length of the transform, as in FFT.
+func IFFT(t Tensor, n, axis int, norm FFTNorm) (retVal Tensor, err error) {
+	if f, ok := t.Engine().(FFTer); ok {
+		return f.IFFT(t, n, axis, norm)
+	}
+	return nil, errors.Errorf("Unable to perform IFFT. Engine %T does not support that.", t.Engine())
+}
+
+// RFFT computes the discrete Fourier transform of a Float32 or Float64 tensor along the given axis.
+// As the transform of real values is Hermitian symmetric, only the n/2+1 non negative frequencies are returned.
+// n is the length of the transform, as in FFT.
+func RFFT(t Tensor, n, axis int, norm FFTNorm) (retVal Tensor, err error) {
+	if f, ok := t.Engine().(FFTer); ok {
+		return f.RFFT(t, n, axis, norm)
+	}
+	return nil, errors.Errorf("Unable to perform RFFT. Engine %T does not support that.", t.Engine())
+}
+
+// IRFFT computes the inverse of RFFT: it takes the non negative frequencies of a Hermitian symmetric spectrum,
+// and returns the n real values it is the transform of. n < 1 uses 2*(m-1), where m is the length of the axis.
+// Since the length of the original signal cannot be told from the length of its RFFT, n should be given for odd lengths.
+func IRFFT(t Tensor, n, axis int, norm FFTNorm) (retVal Tensor, err error) {
+	if f, ok := t.Engine().(FFTer); ok {
+		return f.IRFFT(t, n, axis, norm)
+	}
+	return nil, errors.Errorf("Unable to perform IRFFT. Engine %T does not support that.", t.Engine())
+}
+
+// FFTN computes the discrete Fourier transform of t over the given axes, with the lengths of the transforms in s.
+// nil axes stand for all of the axes, or for the last len(s) axes if s is given. nil s uses the lengths of the axes.
+func FFTN(t Tensor, s, axes []int, norm FFTNorm) (retVal Tensor, err error) {
+	if f, ok := t.Engine().(FFTer); ok {
+		return f.FFTN(t, s, axes, norm)
+	}
+	return nil, errors.Errorf("Unable to perform FFTN. Engine %T does not support that.", t.Engine())
+}
+
+// IFFTN computes the inverse discrete Fourier transform of t over the given axes. See FFTN for the meaning of s and axes.
+func IFFTN(t Tensor, s, axes []int, norm FFTNorm) (retVal Tensor, err error) {
+	if f, ok := t.Engine().(FFTer); ok {
+		return f.IFFTN(t, s, axes, norm)
+	}
+	return nil, errors.Errorf("Unable to perform IFFTN. Engine %T does not support that.", t.Engine())
+}
+
+// FFT2 computes the two dimensional discrete Fourier transform over the last two axes of t. s is nil or the lengths of the transforms.
+func FFT2(t Tensor, s []int, norm FFTNorm) (retVal Tensor, err error) {
+	return FFTN(t, s, []int{-2, -1}, norm)
+}
+
+// IFFT2 computes the two dimensional inverse discrete Fourier transform over the last two axes of t.
+func IFFT2(t Tensor, s []int, norm FFTNorm) (retVal Tensor, err error) {
+	return IFFTN(t, s, []int{-2, -1}, norm)
+}
@@ -0,0 +1,48 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// Histogram counts the values of a float tensor that fall into each of bins equal width bins spanning [lo, hi].
+// lo == hi uses the range of the values instead. All the bins are half open, except for the last one, which also includes hi.
+// Values out of the range, NaNs and masked values are not counted.
+//
+// counts is an Int tensor of shape (bins), and edges holds the bins+1 edges of the bins, with the Dtype of t.
+func Histogram(t Tensor, bins int, lo, hi float64) (counts, edges Tensor, err error) {
+	if h, ok := t.Engine().(Histogrammer); ok {
+		return h.Histogram(t, bins, lo, hi)
+	}
+	return nil, nil, errors.Errorf("Unable to perform Histogram. Engine %T does not support that.", t.Engine())
+}
+
+// HistogramDD computes the joint histogram of N points in D dimensions, given as a float tensor of shape (N, D).
+// bins holds the number of bins of each dimension, and ranges their ranges, as in Histogram. nil ranges use the ranges of the values.
+// Points with any coordinate out of range, NaN or masked are not counted.
+//
+// counts is an Int tensor of shape (bins[0], bins[1], ...), and edges holds the edges of the bins of each dimension.
+func HistogramDD(t Tensor, bins []int, ranges [][2]float64) (counts Tensor, edges []Tensor, err error) {
+	if h, ok := t.Engine().(Histogrammer); ok {
+		return h.HistogramDD(t, bins, ranges)
+	}
+	return nil, nil, errors.Errorf("Unable to perform HistogramDD. Engine %T does not support that.", t.Engine())
+}
+
+// Bincount counts the occurrences of each value of an Int tensor, which must not be negative.
+// The result has max(max(t)+1, minlength) values. Masked values are not counted.
+//
+// If weights is not nil, it must be a float tensor with the shape of t, and the weights of the values are summed instead of counted.
+func Bincount(t, weights Tensor, minlength int) (retVal Tensor, err error) {
+	if h, ok := t.Engine().(Histogrammer); ok {
+		return h.Bincount(t, weights, minlength)
+	}
+	return nil, errors.Errorf("Unable to perform Bincount. Engine %T does not support that.", t.Engine())
+}
+
+// Digitize returns an Int tensor with the indices of the bins the values of a float tensor fall into, given the increasing edges of the bins.
+// If right is false, the index i of a value v is such that edges[i-1] <= v < edges[i]. If right is true, edges[i-1] < v <= edges[i].
+// Values below the first edge get 0, and values past the last edge (or NaN) get len(edges). The mask of t is kept.
+func Digitize(t, edges Tensor, right bool) (retVal Tensor, err error) {
+	if h, ok := t.Engine().(Histogrammer); ok {
+		return h.Digitize(t, edges, right)
+	}
+	return nil, errors.Errorf("Unable to perform Digitize. Engine %T does not support that.", t.Engine())
+}
@@ -0,0 +1,48 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// Index performs Numpy style advanced indexing, and returns the picked values in a new Tensor. There is one index per axis,
+// and each one may be:
+//
+//	an int, which picks a single index of the axis, and removes the axis
+//	a Slice, which picks a range of the axis. Unlike in (*Dense).Slice, the axis is kept, even if it is only of size 1
+//	nil, which picks the whole axis
+//	a []int or a Tensor of Int, which picks the listed indices of the axis
+//
+// Negative ints and indices count from the end of the axis. Missing trailing indices pick whole axes,
+// and Ellipsis and NewAxis may be used like in (*Dense).Slice.
+//
+// The index tensors (and ints) are broadcast together. Their broadcast axes replace the axes that they index in the result
+// if those axes are next to each other, and come before all the other axes otherwise. For example, given a T of shape (5, 6, 7):
+//
+//	Index(T, []int{0, 2}, []int{1, 3})          // shape (2, 7): the values T[0, 1, :] and T[2, 3, :]
+//	Index(T, nil, S(0, 3), []int{1, 3})         // shape (5, 3, 2)
+//	Index(T, []int{0, 2}, nil, []int{1, 3})     // shape (2, 6): the axes indexed are not next to each other
+//	Index(T, idx, 0)                            // with idx of shape (2, 2), shape (2, 2, 7)
+//
+// The values are always copied.
+func Index(t Tensor, indices ...interface{}) (retVal Tensor, err error) {
+	if ix, ok := t.Engine().(Indexer); ok {
+		return ix.Index(t, indices...)
+	}
+	return nil, errors.Errorf("Unable to perform Index. Engine %T does not support that.", t.Engine())
+}
+
+// IndexPut sets the values of t picked by the indices, as described in Index, to value. value may be a scalar of the Dtype of t,
+// or a Tensor that broadcasts to the shape of the result of Index. When an index is repeated, the last value assigned wins.
+func IndexPut(t Tensor, value interface{}, indices ...interface{}) error {
+	if ix, ok := t.Engine().(Indexer); ok {
+		return ix.IndexPut(t, value, indices...)
+	}
+	return errors.Errorf("Unable to perform IndexPut. Engine %T does not support that.", t.Engine())
+}
+
+// IndexAdd adds value to the values of t picked by the indices, as described in IndexPut. Unlike IndexPut,
+// repeated indices accumulate, so that IndexAdd can scatter the gradient of Index back into its input.
+func IndexAdd(t Tensor, value interface{}, indices ...interface{}) error {
+	if ix, ok := t.Engine().(Indexer); ok {
+		return ix.IndexAdd(t, value, indices...)
+	}
+	return errors.Errorf("Unable to perform IndexAdd. Engine %T does not support that.", t.Engine())
+}
@@ -0,0 +1,69 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// ResizeMethod describes how the values of a resized image are computed from the values around their position in the input.
+type ResizeMethod byte
+
+const (
+	// ResizeNearest takes the value of the nearest pixel. This is the default.
+	ResizeNearest ResizeMethod = iota
+	// ResizeBilinear interpolates linearly between the 2×2 pixels around the position.
+	ResizeBilinear
+	// ResizeBicubic interpolates between the 4×4 pixels around the position with a cubic convolution (a = -0.75, like PyTorch).
+	ResizeBicubic
+)
+
+func (m ResizeMethod) String() string {
+	switch m {
+	case ResizeNearest:
+		return "Nearest"
+	case ResizeBilinear:
+		return "Bilinear"
+	case ResizeBicubic:
+		return "Bicubic"
+	}
+	return "UnknownResizeMethod"
+}
+
+// Resampling describes how a batch of images is resized.
+//
+// When AlignCorners is false, the pixels are taken to be squares, and the output position y maps to the input position
+// (y + 0.5)×H/newH - 0.5, which is the behaviour of PyTorch's interpolate. Nearest takes the pixel at ⌊y×H/newH⌋.
+//
+// When AlignCorners is true, the centers of the corner pixels of the input and output are aligned, so the output position y
+// maps to the input position y×(H-1)/(newH-1). Nearest rounds that position.
+type Resampling struct {
+	Method       ResizeMethod
+	Layout       ImageLayout
+	AlignCorners bool
+}
+
+// Resize resamples the spatial dimensions of a batch of float images, which are (N, C, H, W) for NCHW and (N, H, W, C) for NHWC, to newH×newW.
+// Positions that fall outside of the input take the values at its edges.
+func Resize(x Tensor, newH, newW int, r Resampling) (retVal Tensor, err error) {
+	if rs, ok := x.Engine().(Resizer); ok {
+		return rs.Resize(x, newH, newW, r)
+	}
+	return nil, errors.Errorf("Unable to perform Resize. Engine %T does not support that.", x.Engine())
+}
+
+// ResizeB computes the gradient of the input of Resize, given the gradient of the output. Only the shape and type of x is used.
+func ResizeB(x, grad Tensor, r Resampling) (retVal Tensor, err error) {
+	if rs, ok := x.Engine().(Resizer); ok {
+		return rs.ResizeB(x, grad, r)
+	}
+	return nil, errors.Errorf("Unable to perform ResizeB. Engine %T does not support that.", x.Engine())
+}
+
+// Interp evaluates the piecewise linear function that goes through the points (xp, fp) at each value of x, like Numpy's interp.
+// xp and fp are float vectors of the same length, and xp has to be increasing. The values of x that are below or above the range
+// of xp take the first or the last value of fp.
+//
+// The result has the shape of x, which may be a view.
+func Interp(x, xp, fp Tensor) (retVal Tensor, err error) {
+	if in, ok := x.Engine().(Interpolator); ok {
+		return in.Interp(x, xp, fp)
+	}
+	return nil, errors.Errorf("Unable to perform Interp. Engine %T does not support that.", x.Engine())
+}
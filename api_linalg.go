@@ -0,0 +1,49 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// Pinv computes the Moore-Penrose pseudo-inverse of a float matrix. Singular values at or below rcond times the largest singular value
+// are treated as zeroes. A negative rcond uses max(m, n)·ε of the Dtype, which is what Numpy uses by default.
+func Pinv(t Tensor, rcond float64) (retVal Tensor, err error) {
+	if p, ok := t.Engine().(Pinver); ok {
+		return p.Pinv(t, rcond)
+	}
+	return nil, errors.Errorf("Unable to perform Pinv. Engine %T does not support that.", t.Engine())
+}
+
+// MatrixRank returns the rank of a float matrix, which is the number of its singular values above tol.
+// A negative tol uses the largest singular value times max(m, n)·ε of the Dtype.
+func MatrixRank(t Tensor, tol float64) (retVal int, err error) {
+	if r, ok := t.Engine().(MatrixRanker); ok {
+		return r.MatrixRank(t, tol)
+	}
+	return 0, errors.Errorf("Unable to perform MatrixRank. Engine %T does not support that.", t.Engine())
+}
+
+// MatrixPower raises a square matrix to the n-th power. n = 0 returns the identity matrix, and negative powers
+// are powers of the inverse of t.
+func MatrixPower(t Tensor, n int) (retVal Tensor, err error) {
+	if p, ok := t.Engine().(MatrixPowerer); ok {
+		return p.MatrixPower(t, n)
+	}
+	return nil, errors.Errorf("Unable to perform MatrixPower. Engine %T does not support that.", t.Engine())
+}
+
+// Kron computes the Kronecker product of a and b. If they do not have the same number of dimensions, the shape of the smaller one
+// is padded with leading 1s. The result has shape (a.Shape()[0] * b.Shape()[0], a.Shape()[1] * b.Shape()[1], ...).
+func Kron(a, b Tensor) (retVal Tensor, err error) {
+	if k, ok := a.Engine().(Kroner); ok {
+		return k.Kron(a, b)
+	}
+	return nil, errors.Errorf("Unable to perform Kron. Engine %T does not support that.", a.Engine())
+}
+
+// Cond computes the condition number of a float matrix in the given norm. It accepts the same orders as (*Dense).Norm does for matrices.
+// Norm(2) (the ratio of the largest to the smallest singular value) and Norm(-2) also work on non square matrices.
+// Singular matrices have a condition number of +Inf.
+func Cond(t Tensor, ord NormOrder) (retVal float64, err error) {
+	if c, ok := t.Engine().(Conder); ok {
+		return c.Cond(t, ord)
+	}
+	return 0, errors.Errorf("Unable to perform Cond. Engine %T does not support that.", t.Engine())
+}
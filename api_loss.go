@@ -0,0 +1,106 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// LossReduction describes how the per-sample losses are combined.
+type LossReduction byte
+
+const (
+	// ReduceMean averages the losses over the samples that are not ignored. This is the default.
+	ReduceMean LossReduction = iota
+	// ReduceSum sums up the losses.
+	ReduceSum
+	// ReduceNone returns the per-sample losses.
+	ReduceNone
+)
+
+func (r LossReduction) String() string {
+	switch r {
+	case ReduceMean:
+		return "Mean"
+	case ReduceSum:
+		return "Sum"
+	case ReduceNone:
+		return "None"
+	}
+	return "UnknownReduction"
+}
+
+// LossOpt are optionals for calling the loss functions.
+type LossOpt func(*lossOpt)
+
+type lossOpt struct {
+	reduction LossReduction
+	smoothing float64
+	ignore    int
+	hasIgnore bool
+}
+
+func parseLossOpts(opts ...LossOpt) (retVal lossOpt, err error) {
+	for _, opt := range opts {
+		opt(&retVal)
+	}
+	if retVal.reduction > ReduceNone {
+		return retVal, errors.Errorf("Unknown reduction %v", retVal.reduction)
+	}
+	if retVal.smoothing < 0 || retVal.smoothing > 1 {
+		return retVal, errors.Errorf("Label smoothing has to be in [0, 1]. Got %v", retVal.smoothing)
+	}
+	return
+}
+
+// WithReduction sets how the per-sample losses are combined.
+func WithReduction(r LossReduction) LossOpt {
+	return func(opt *lossOpt) { opt.reduction = r }
+}
+
+// WithIgnoreIndex makes samples whose target is the given class contribute neither to the loss nor to the gradient.
+func WithIgnoreIndex(class int) LossOpt {
+	return func(opt *lossOpt) {
+		opt.ignore = class
+		opt.hasIgnore = true
+	}
+}
+
+// WithLabelSmoothing mixes the one-hot targets with a uniform distribution over the classes:
+//
+//	q = (1-ε)·onehot(target) + ε/C
+func WithLabelSmoothing(epsilon float64) LossOpt {
+	return func(opt *lossOpt) { opt.smoothing = epsilon }
+}
+
+// SoftmaxCrossEntropy computes the cross entropy between softmax(logits) along the given axis and the target classes.
+// targets is a tensor of Int, with the shape of logits without the class axis.
+//
+// By default the mean loss is returned as a scalar. Use WithReduction(ReduceNone) to get the per-sample losses instead.
+func SoftmaxCrossEntropy(logits, targets Tensor, axis int, opts ...LossOpt) (retVal Tensor, err error) {
+	if ce, ok := logits.Engine().(CrossEntropyer); ok {
+		return ce.SoftmaxCrossEntropy(logits, targets, axis, opts...)
+	}
+	return nil, errors.Errorf("Unable to perform SoftmaxCrossEntropy. Engine %T does not support that.", logits.Engine())
+}
+
+// SoftmaxCrossEntropyB computes the gradient of SoftmaxCrossEntropy with regards to the logits, given the gradient of the loss.
+// The same options must be passed in as those used in the forward pass.
+func SoftmaxCrossEntropyB(logits, targets, grad Tensor, axis int, opts ...LossOpt) (retVal Tensor, err error) {
+	if ce, ok := logits.Engine().(CrossEntropyer); ok {
+		return ce.SoftmaxCrossEntropyB(logits, targets, grad, axis, opts...)
+	}
+	return nil, errors.Errorf("Unable to perform SoftmaxCrossEntropyB. Engine %T does not support that.", logits.Engine())
+}
+
+// NLLLoss computes the negative log likelihood of the target classes, given log probabilities (such as the output of LogSoftMax) along the last axis.
+func NLLLoss(logProbs, targets Tensor, opts ...LossOpt) (retVal Tensor, err error) {
+	if ce, ok := logProbs.Engine().(CrossEntropyer); ok {
+		return ce.NLLLoss(logProbs, targets, opts...)
+	}
+	return nil, errors.Errorf("Unable to perform NLLLoss. Engine %T does not support that.", logProbs.Engine())
+}
+
+// NLLLossB computes the gradient of NLLLoss with regards to the log probabilities, given the gradient of the loss.
+func NLLLossB(logProbs, targets, grad Tensor, opts ...LossOpt) (retVal Tensor, err error) {
+	if ce, ok := logProbs.Engine().(CrossEntropyer); ok {
+		return ce.NLLLossB(logProbs, targets, grad, opts...)
+	}
+	return nil, errors.Errorf("Unable to perform NLLLossB. Engine %T does not support that.", logProbs.Engine())
+}
@@ -57,6 +57,65 @@ func Transpose(t Tensor, axes ...int) (retVal Tensor, err error) {
 	panic("Unreachable")
 }
 
+// Squeeze removes the given axes of size 1 from a Tensor, or all the axes of size 1 if none are given.
+// For a *Dense, the result is a view of the input.
+func Squeeze(t Tensor, axes ...int) (retVal Tensor, err error) {
+	switch tt := t.(type) {
+	case *Dense:
+		return tt.Squeeze(axes...)
+	}
+	return nil, errors.Errorf(typeNYI, "Squeeze", t)
+}
+
+// ExpandDims inserts a new axis of size 1 at the given position. For a *Dense, the result is a view of the input.
+func ExpandDims(t Tensor, axis int) (retVal Tensor, err error) {
+	switch tt := t.(type) {
+	case *Dense:
+		return tt.ExpandDims(axis)
+	}
+	return nil, errors.Errorf(typeNYI, "ExpandDims", t)
+}
+
+// BroadcastTo presents a Tensor as one of the given shape, following Numpy's broadcasting rules. For a *Dense,
+// the result is a read-only view of the input. See (*Dense).Expand.
+func BroadcastTo(t Tensor, shape ...int) (retVal Tensor, err error) {
+	switch tt := t.(type) {
+	case *Dense:
+		return tt.Expand(shape...)
+	}
+	return nil, errors.Errorf(typeNYI, "BroadcastTo", t)
+}
+
+// Unsqueeze is an alias for ExpandDims.
+func Unsqueeze(t Tensor, axis int) (retVal Tensor, err error) { return ExpandDims(t, axis) }
+
+// Flatten merges the axes from start to end (both inclusive) into one. The data is only copied if the strides do not allow a view.
+func Flatten(t Tensor, start, end int) (retVal Tensor, err error) {
+	switch tt := t.(type) {
+	case *Dense:
+		return tt.Flatten(start, end)
+	}
+	return nil, errors.Errorf(typeNYI, "Flatten", t)
+}
+
+// MoveAxis moves the axis src of a Tensor to the position dst. For a *Dense, the result is a view of the input.
+func MoveAxis(t Tensor, src, dst int) (retVal Tensor, err error) {
+	switch tt := t.(type) {
+	case *Dense:
+		return tt.MoveAxis(src, dst)
+	}
+	return nil, errors.Errorf(typeNYI, "MoveAxis", t)
+}
+
+// SwapAxes swaps two axes of a Tensor. For a *Dense, the result is a view of the input.
+func SwapAxes(t Tensor, a, b int) (retVal Tensor, err error) {
+	switch tt := t.(type) {
+	case *Dense:
+		return tt.SwapAxes(a, b)
+	}
+	return nil, errors.Errorf(typeNYI, "SwapAxes", t)
+}
+
 // Concat concatenates a list of Tensors. At the moment the operation only supports Tensors of the same type
 // (*Dense can only be concatenated with a bunch of *Dense, CSCs can only be concatenated with a bunch of CSC, etc)
 func Concat(axis int, t Tensor, others ...Tensor) (retVal Tensor, err error) {
@@ -78,14 +137,165 @@ func Concat(axis int, t Tensor, others ...Tensor) (retVal Tensor, err error) {
 	panic("Unreachable")
 }
 
+// SplitOpt is an option for Split and the functions built on it.
+type SplitOpt func(*splitOpt)
+
+type splitOpt struct {
+	materialize bool
+}
+
+// WithMaterialize makes the split functions return copies of the data instead of views of the input.
+func WithMaterialize() SplitOpt {
+	return func(o *splitOpt) { o.materialize = true }
+}
+
+func parseSplitOpts(opts []SplitOpt) (retVal splitOpt) {
+	for _, opt := range opts {
+		opt(&retVal)
+	}
+	return
+}
+
+// Split splits a Tensor along the axis into pieces of the given sizes. It is the inverse of Concat.
+//
+// If the sizes add up to the size of the axis, they are the sizes of the pieces. If a single size is given
+// that does not, it is the size of each piece, and the last piece holds whatever remains.
+// The pieces are views of t unless WithMaterialize() is passed in.
+func Split(t Tensor, axis int, sizes []int, opts ...SplitOpt) (retVal []Tensor, err error) {
+	s, ok := t.Engine().(Splitter)
+	if !ok {
+		return nil, errors.Errorf("Unable to perform Split. Engine %T does not support that.", t.Engine())
+	}
+	if len(sizes) == 1 && axis < t.Dims() && axis >= -t.Dims() {
+		d, size := t.Shape()[resolveAxis(axis, t.Dims())], sizes[0]
+		if size > 0 && size < d {
+			sizes = make([]int, 0, (d+size-1)/size)
+			for ; d > size; d -= size {
+				sizes = append(sizes, size)
+			}
+			sizes = append(sizes, d)
+		}
+	}
+	o := parseSplitOpts(opts)
+	return s.Split(t, axis, sizes, o.materialize)
+}
+
+// Chunk splits a Tensor into n pieces along the axis. If the size of the axis is not divisible by n,
+// the first pieces are one larger than the rest, like Numpy's array_split().
+func Chunk(t Tensor, n, axis int, opts ...SplitOpt) (retVal []Tensor, err error) {
+	if axis >= t.Dims() || axis < -t.Dims() {
+		return nil, errors.Errorf(invalidAxis, axis, t.Dims())
+	}
+	d := t.Shape()[resolveAxis(axis, t.Dims())]
+	if n < 1 || n > d {
+		return nil, errors.Errorf("Cannot split an axis of size %d into %d chunks", d, n)
+	}
+	sizes := make([]int, n)
+	q, r := divmod(d, n)
+	for i := range sizes {
+		sizes[i] = q
+		if i < r {
+			sizes[i]++
+		}
+	}
+	return Split(t, axis, sizes, opts...)
+}
+
+// Unstack splits a Tensor into pieces of size 1 along the axis, and removes that axis from the pieces. It is the inverse of Stack.
+func Unstack(t Tensor, axis int, opts ...SplitOpt) (retVal []Tensor, err error) {
+	if axis >= t.Dims() || axis < -t.Dims() {
+		return nil, errors.Errorf(invalidAxis, axis, t.Dims())
+	}
+	axis = resolveAxis(axis, t.Dims())
+	sizes := make([]int, t.Shape()[axis])
+	for i := range sizes {
+		sizes[i] = 1
+	}
+	if retVal, err = Split(t, axis, sizes, opts...); err != nil {
+		return nil, err
+	}
+
+	shape := make(Shape, 0, t.Dims()-1)
+	shape = append(shape, t.Shape()[:axis]...)
+	shape = append(shape, t.Shape()[axis+1:]...)
+	materialize := parseSplitOpts(opts).materialize
+	for i, piece := range retVal {
+		if !materialize {
+			if retVal[i], err = Squeeze(piece, axis); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err = piece.Reshape(shape...); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// Hsplit splits a Tensor columnwise (along the second axis, or the first for vectors). It is the inverse of Hstack.
+func Hsplit(t Tensor, sizes []int, opts ...SplitOpt) (retVal []Tensor, err error) {
+	switch t.Dims() {
+	case 0:
+		return nil, errors.Errorf(atleastDims, 1)
+	case 1:
+		return Split(t, 0, sizes, opts...)
+	}
+	return Split(t, 1, sizes, opts...)
+}
+
+// Vsplit splits a Tensor rowwise (along the first axis). It is the inverse of Vstack, and requires at least 2 dimensions.
+func Vsplit(t Tensor, sizes []int, opts ...SplitOpt) (retVal []Tensor, err error) {
+	if t.Dims() < 2 {
+		return nil, errors.Errorf(atleastDims, 2)
+	}
+	return Split(t, 0, sizes, opts...)
+}
+
+// Flip reverses the order of the values of a Tensor along the given axes, or along all the axes if none are given.
+func Flip(t Tensor, axes ...int) (retVal Tensor, err error) {
+	if f, ok := t.Engine().(Flipper); ok {
+		return f.Flip(t, axes...)
+	}
+	return nil, errors.Errorf("Unable to perform Flip. Engine %T does not support that.", t.Engine())
+}
+
+// Rot90 rotates a Tensor by 90 degrees k times, in the plane of the given axes, in the direction from the first axis towards the second.
+func Rot90(t Tensor, k, axis1, axis2 int) (retVal Tensor, err error) {
+	if f, ok := t.Engine().(Flipper); ok {
+		return f.Rot90(t, k, axis1, axis2)
+	}
+	return nil, errors.Errorf("Unable to perform Rot90. Engine %T does not support that.", t.Engine())
+}
+
+// Roll shifts the values of a Tensor by shift places along the given axes (or the flattened Tensor if none are given), wrapping around at the end.
+func Roll(t Tensor, shift int, axes ...int) (retVal Tensor, err error) {
+	if r, ok := t.Engine().(Roller); ok {
+		return r.Roll(t, shift, axes...)
+	}
+	return nil, errors.Errorf("Unable to perform Roll. Engine %T does not support that.", t.Engine())
+}
+
+// Tile repeats a whole Tensor reps[i] times along the i-th axis.
+func Tile(t Tensor, reps ...int) (retVal Tensor, err error) {
+	if tl, ok := t.Engine().(Tiler); ok {
+		return tl.Tile(t, reps...)
+	}
+	return nil, errors.Errorf("Unable to perform Tile. Engine %T does not support that.", t.Engine())
+}
+
 // Copy copies a tensor to another. For *Dense views, only the relevant slots are copied.
 func Copy(dst, src Tensor) error {
+	if err := checkWritable(dst); err != nil {
+		return err
+	}
 	switch st := src.(type) {
 	case DenseTensor:
 		dt, ok := dst.(DenseTensor)
 		if !ok {
 			return errors.Errorf("Cannot copy from DenseTensor to %T", dst)
 		}
+		st = unconjugated(st)
 
 		if st.RequiresIterator() || dt.RequiresIterator() {
 			siter := st.Iterator()
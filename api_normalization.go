@@ -0,0 +1,74 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// LayerNorm normalizes x to zero mean and unit variance over the given axes (the last axis if none are given),
+// then applies the elementwise scale and bias, whose shape is that of the normalized axes:
+//
+//	y = (x - mean) / sqrt(var + eps) * scale + bias
+//
+// scale and bias may be nil.
+//
+// The mean and the inverse standard deviation of each group are also returned, for use in LayerNormB.
+// They have the shape of x with the normalized axes removed.
+func LayerNorm(x, scale, bias Tensor, eps float64, axes ...int) (retVal, mean, invStd Tensor, err error) {
+	if n, ok := x.Engine().(Normalizer); ok {
+		return n.LayerNorm(x, scale, bias, eps, axes...)
+	}
+	return nil, nil, nil, errors.Errorf("Unable to perform LayerNorm. Engine %T does not support that.", x.Engine())
+}
+
+// LayerNormB computes the gradients of the input, the scale and the bias of LayerNorm, given the statistics returned by LayerNorm and the gradient of its output.
+func LayerNormB(x, scale, mean, invStd, grad Tensor, axes ...int) (dx, dscale, dbias Tensor, err error) {
+	if n, ok := x.Engine().(Normalizer); ok {
+		return n.LayerNormB(x, scale, mean, invStd, grad, axes...)
+	}
+	return nil, nil, nil, errors.Errorf("Unable to perform LayerNormB. Engine %T does not support that.", x.Engine())
+}
+
+// RMSNorm scales x by the inverse of its root mean square over the given axes (the last axis if none are given):
+//
+//	y = x / sqrt(mean(x²) + eps) * scale
+//
+// scale may be nil. The inverse root mean square of each group is also returned, for use in RMSNormB.
+func RMSNorm(x, scale Tensor, eps float64, axes ...int) (retVal, invRMS Tensor, err error) {
+	if n, ok := x.Engine().(Normalizer); ok {
+		return n.RMSNorm(x, scale, eps, axes...)
+	}
+	return nil, nil, errors.Errorf("Unable to perform RMSNorm. Engine %T does not support that.", x.Engine())
+}
+
+// RMSNormB computes the gradients of the input and the scale of RMSNorm.
+func RMSNormB(x, scale, invRMS, grad Tensor, axes ...int) (dx, dscale Tensor, err error) {
+	if n, ok := x.Engine().(Normalizer); ok {
+		return n.RMSNormB(x, scale, invRMS, grad, axes...)
+	}
+	return nil, nil, errors.Errorf("Unable to perform RMSNormB. Engine %T does not support that.", x.Engine())
+}
+
+// BatchNorm normalizes each channel of x over all the other axes. axis is the channel axis (1 for NCHW), and scale, bias,
+// runningMean and runningVar are vectors with one value per channel. scale and bias may be nil.
+//
+// In training mode, the statistics of the batch are used, and the running statistics (if not nil) are updated in place:
+//
+//	running = (1 - momentum) × running + momentum × batch
+//
+// where the unbiased variance of the batch is used for runningVar.
+// Otherwise the running statistics are used, and are required.
+//
+// The mean and the inverse standard deviation that were used are returned for BatchNormB.
+func BatchNorm(x, scale, bias, runningMean, runningVar Tensor, axis int, momentum, eps float64, training bool) (retVal, mean, invStd Tensor, err error) {
+	if n, ok := x.Engine().(Normalizer); ok {
+		return n.BatchNorm(x, scale, bias, runningMean, runningVar, axis, momentum, eps, training)
+	}
+	return nil, nil, nil, errors.Errorf("Unable to perform BatchNorm. Engine %T does not support that.", x.Engine())
+}
+
+// BatchNormB computes the gradients of the input, the scale and the bias of BatchNorm.
+// training has to match the mode of the forward pass: in inference mode the statistics are constants.
+func BatchNormB(x, scale, mean, invStd, grad Tensor, axis int, training bool) (dx, dscale, dbias Tensor, err error) {
+	if n, ok := x.Engine().(Normalizer); ok {
+		return n.BatchNormB(x, scale, mean, invStd, grad, axis, training)
+	}
+	return nil, nil, nil, errors.Errorf("Unable to perform BatchNormB. Engine %T does not support that.", x.Engine())
+}
@@ -0,0 +1,58 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// PadMode describes how the values of the padding are picked.
+type PadMode byte
+
+const (
+	// PadConstant pads with a constant value.
+	PadConstant PadMode = iota
+	// PadReflect pads with the reflection of the values about the edge, without repeating the edge: [1 2 3] → [3 2 | 1 2 3 | 2 1].
+	PadReflect
+	// PadSymmetric pads with the reflection of the values about the edge, repeating the edge: [1 2 3] → [2 1 | 1 2 3 | 3 2].
+	PadSymmetric
+	// PadEdge pads with the edge value: [1 2 3] → [1 1 | 1 2 3 | 3 3].
+	PadEdge
+	// PadCircular pads with the values wrapped around from the other end: [1 2 3] → [2 3 | 1 2 3 | 1 2].
+	PadCircular
+
+	// PadReplicate is PadEdge by its other name.
+	PadReplicate = PadEdge
+)
+
+func (m PadMode) String() string {
+	switch m {
+	case PadConstant:
+		return "constant"
+	case PadReflect:
+		return "reflect"
+	case PadSymmetric:
+		return "symmetric"
+	case PadEdge:
+		return "edge"
+	case PadCircular:
+		return "circular"
+	}
+	return "Unknown PadMode"
+}
+
+// Pad pads a Tensor. widths holds the amount of padding before and after each axis, and has to have one entry per axis.
+// value is the value of the padding for PadConstant; it is ignored otherwise. A nil value pads with zeroes.
+//
+// Reflections and wrapping repeat as many times as required, so the padding may be wider than the axis.
+func Pad(t Tensor, widths [][2]int, mode PadMode, value interface{}) (retVal Tensor, err error) {
+	if p, ok := t.Engine().(Padder); ok {
+		return p.Pad(t, widths, mode, value)
+	}
+	return nil, errors.Errorf("Unable to perform Pad. Engine %T does not support that.", t.Engine())
+}
+
+// PadB computes the gradient of the input of Pad, given the gradient of its output. It crops the padding off grad,
+// and for all but PadConstant, accumulates the gradients of the padding into the values that were copied into it.
+func PadB(grad Tensor, widths [][2]int, mode PadMode) (retVal Tensor, err error) {
+	if p, ok := grad.Engine().(Padder); ok {
+		return p.PadB(grad, widths, mode)
+	}
+	return nil, errors.Errorf("Unable to perform PadB. Engine %T does not support that.", grad.Engine())
+}
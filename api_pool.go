@@ -0,0 +1,106 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// MaxPool2D returns the maximum value of each window over the spatial dimensions of a batch of images,
+// as well as the position of the maximum value within its image plane, as an Int tensor of the same shape.
+// The position is given as y×W + x, where W is the width of the input.
+//
+// Padded positions never win.
+func MaxPool2D(x Tensor, w Window2D) (retVal, argmax Tensor, err error) {
+	if p, ok := x.Engine().(Pooler); ok {
+		return p.MaxPool2D(x, w)
+	}
+	return nil, nil, errors.Errorf("Unable to perform MaxPool2D. Engine %T does not support that.", x.Engine())
+}
+
+// MaxPool2DB computes the gradient of the input of MaxPool2D, given the argmax returned by MaxPool2D and the gradient of the output.
+func MaxPool2DB(x, argmax, grad Tensor, w Window2D) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(Pooler); ok {
+		return p.MaxPool2DB(x, argmax, grad, w)
+	}
+	return nil, errors.Errorf("Unable to perform MaxPool2DB. Engine %T does not support that.", x.Engine())
+}
+
+// AvgPool2D returns the mean value of each window over the spatial dimensions of a batch of images.
+// Padded positions count as zeroes, so every window is divided by KernelH×KernelW.
+func AvgPool2D(x Tensor, w Window2D) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(Pooler); ok {
+		return p.AvgPool2D(x, w)
+	}
+	return nil, errors.Errorf("Unable to perform AvgPool2D. Engine %T does not support that.", x.Engine())
+}
+
+// AvgPool2DB computes the gradient of the input of AvgPool2D, given the gradient of the output.
+func AvgPool2DB(x, grad Tensor, w Window2D) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(Pooler); ok {
+		return p.AvgPool2DB(x, grad, w)
+	}
+	return nil, errors.Errorf("Unable to perform AvgPool2DB. Engine %T does not support that.", x.Engine())
+}
+
+// MaxPool1D is the 1D version of MaxPool2D. The returned argmax is the position of the maximum value within its sequence.
+func MaxPool1D(x Tensor, w Window1D) (retVal, argmax Tensor, err error) {
+	if p, ok := x.Engine().(Pooler); ok {
+		return p.MaxPool1D(x, w)
+	}
+	return nil, nil, errors.Errorf("Unable to perform MaxPool1D. Engine %T does not support that.", x.Engine())
+}
+
+// MaxPool1DB computes the gradient of the input of MaxPool1D.
+func MaxPool1DB(x, argmax, grad Tensor, w Window1D) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(Pooler); ok {
+		return p.MaxPool1DB(x, argmax, grad, w)
+	}
+	return nil, errors.Errorf("Unable to perform MaxPool1DB. Engine %T does not support that.", x.Engine())
+}
+
+// AvgPool1D is the 1D version of AvgPool2D.
+func AvgPool1D(x Tensor, w Window1D) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(Pooler); ok {
+		return p.AvgPool1D(x, w)
+	}
+	return nil, errors.Errorf("Unable to perform AvgPool1D. Engine %T does not support that.", x.Engine())
+}
+
+// AvgPool1DB computes the gradient of the input of AvgPool1D.
+func AvgPool1DB(x, grad Tensor, w Window1D) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(Pooler); ok {
+		return p.AvgPool1DB(x, grad, w)
+	}
+	return nil, errors.Errorf("Unable to perform AvgPool1DB. Engine %T does not support that.", x.Engine())
+}
+
+// GlobalMaxPool returns the maximum over all the spatial dimensions of each channel.
+// The input is expected to be (N, C, ...) for NCHW and (N, ..., C) for NHWC, with any number of spatial dimensions.
+// Both the result and the argmax are of shape (N, C). The argmax is the flat position within the spatial dimensions.
+func GlobalMaxPool(x Tensor, layout ImageLayout) (retVal, argmax Tensor, err error) {
+	if p, ok := x.Engine().(GlobalPooler); ok {
+		return p.GlobalMaxPool(x, layout)
+	}
+	return nil, nil, errors.Errorf("Unable to perform GlobalMaxPool. Engine %T does not support that.", x.Engine())
+}
+
+// GlobalMaxPoolB computes the gradient of the input of GlobalMaxPool.
+func GlobalMaxPoolB(x, argmax, grad Tensor, layout ImageLayout) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(GlobalPooler); ok {
+		return p.GlobalMaxPoolB(x, argmax, grad, layout)
+	}
+	return nil, errors.Errorf("Unable to perform GlobalMaxPoolB. Engine %T does not support that.", x.Engine())
+}
+
+// GlobalAvgPool returns the mean over all the spatial dimensions of each channel. The result is of shape (N, C).
+func GlobalAvgPool(x Tensor, layout ImageLayout) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(GlobalPooler); ok {
+		return p.GlobalAvgPool(x, layout)
+	}
+	return nil, errors.Errorf("Unable to perform GlobalAvgPool. Engine %T does not support that.", x.Engine())
+}
+
+// GlobalAvgPoolB computes the gradient of the input of GlobalAvgPool.
+func GlobalAvgPoolB(x, grad Tensor, layout ImageLayout) (retVal Tensor, err error) {
+	if p, ok := x.Engine().(GlobalPooler); ok {
+		return p.GlobalAvgPoolB(x, grad, layout)
+	}
+	return nil, errors.Errorf("Unable to perform GlobalAvgPoolB. Engine %T does not support that.", x.Engine())
+}
@@ -0,0 +1,65 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// QuantileMethod describes how a quantile that falls between two values is computed. The methods are the same as Numpy's.
+// When a quantile q of n sorted values is wanted, the methods are given the position q·(n-1), which is usually not a whole number.
+type QuantileMethod byte
+
+const (
+	// QuantileLinear interpolates linearly between the values on either side of the position. This is the default.
+	QuantileLinear QuantileMethod = iota
+	// QuantileLower takes the value before the position.
+	QuantileLower
+	// QuantileHigher takes the value after the position.
+	QuantileHigher
+	// QuantileNearest takes the value nearest to the position. Ties go to the even position.
+	QuantileNearest
+	// QuantileMidpoint takes the average of the values on either side of the position.
+	QuantileMidpoint
+)
+
+func (m QuantileMethod) String() string {
+	switch m {
+	case QuantileLinear:
+		return "Linear"
+	case QuantileLower:
+		return "Lower"
+	case QuantileHigher:
+		return "Higher"
+	case QuantileNearest:
+		return "Nearest"
+	case QuantileMidpoint:
+		return "Midpoint"
+	}
+	return "UnknownQuantileMethod"
+}
+
+// Quantile computes the q-th quantiles of the values of a float tensor along the given axes, where each q is in [0, 1].
+// nil axes stand for all of the axes. Masked values are ignored, and slices with a NaN have NaN quantiles.
+//
+// With a single q, the result has the shape of t without the axes. With several, the result has an extra leading axis, with one slice per q.
+func Quantile(t Tensor, axes []int, method QuantileMethod, q ...float64) (retVal Tensor, err error) {
+	if qt, ok := t.Engine().(Quantiler); ok {
+		return qt.Quantile(t, axes, method, q...)
+	}
+	return nil, errors.Errorf("Unable to perform Quantile. Engine %T does not support that.", t.Engine())
+}
+
+// Percentile is like Quantile, with each p in [0, 100].
+func Percentile(t Tensor, axes []int, method QuantileMethod, p ...float64) (retVal Tensor, err error) {
+	q := make([]float64, len(p))
+	for i, v := range p {
+		if v < 0 || v > 100 {
+			return nil, errors.Errorf("Percentiles must be in [0, 100]. Got %v", v)
+		}
+		q[i] = v / 100
+	}
+	return Quantile(t, axes, method, q...)
+}
+
+// Median computes the medians of the values of a float tensor along the given axes, or of all the values if there are none.
+// Like Numpy, the median of an even number of values is the average of the two middle values.
+func Median(t Tensor, along ...int) (retVal Tensor, err error) {
+	return Quantile(t, along, QuantileLinear, 0.5)
+}
@@ -25,3 +25,72 @@ func Argmin(t Tensor, axis int) (retVal Tensor, err error) {
 	}
 	return nil, errors.New("Engine does not support Argmax()")
 }
+
+// NanSum sums the values of a float tensor along the given axes, treating NaNs as zeroes. With no axes, all the values are summed.
+// If skipMasked is true, the masked values of a MaskedTensor are treated like NaNs.
+func NanSum(t Tensor, skipMasked bool, along ...int) (retVal Tensor, err error) {
+	if r, ok := t.Engine().(NanReducer); ok {
+		return r.NanSum(t, skipMasked, along...)
+	}
+	return nil, errors.Errorf("Unable to perform NanSum. Engine %T does not support that.", t.Engine())
+}
+
+// NanMean averages the values of a float tensor that are not NaN along the given axes. The mean of a slice with only NaNs is NaN.
+// If skipMasked is true, the masked values of a MaskedTensor are treated like NaNs.
+func NanMean(t Tensor, skipMasked bool, along ...int) (retVal Tensor, err error) {
+	if r, ok := t.Engine().(NanReducer); ok {
+		return r.NanMean(t, skipMasked, along...)
+	}
+	return nil, errors.Errorf("Unable to perform NanMean. Engine %T does not support that.", t.Engine())
+}
+
+// NanMax finds the largest values of a float tensor along the given axes, ignoring NaNs. The max of a slice with only NaNs is NaN.
+// If skipMasked is true, the masked values of a MaskedTensor are treated like NaNs.
+func NanMax(t Tensor, skipMasked bool, along ...int) (retVal Tensor, err error) {
+	if r, ok := t.Engine().(NanReducer); ok {
+		return r.NanMax(t, skipMasked, along...)
+	}
+	return nil, errors.Errorf("Unable to perform NanMax. Engine %T does not support that.", t.Engine())
+}
+
+// NanMin finds the smallest values of a float tensor along the given axes, ignoring NaNs. The min of a slice with only NaNs is NaN.
+// If skipMasked is true, the masked values of a MaskedTensor are treated like NaNs.
+func NanMin(t Tensor, skipMasked bool, along ...int) (retVal Tensor, err error) {
+	if r, ok := t.Engine().(NanReducer); ok {
+		return r.NanMin(t, skipMasked, along...)
+	}
+	return nil, errors.Errorf("Unable to perform NanMin. Engine %T does not support that.", t.Engine())
+}
+
+// NanArgmax finds the index of the largest value that is not NaN along the axis provided. AllAxes finds the index in the flattened tensor.
+// If skipMasked is true, the masked values of a MaskedTensor are treated like NaNs. A slice with only NaNs is an error.
+func NanArgmax(t Tensor, axis int, skipMasked bool) (retVal Tensor, err error) {
+	if r, ok := t.Engine().(NanReducer); ok {
+		return r.NanArgmax(t, axis, skipMasked)
+	}
+	return nil, errors.Errorf("Unable to perform NanArgmax. Engine %T does not support that.", t.Engine())
+}
+
+// NanArgmin finds the index of the smallest value that is not NaN along the axis provided. See NanArgmax.
+func NanArgmin(t Tensor, axis int, skipMasked bool) (retVal Tensor, err error) {
+	if r, ok := t.Engine().(NanReducer); ok {
+		return r.NanArgmin(t, axis, skipMasked)
+	}
+	return nil, errors.Errorf("Unable to perform NanArgmin. Engine %T does not support that.", t.Engine())
+}
+
+// Any tests whether any value of a Bool tensor is true along the given axes. With no axes, all the values are tested.
+func Any(t Tensor, along ...int) (retVal Tensor, err error) {
+	if anyer, ok := t.Engine().(Anyer); ok {
+		return anyer.Any(t, along...)
+	}
+	return nil, errors.Errorf("Unable to perform Any. Engine %T does not support that.", t.Engine())
+}
+
+// All tests whether all the values of a Bool tensor are true along the given axes. With no axes, all the values are tested.
+func All(t Tensor, along ...int) (retVal Tensor, err error) {
+	if aller, ok := t.Engine().(Aller); ok {
+		return aller.All(t, along...)
+	}
+	return nil, errors.Errorf("Unable to perform All. Engine %T does not support that.", t.Engine())
+}
@@ -0,0 +1,50 @@
+package tensor
+
+import (
+	"math/rand"
+
+	"github.com/pkg/errors"
+)
+
+// SearchSide tells SearchSorted which index to return when a value is equal to some of the sorted values.
+type SearchSide byte
+
+const (
+	// SearchLeft returns the index of the first equal value, so that a value is inserted before the values it is equal to.
+	SearchLeft SearchSide = iota
+	// SearchRight returns the index after the last equal value.
+	SearchRight
+)
+
+func (s SearchSide) String() string {
+	switch s {
+	case SearchLeft:
+		return "Left"
+	case SearchRight:
+		return "Right"
+	}
+	return "UnknownSearchSide"
+}
+
+// SearchSorted finds the indices at which the values would have to be inserted into the last axis of sorted to keep it sorted, using binary search.
+// sorted and values must have the same ordered Dtype, and sorted must be sorted in increasing order along its last axis.
+//
+// If sorted is a vector, values may have any shape. Otherwise sorted has the shape (..., M) and values the shape (..., N),
+// with the same leading dimensions, and each row of values is searched for in the matching row of sorted.
+// The result is an Int tensor with the shape of values.
+func SearchSorted(sorted, values Tensor, side SearchSide) (retVal Tensor, err error) {
+	if s, ok := sorted.Engine().(Searcher); ok {
+		return s.SearchSorted(sorted, values, side)
+	}
+	return nil, errors.Errorf("Unable to perform SearchSorted. Engine %T does not support that.", sorted.Engine())
+}
+
+// SampleCategorical draws n samples from each of the categorical distributions given by the last axis of weights, which has the shape (..., K).
+// The weights must be finite and non negative, and are not required to sum to 1. The result is an Int tensor of shape (..., n)
+// holding the drawn categories. The samples are drawn from rng, or from the global source of math/rand if rng is nil.
+func SampleCategorical(weights Tensor, n int, rng *rand.Rand) (retVal Tensor, err error) {
+	if s, ok := weights.Engine().(Searcher); ok {
+		return s.SampleCategorical(weights, n, rng)
+	}
+	return nil, errors.Errorf("Unable to perform SampleCategorical. Engine %T does not support that.", weights.Engine())
+}
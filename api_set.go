@@ -0,0 +1,51 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// Unique returns the sorted unique values of t, which may be of any ordered Dtype, including String. NaNs are equal to each other and sort last.
+//
+// With AllAxes, t is flattened and unique is a vector. Otherwise, the slices of t along the axis are compared lexicographically,
+// and unique holds the sorted unique slices along that axis.
+//
+// If withCounts is true, counts is an Int vector holding the number of occurrences of each unique value (or slice).
+// If withInverse is true, inverse is an Int tensor holding the index in unique of each value of t (with the shape of t),
+// or of each slice along the axis. Otherwise they are nil.
+func Unique(t Tensor, axis int, withCounts, withInverse bool) (unique, counts, inverse Tensor, err error) {
+	if s, ok := t.Engine().(SetOper); ok {
+		return s.Unique(t, axis, withCounts, withInverse)
+	}
+	return nil, nil, nil, errors.Errorf("Unable to perform Unique. Engine %T does not support that.", t.Engine())
+}
+
+// Intersect1D returns a vector of the sorted unique values found in both a and b, which must have the same ordered Dtype. Both are flattened.
+func Intersect1D(a, b Tensor) (retVal Tensor, err error) {
+	if s, ok := a.Engine().(SetOper); ok {
+		return s.Intersect1D(a, b)
+	}
+	return nil, errors.Errorf("Unable to perform Intersect1D. Engine %T does not support that.", a.Engine())
+}
+
+// Union1D returns a vector of the sorted unique values found in either a or b, which must have the same ordered Dtype. Both are flattened.
+func Union1D(a, b Tensor) (retVal Tensor, err error) {
+	if s, ok := a.Engine().(SetOper); ok {
+		return s.Union1D(a, b)
+	}
+	return nil, errors.Errorf("Unable to perform Union1D. Engine %T does not support that.", a.Engine())
+}
+
+// SetDiff1D returns a vector of the sorted unique values of a that are not in b, which must have the same ordered Dtype. Both are flattened.
+func SetDiff1D(a, b Tensor) (retVal Tensor, err error) {
+	if s, ok := a.Engine().(SetOper); ok {
+		return s.SetDiff1D(a, b)
+	}
+	return nil, errors.Errorf("Unable to perform SetDiff1D. Engine %T does not support that.", a.Engine())
+}
+
+// Isin returns a Bool tensor with the shape of t, which is true where the value of t is one of the values of test.
+// t and test must have the same ordered Dtype.
+func Isin(t, test Tensor) (retVal Tensor, err error) {
+	if s, ok := t.Engine().(SetOper); ok {
+		return s.Isin(t, test)
+	}
+	return nil, errors.Errorf("Unable to perform Isin. Engine %T does not support that.", t.Engine())
+}
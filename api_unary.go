@@ -112,6 +112,24 @@ func InvSqrt(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	return
 }
 
+func Not(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	e := a.Engine()
+	if noter, ok := e.(Noter); ok {
+		return noter.Not(a, opts...)
+	}
+	err = errors.Errorf("Engine does not perform Not")
+	return
+}
+
+func BitNot(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	e := a.Engine()
+	if bitnoter, ok := e.(BitNoter); ok {
+		return bitnoter.BitNot(a, opts...)
+	}
+	err = errors.Errorf("Engine does not perform BitNot")
+	return
+}
+
 func Abs(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	e := a.Engine()
 	if abser, ok := e.(Abser); ok {
@@ -195,6 +195,32 @@ func TestCbrt(t *testing.T) {
 		t.Errorf("Inv tests for Cbrt failed: %v", err)
 	}
 }
+func TestBitNot(t *testing.T) {
+	invFn := func(q *Dense) bool {
+		a := q.Clone().(*Dense)
+		correct := a.Clone().(*Dense)
+		we, willFailEq := willerr(a, integerTypes, nil)
+		_, ok := q.Engine().(BitNoter)
+		we = we || !ok
+
+		ret, err := BitNot(a)
+		if err, retEarly := qcErrCheck(t, "BitNot", a, nil, we, err); retEarly {
+			if err != nil {
+				return false
+			}
+			return true
+		}
+		BitNot(ret, UseUnsafe())
+		if !qcEqCheck(t, a.Dtype(), willFailEq, correct.Data(), ret.Data()) {
+			return false
+		}
+		return true
+	}
+
+	if err := quick.Check(invFn, &quick.Config{Rand: newRand(), MaxCount: quickchecks}); err != nil {
+		t.Errorf("Inv tests for BitNot failed: %v", err)
+	}
+}
 func TestNeg_unsafe(t *testing.T) {
 	invFn := func(q *Dense) bool {
 		a := q.Clone().(*Dense)
@@ -418,6 +444,37 @@ func TestCbrt_unsafe(t *testing.T) {
 		t.Errorf("Inv tests for Cbrt failed: %v", err)
 	}
 }
+func TestBitNot_unsafe(t *testing.T) {
+	invFn := func(q *Dense) bool {
+		a := q.Clone().(*Dense)
+		correct := a.Clone().(*Dense)
+		we, willFailEq := willerr(a, integerTypes, nil)
+		_, ok := q.Engine().(BitNoter)
+		we = we || !ok
+
+		ret, err := BitNot(a, UseUnsafe())
+		if err, retEarly := qcErrCheck(t, "BitNot", a, nil, we, err); retEarly {
+			if err != nil {
+				return false
+			}
+			return true
+		}
+		BitNot(ret, UseUnsafe())
+		if !qcEqCheck(t, a.Dtype(), willFailEq, correct.Data(), ret.Data()) {
+			return false
+		}
+		if ret != a {
+			t.Errorf("Expected ret to be the same as a")
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(invFn, &quick.Config{Rand: newRand(), MaxCount: quickchecks}); err != nil {
+		t.Errorf("Inv tests for BitNot failed: %v", err)
+	}
+}
 func TestNeg_reuse(t *testing.T) {
 	invFn := func(q *Dense) bool {
 		a := q.Clone().(*Dense)
@@ -648,6 +705,38 @@ func TestCbrt_reuse(t *testing.T) {
 		t.Errorf("Inv tests for Cbrt failed: %v", err)
 	}
 }
+func TestBitNot_reuse(t *testing.T) {
+	invFn := func(q *Dense) bool {
+		a := q.Clone().(*Dense)
+		reuse := New(Of(a.t), WithShape(a.Shape().Clone()...))
+		correct := a.Clone().(*Dense)
+		we, willFailEq := willerr(a, integerTypes, nil)
+		_, ok := q.Engine().(BitNoter)
+		we = we || !ok
+
+		ret, err := BitNot(a, WithReuse(reuse))
+		if err, retEarly := qcErrCheck(t, "BitNot", a, nil, we, err); retEarly {
+			if err != nil {
+				return false
+			}
+			return true
+		}
+		BitNot(ret, UseUnsafe())
+		if !qcEqCheck(t, a.Dtype(), willFailEq, correct.Data(), ret.Data()) {
+			return false
+		}
+		if reuse != ret {
+			t.Errorf("Expected reuse to be the same as retVal")
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(invFn, &quick.Config{Rand: newRand(), MaxCount: quickchecks}); err != nil {
+		t.Errorf("Inv tests for BitNot failed: %v", err)
+	}
+}
 func TestNeg_incr(t *testing.T) {
 	invFn := func(q *Dense) bool {
 		a := q.Clone().(*Dense)
@@ -885,3 +974,36 @@ func TestCbrt_incr(t *testing.T) {
 		t.Errorf("Inv tests for Cbrt failed: %v", err)
 	}
 }
+func TestBitNot_incr(t *testing.T) {
+	invFn := func(q *Dense) bool {
+		a := q.Clone().(*Dense)
+		incr := New(Of(a.t), WithShape(a.Shape().Clone()...))
+		correct := a.Clone().(*Dense)
+		incr.Memset(identityVal(100, a.t))
+		correct.Add(incr, UseUnsafe())
+		we, willFailEq := willerr(a, integerTypes, nil)
+		_, ok := q.Engine().(BitNoter)
+		we = we || !ok
+
+		ret, err := BitNot(a, WithIncr(incr))
+		if err, retEarly := qcErrCheck(t, "BitNot", a, nil, we, err); retEarly {
+			if err != nil {
+				return false
+			}
+			return true
+		}
+		if ret, err = Sub(ret, identityVal(100, a.Dtype()), UseUnsafe()); err != nil {
+			t.Errorf("err while subtracting incr: %v", err)
+			return false
+		}
+		BitNot(ret, UseUnsafe())
+		if !qcEqCheck(t, a.Dtype(), willFailEq, correct.Data(), ret.Data()) {
+			return false
+		}
+		return true
+	}
+
+	if err := quick.Check(invFn, &quick.Config{Rand: newRand(), MaxCount: quickchecks}); err != nil {
+		t.Errorf("Inv tests for BitNot failed: %v", err)
+	}
+}
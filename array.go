@@ -341,17 +341,29 @@ func copyDenseIter(dst, src DenseTensor, diter, siter Iterator) (int, error) {
 		siter = FlatIteratorFromDense(src)
 	}
 
-	// if it's a masked tensor, we copy the mask as well
+	// if it's a masked tensor, we copy the mask as well. The mask is laid out like the data, so it follows the same iterators.
 	if ms, ok := src.(MaskedTensor); ok && ms.IsMasked() {
 		if md, ok := dst.(MaskedTensor); ok {
 			dmask := md.Mask()
 			smask := ms.Mask()
-			if cap(dmask) < len(smask) {
-				dmask = make([]bool, len(smask))
+			if len(dmask) < dst.len() {
+				dmask = make([]bool, dst.len())
 				copy(dmask, md.Mask())
 				md.SetMask(dmask)
 			}
-			copy(dmask, smask)
+			for {
+				i, err := diter.Next()
+				if err != nil {
+					break
+				}
+				j, err := siter.Next()
+				if err != nil {
+					break
+				}
+				dmask[i] = smask[j]
+			}
+			diter.Reset()
+			siter.Reset()
 		}
 	}
 	return storage.CopyIter(dst.rtype(), dst.hdr(), src.hdr(), diter, siter), nil
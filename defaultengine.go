@@ -67,3 +67,29 @@ func (e StdEng) checkAccessible(t Tensor) error {
 	}
 	return nil
 }
+
+// checkWritable checks that the data of t may be written to through t.
+func checkWritable(t Tensor) error {
+	if ro, ok := t.(interface{ IsReadOnly() bool }); ok && ro.IsReadOnly() {
+		return errors.Errorf(readOnlyData, t)
+	}
+	return nil
+}
+
+// unconjugated returns t, with the conjugation of a lazily conjugated view applied, so that its data can be read as is.
+func unconjugated(t DenseTensor) DenseTensor {
+	if d, ok := t.(*Dense); ok && d.IsConjugated() {
+		return d.Materialize().(*Dense)
+	}
+	return t
+}
+
+// checkUnconjugated checks that the data of t may be read as is, which is not the case of lazily conjugated views.
+func checkUnconjugated(ts ...Tensor) error {
+	for _, t := range ts {
+		if c, ok := t.(interface{ IsConjugated() bool }); ok && c.IsConjugated() {
+			return errors.Errorf(conjugatedData, t)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+var (
+	_ Anyer = StdEng{}
+	_ Aller = StdEng{}
+)
+
+// Any tests whether any value is true along the given axes. See the package level function Any.
+func (e StdEng) Any(a Tensor, along ...int) (retVal Tensor, err error) {
+	return e.anyAll(a, along, false, "Any")
+}
+
+// All tests whether all the values are true along the given axes. See the package level function All.
+func (e StdEng) All(a Tensor, along ...int) (retVal Tensor, err error) {
+	return e.anyAll(a, along, true, "All")
+}
+
+// anyAll reduces the groups of values along the axes to all, unless one of the values is not all.
+func (e StdEng) anyAll(t Tensor, along []int, all bool, op string) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, op); err != nil {
+		return nil, err
+	}
+	if x.Dtype() != Bool {
+		return nil, errors.Errorf(unsupportedDtype, x.Dtype(), op)
+	}
+	dims := x.Dims()
+	if len(along) == 0 {
+		along = make([]int, dims)
+		for i := range along {
+			along[i] = i
+		}
+	}
+	var axes []int
+	if axes, err = resolveAxes(along, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+	g := newNormGeom(x.Shape(), axes, false)
+
+	hdr := x.arr().Header
+	data := hdr.Bools()
+	out := make([]bool, len(g.base))
+	for grp, base := range g.base {
+		out[grp] = all
+		for _, r := range g.rel {
+			if data[base+r] != all {
+				out[grp] = !all
+				break
+			}
+		}
+	}
+	if len(g.keptShape) == 0 {
+		return New(FromScalar(out[0]), WithEngine(e)), nil
+	}
+	return New(WithShape(g.keptShape...), WithBacking(out), WithEngine(e)), nil
+}
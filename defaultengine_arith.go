@@ -19,6 +19,9 @@ func (e StdEng) Add(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err err
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Add")
+	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
 	var ait, bit, iit Iterator
@@ -83,6 +86,9 @@ func (e StdEng) Sub(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err err
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Sub")
+	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
 	var ait, bit, iit Iterator
@@ -147,6 +153,9 @@ func (e StdEng) Mul(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err err
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Mul")
+	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
 	var ait, bit, iit Iterator
@@ -211,6 +220,9 @@ func (e StdEng) Div(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err err
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Div")
+	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
 	var ait, bit, iit Iterator
@@ -275,6 +287,9 @@ func (e StdEng) Pow(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err err
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Pow")
+	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
 	var ait, bit, iit Iterator
@@ -339,6 +354,9 @@ func (e StdEng) Mod(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err err
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Mod")
+	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
 	var ait, bit, iit Iterator
@@ -407,6 +425,9 @@ func (e StdEng) AddScalar(t Tensor, s interface{}, leftTensor bool, opts ...Func
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Add")
+	}
 	a := t
 	typ := t.Dtype().Type
 	var ait, bit, iit Iterator
@@ -510,6 +531,9 @@ func (e StdEng) SubScalar(t Tensor, s interface{}, leftTensor bool, opts ...Func
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Sub")
+	}
 	a := t
 	typ := t.Dtype().Type
 	var ait, bit, iit Iterator
@@ -613,6 +637,9 @@ func (e StdEng) MulScalar(t Tensor, s interface{}, leftTensor bool, opts ...Func
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Mul")
+	}
 	a := t
 	typ := t.Dtype().Type
 	var ait, bit, iit Iterator
@@ -716,6 +743,9 @@ func (e StdEng) DivScalar(t Tensor, s interface{}, leftTensor bool, opts ...Func
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Div")
+	}
 	a := t
 	typ := t.Dtype().Type
 	var ait, bit, iit Iterator
@@ -819,6 +849,9 @@ func (e StdEng) PowScalar(t Tensor, s interface{}, leftTensor bool, opts ...Func
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Pow")
+	}
 	a := t
 	typ := t.Dtype().Type
 	var ait, bit, iit Iterator
@@ -922,6 +955,9 @@ func (e StdEng) ModScalar(t Tensor, s interface{}, leftTensor bool, opts ...Func
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Mod")
+	}
 	a := t
 	typ := t.Dtype().Type
 	var ait, bit, iit Iterator
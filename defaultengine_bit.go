@@ -0,0 +1,1344 @@
+// Code generated by genlib2. DO NOT EDIT.
+
+package tensor
+
+import (
+	"github.com/pkg/errors"
+	"gorgonia.org/tensor/internal/storage"
+)
+
+// And performs a ∧ b elementwise. Both a and b must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) And(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = binaryCheck(a, b, boolTypes); err != nil {
+		return nil, errors.Wrapf(err, "And failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.And")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "And")
+	}
+	typ := a.Dtype().Type
+	var dataA, dataB, dataReuse *storage.Header
+	var ait, bit, iit Iterator
+	var useIter, swap bool
+	if dataA, dataB, dataReuse, ait, bit, iit, useIter, swap, err = prepDataVV(a, b, reuse); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.And")
+	}
+	if useIter {
+		switch {
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.AndIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case !safe:
+			err = e.E.AndIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			if swap {
+				retVal = b.Clone().(Tensor)
+			} else {
+				retVal = a.Clone().(Tensor)
+			}
+			err = e.E.AndIter(typ, retVal.hdr(), dataB, ait, bit)
+		}
+		return
+	}
+	switch {
+	case toReuse:
+		err = e.E.AndRecv(typ, dataA, dataB, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.And(typ, dataA, dataB)
+		retVal = a
+	default:
+		if swap {
+			retVal = b.Clone().(Tensor)
+		} else {
+			retVal = a.Clone().(Tensor)
+		}
+		err = e.E.And(typ, retVal.hdr(), dataB)
+	}
+	return
+}
+
+// Or performs a ∨ b elementwise. Both a and b must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) Or(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = binaryCheck(a, b, boolTypes); err != nil {
+		return nil, errors.Wrapf(err, "Or failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Or")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "Or")
+	}
+	typ := a.Dtype().Type
+	var dataA, dataB, dataReuse *storage.Header
+	var ait, bit, iit Iterator
+	var useIter, swap bool
+	if dataA, dataB, dataReuse, ait, bit, iit, useIter, swap, err = prepDataVV(a, b, reuse); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Or")
+	}
+	if useIter {
+		switch {
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.OrIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case !safe:
+			err = e.E.OrIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			if swap {
+				retVal = b.Clone().(Tensor)
+			} else {
+				retVal = a.Clone().(Tensor)
+			}
+			err = e.E.OrIter(typ, retVal.hdr(), dataB, ait, bit)
+		}
+		return
+	}
+	switch {
+	case toReuse:
+		err = e.E.OrRecv(typ, dataA, dataB, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.Or(typ, dataA, dataB)
+		retVal = a
+	default:
+		if swap {
+			retVal = b.Clone().(Tensor)
+		} else {
+			retVal = a.Clone().(Tensor)
+		}
+		err = e.E.Or(typ, retVal.hdr(), dataB)
+	}
+	return
+}
+
+// Xor performs a ⊕ b elementwise. Both a and b must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) Xor(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = binaryCheck(a, b, boolTypes); err != nil {
+		return nil, errors.Wrapf(err, "Xor failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Xor")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "Xor")
+	}
+	typ := a.Dtype().Type
+	var dataA, dataB, dataReuse *storage.Header
+	var ait, bit, iit Iterator
+	var useIter, swap bool
+	if dataA, dataB, dataReuse, ait, bit, iit, useIter, swap, err = prepDataVV(a, b, reuse); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Xor")
+	}
+	if useIter {
+		switch {
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.XorIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case !safe:
+			err = e.E.XorIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			if swap {
+				retVal = b.Clone().(Tensor)
+			} else {
+				retVal = a.Clone().(Tensor)
+			}
+			err = e.E.XorIter(typ, retVal.hdr(), dataB, ait, bit)
+		}
+		return
+	}
+	switch {
+	case toReuse:
+		err = e.E.XorRecv(typ, dataA, dataB, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.Xor(typ, dataA, dataB)
+		retVal = a
+	default:
+		if swap {
+			retVal = b.Clone().(Tensor)
+		} else {
+			retVal = a.Clone().(Tensor)
+		}
+		err = e.E.Xor(typ, retVal.hdr(), dataB)
+	}
+	return
+}
+
+// BitAnd performs a & b elementwise. Both a and b must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) BitAnd(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = binaryCheck(a, b, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "BitAnd failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitAnd")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "BitAnd")
+	}
+	typ := a.Dtype().Type
+	var dataA, dataB, dataReuse *storage.Header
+	var ait, bit, iit Iterator
+	var useIter, swap bool
+	if dataA, dataB, dataReuse, ait, bit, iit, useIter, swap, err = prepDataVV(a, b, reuse); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitAnd")
+	}
+	if useIter {
+		switch {
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.BitAndIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case !safe:
+			err = e.E.BitAndIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			if swap {
+				retVal = b.Clone().(Tensor)
+			} else {
+				retVal = a.Clone().(Tensor)
+			}
+			err = e.E.BitAndIter(typ, retVal.hdr(), dataB, ait, bit)
+		}
+		return
+	}
+	switch {
+	case toReuse:
+		err = e.E.BitAndRecv(typ, dataA, dataB, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.BitAnd(typ, dataA, dataB)
+		retVal = a
+	default:
+		if swap {
+			retVal = b.Clone().(Tensor)
+		} else {
+			retVal = a.Clone().(Tensor)
+		}
+		err = e.E.BitAnd(typ, retVal.hdr(), dataB)
+	}
+	return
+}
+
+// BitOr performs a | b elementwise. Both a and b must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) BitOr(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = binaryCheck(a, b, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "BitOr failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitOr")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "BitOr")
+	}
+	typ := a.Dtype().Type
+	var dataA, dataB, dataReuse *storage.Header
+	var ait, bit, iit Iterator
+	var useIter, swap bool
+	if dataA, dataB, dataReuse, ait, bit, iit, useIter, swap, err = prepDataVV(a, b, reuse); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitOr")
+	}
+	if useIter {
+		switch {
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.BitOrIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case !safe:
+			err = e.E.BitOrIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			if swap {
+				retVal = b.Clone().(Tensor)
+			} else {
+				retVal = a.Clone().(Tensor)
+			}
+			err = e.E.BitOrIter(typ, retVal.hdr(), dataB, ait, bit)
+		}
+		return
+	}
+	switch {
+	case toReuse:
+		err = e.E.BitOrRecv(typ, dataA, dataB, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.BitOr(typ, dataA, dataB)
+		retVal = a
+	default:
+		if swap {
+			retVal = b.Clone().(Tensor)
+		} else {
+			retVal = a.Clone().(Tensor)
+		}
+		err = e.E.BitOr(typ, retVal.hdr(), dataB)
+	}
+	return
+}
+
+// BitXor performs a ^ b elementwise. Both a and b must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) BitXor(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = binaryCheck(a, b, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "BitXor failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitXor")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "BitXor")
+	}
+	typ := a.Dtype().Type
+	var dataA, dataB, dataReuse *storage.Header
+	var ait, bit, iit Iterator
+	var useIter, swap bool
+	if dataA, dataB, dataReuse, ait, bit, iit, useIter, swap, err = prepDataVV(a, b, reuse); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitXor")
+	}
+	if useIter {
+		switch {
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.BitXorIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case !safe:
+			err = e.E.BitXorIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			if swap {
+				retVal = b.Clone().(Tensor)
+			} else {
+				retVal = a.Clone().(Tensor)
+			}
+			err = e.E.BitXorIter(typ, retVal.hdr(), dataB, ait, bit)
+		}
+		return
+	}
+	switch {
+	case toReuse:
+		err = e.E.BitXorRecv(typ, dataA, dataB, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.BitXor(typ, dataA, dataB)
+		retVal = a
+	default:
+		if swap {
+			retVal = b.Clone().(Tensor)
+		} else {
+			retVal = a.Clone().(Tensor)
+		}
+		err = e.E.BitXor(typ, retVal.hdr(), dataB)
+	}
+	return
+}
+
+// Shl performs a << b elementwise. Both a and b must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) Shl(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = binaryCheck(a, b, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "Shl failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Shl")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "Shl")
+	}
+	typ := a.Dtype().Type
+	var dataA, dataB, dataReuse *storage.Header
+	var ait, bit, iit Iterator
+	var useIter, swap bool
+	if dataA, dataB, dataReuse, ait, bit, iit, useIter, swap, err = prepDataVV(a, b, reuse); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Shl")
+	}
+	if useIter {
+		switch {
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.ShlIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case !safe:
+			err = e.E.ShlIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			if swap {
+				retVal = b.Clone().(Tensor)
+			} else {
+				retVal = a.Clone().(Tensor)
+			}
+			err = e.E.ShlIter(typ, retVal.hdr(), dataB, ait, bit)
+		}
+		return
+	}
+	switch {
+	case toReuse:
+		err = e.E.ShlRecv(typ, dataA, dataB, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.Shl(typ, dataA, dataB)
+		retVal = a
+	default:
+		if swap {
+			retVal = b.Clone().(Tensor)
+		} else {
+			retVal = a.Clone().(Tensor)
+		}
+		err = e.E.Shl(typ, retVal.hdr(), dataB)
+	}
+	return
+}
+
+// Shr performs a >> b elementwise. Both a and b must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) Shr(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = binaryCheck(a, b, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "Shr failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Shr")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "Shr")
+	}
+	typ := a.Dtype().Type
+	var dataA, dataB, dataReuse *storage.Header
+	var ait, bit, iit Iterator
+	var useIter, swap bool
+	if dataA, dataB, dataReuse, ait, bit, iit, useIter, swap, err = prepDataVV(a, b, reuse); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Shr")
+	}
+	if useIter {
+		switch {
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.ShrIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case !safe:
+			err = e.E.ShrIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			if swap {
+				retVal = b.Clone().(Tensor)
+			} else {
+				retVal = a.Clone().(Tensor)
+			}
+			err = e.E.ShrIter(typ, retVal.hdr(), dataB, ait, bit)
+		}
+		return
+	}
+	switch {
+	case toReuse:
+		err = e.E.ShrRecv(typ, dataA, dataB, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.Shr(typ, dataA, dataB)
+		retVal = a
+	default:
+		if swap {
+			retVal = b.Clone().(Tensor)
+		} else {
+			retVal = a.Clone().(Tensor)
+		}
+		err = e.E.Shr(typ, retVal.hdr(), dataB)
+	}
+	return
+}
+
+// AndScalar performs t ∧ s elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in s.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) AndScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(t, boolTypes); err != nil {
+		return nil, errors.Wrapf(err, "And failed")
+	}
+
+	if err = scalarDtypeCheck(t, s); err != nil {
+		return nil, errors.Wrap(err, "And failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.And")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "And")
+	}
+	a := t
+	typ := t.Dtype().Type
+	var ait, bit, iit Iterator
+	var dataA, dataB, dataReuse, scalarHeader *storage.Header
+	var useIter, newAlloc bool
+
+	if leftTensor {
+		if dataA, dataB, dataReuse, ait, iit, useIter, newAlloc, err = prepDataVS(t, s, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.And")
+		}
+		scalarHeader = dataB
+	} else {
+		if dataA, dataB, dataReuse, bit, iit, useIter, newAlloc, err = prepDataSV(s, t, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.And")
+		}
+		scalarHeader = dataA
+	}
+
+	if useIter {
+		switch {
+		case toReuse && leftTensor:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.AndIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case toReuse && !leftTensor:
+			storage.CopyIter(typ, dataReuse, dataB, iit, bit)
+			iit.Reset()
+			bit.Reset()
+			err = e.E.AndIter(typ, dataA, dataReuse, ait, iit)
+			retVal = reuse
+		case !safe:
+			err = e.E.AndIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			retVal = a.Clone().(Tensor)
+			if leftTensor {
+				err = e.E.AndIter(typ, retVal.hdr(), dataB, ait, bit)
+			} else {
+				err = e.E.AndIter(typ, dataA, retVal.hdr(), ait, bit)
+			}
+		}
+		if newAlloc {
+			freeScalar(scalarHeader.Raw)
+		}
+		returnHeader(scalarHeader)
+		return
+	}
+	switch {
+	case toReuse && leftTensor:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.And(typ, dataReuse, dataB)
+		retVal = reuse
+	case toReuse && !leftTensor:
+		storage.Copy(typ, dataReuse, dataB)
+		err = e.E.And(typ, dataA, dataReuse)
+		if t.Shape().IsScalarEquiv() {
+			storage.Copy(typ, dataReuse, dataA)
+		}
+		retVal = reuse
+	case !safe:
+		err = e.E.And(typ, dataA, dataB)
+		if t.Shape().IsScalarEquiv() && !leftTensor {
+			storage.Copy(typ, dataB, dataA)
+		}
+		retVal = a
+	default:
+		retVal = a.Clone().(Tensor)
+		if !leftTensor {
+			storage.Fill(typ, retVal.hdr(), dataA)
+		}
+		err = e.E.And(typ, retVal.hdr(), dataB)
+	}
+	if newAlloc {
+		freeScalar(scalarHeader.Raw)
+	}
+	returnHeader(scalarHeader)
+	return
+}
+
+// OrScalar performs t ∨ s elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in s.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) OrScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(t, boolTypes); err != nil {
+		return nil, errors.Wrapf(err, "Or failed")
+	}
+
+	if err = scalarDtypeCheck(t, s); err != nil {
+		return nil, errors.Wrap(err, "Or failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Or")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "Or")
+	}
+	a := t
+	typ := t.Dtype().Type
+	var ait, bit, iit Iterator
+	var dataA, dataB, dataReuse, scalarHeader *storage.Header
+	var useIter, newAlloc bool
+
+	if leftTensor {
+		if dataA, dataB, dataReuse, ait, iit, useIter, newAlloc, err = prepDataVS(t, s, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.Or")
+		}
+		scalarHeader = dataB
+	} else {
+		if dataA, dataB, dataReuse, bit, iit, useIter, newAlloc, err = prepDataSV(s, t, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.Or")
+		}
+		scalarHeader = dataA
+	}
+
+	if useIter {
+		switch {
+		case toReuse && leftTensor:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.OrIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case toReuse && !leftTensor:
+			storage.CopyIter(typ, dataReuse, dataB, iit, bit)
+			iit.Reset()
+			bit.Reset()
+			err = e.E.OrIter(typ, dataA, dataReuse, ait, iit)
+			retVal = reuse
+		case !safe:
+			err = e.E.OrIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			retVal = a.Clone().(Tensor)
+			if leftTensor {
+				err = e.E.OrIter(typ, retVal.hdr(), dataB, ait, bit)
+			} else {
+				err = e.E.OrIter(typ, dataA, retVal.hdr(), ait, bit)
+			}
+		}
+		if newAlloc {
+			freeScalar(scalarHeader.Raw)
+		}
+		returnHeader(scalarHeader)
+		return
+	}
+	switch {
+	case toReuse && leftTensor:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.Or(typ, dataReuse, dataB)
+		retVal = reuse
+	case toReuse && !leftTensor:
+		storage.Copy(typ, dataReuse, dataB)
+		err = e.E.Or(typ, dataA, dataReuse)
+		if t.Shape().IsScalarEquiv() {
+			storage.Copy(typ, dataReuse, dataA)
+		}
+		retVal = reuse
+	case !safe:
+		err = e.E.Or(typ, dataA, dataB)
+		if t.Shape().IsScalarEquiv() && !leftTensor {
+			storage.Copy(typ, dataB, dataA)
+		}
+		retVal = a
+	default:
+		retVal = a.Clone().(Tensor)
+		if !leftTensor {
+			storage.Fill(typ, retVal.hdr(), dataA)
+		}
+		err = e.E.Or(typ, retVal.hdr(), dataB)
+	}
+	if newAlloc {
+		freeScalar(scalarHeader.Raw)
+	}
+	returnHeader(scalarHeader)
+	return
+}
+
+// XorScalar performs t ⊕ s elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in s.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) XorScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(t, boolTypes); err != nil {
+		return nil, errors.Wrapf(err, "Xor failed")
+	}
+
+	if err = scalarDtypeCheck(t, s); err != nil {
+		return nil, errors.Wrap(err, "Xor failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Xor")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "Xor")
+	}
+	a := t
+	typ := t.Dtype().Type
+	var ait, bit, iit Iterator
+	var dataA, dataB, dataReuse, scalarHeader *storage.Header
+	var useIter, newAlloc bool
+
+	if leftTensor {
+		if dataA, dataB, dataReuse, ait, iit, useIter, newAlloc, err = prepDataVS(t, s, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.Xor")
+		}
+		scalarHeader = dataB
+	} else {
+		if dataA, dataB, dataReuse, bit, iit, useIter, newAlloc, err = prepDataSV(s, t, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.Xor")
+		}
+		scalarHeader = dataA
+	}
+
+	if useIter {
+		switch {
+		case toReuse && leftTensor:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.XorIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case toReuse && !leftTensor:
+			storage.CopyIter(typ, dataReuse, dataB, iit, bit)
+			iit.Reset()
+			bit.Reset()
+			err = e.E.XorIter(typ, dataA, dataReuse, ait, iit)
+			retVal = reuse
+		case !safe:
+			err = e.E.XorIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			retVal = a.Clone().(Tensor)
+			if leftTensor {
+				err = e.E.XorIter(typ, retVal.hdr(), dataB, ait, bit)
+			} else {
+				err = e.E.XorIter(typ, dataA, retVal.hdr(), ait, bit)
+			}
+		}
+		if newAlloc {
+			freeScalar(scalarHeader.Raw)
+		}
+		returnHeader(scalarHeader)
+		return
+	}
+	switch {
+	case toReuse && leftTensor:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.Xor(typ, dataReuse, dataB)
+		retVal = reuse
+	case toReuse && !leftTensor:
+		storage.Copy(typ, dataReuse, dataB)
+		err = e.E.Xor(typ, dataA, dataReuse)
+		if t.Shape().IsScalarEquiv() {
+			storage.Copy(typ, dataReuse, dataA)
+		}
+		retVal = reuse
+	case !safe:
+		err = e.E.Xor(typ, dataA, dataB)
+		if t.Shape().IsScalarEquiv() && !leftTensor {
+			storage.Copy(typ, dataB, dataA)
+		}
+		retVal = a
+	default:
+		retVal = a.Clone().(Tensor)
+		if !leftTensor {
+			storage.Fill(typ, retVal.hdr(), dataA)
+		}
+		err = e.E.Xor(typ, retVal.hdr(), dataB)
+	}
+	if newAlloc {
+		freeScalar(scalarHeader.Raw)
+	}
+	returnHeader(scalarHeader)
+	return
+}
+
+// BitAndScalar performs t & s elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in s.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) BitAndScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(t, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "BitAnd failed")
+	}
+
+	if err = scalarDtypeCheck(t, s); err != nil {
+		return nil, errors.Wrap(err, "BitAnd failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitAnd")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "BitAnd")
+	}
+	a := t
+	typ := t.Dtype().Type
+	var ait, bit, iit Iterator
+	var dataA, dataB, dataReuse, scalarHeader *storage.Header
+	var useIter, newAlloc bool
+
+	if leftTensor {
+		if dataA, dataB, dataReuse, ait, iit, useIter, newAlloc, err = prepDataVS(t, s, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.BitAnd")
+		}
+		scalarHeader = dataB
+	} else {
+		if dataA, dataB, dataReuse, bit, iit, useIter, newAlloc, err = prepDataSV(s, t, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.BitAnd")
+		}
+		scalarHeader = dataA
+	}
+
+	if useIter {
+		switch {
+		case toReuse && leftTensor:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.BitAndIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case toReuse && !leftTensor:
+			storage.CopyIter(typ, dataReuse, dataB, iit, bit)
+			iit.Reset()
+			bit.Reset()
+			err = e.E.BitAndIter(typ, dataA, dataReuse, ait, iit)
+			retVal = reuse
+		case !safe:
+			err = e.E.BitAndIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			retVal = a.Clone().(Tensor)
+			if leftTensor {
+				err = e.E.BitAndIter(typ, retVal.hdr(), dataB, ait, bit)
+			} else {
+				err = e.E.BitAndIter(typ, dataA, retVal.hdr(), ait, bit)
+			}
+		}
+		if newAlloc {
+			freeScalar(scalarHeader.Raw)
+		}
+		returnHeader(scalarHeader)
+		return
+	}
+	switch {
+	case toReuse && leftTensor:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.BitAnd(typ, dataReuse, dataB)
+		retVal = reuse
+	case toReuse && !leftTensor:
+		storage.Copy(typ, dataReuse, dataB)
+		err = e.E.BitAnd(typ, dataA, dataReuse)
+		if t.Shape().IsScalarEquiv() {
+			storage.Copy(typ, dataReuse, dataA)
+		}
+		retVal = reuse
+	case !safe:
+		err = e.E.BitAnd(typ, dataA, dataB)
+		if t.Shape().IsScalarEquiv() && !leftTensor {
+			storage.Copy(typ, dataB, dataA)
+		}
+		retVal = a
+	default:
+		retVal = a.Clone().(Tensor)
+		if !leftTensor {
+			storage.Fill(typ, retVal.hdr(), dataA)
+		}
+		err = e.E.BitAnd(typ, retVal.hdr(), dataB)
+	}
+	if newAlloc {
+		freeScalar(scalarHeader.Raw)
+	}
+	returnHeader(scalarHeader)
+	return
+}
+
+// BitOrScalar performs t | s elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in s.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) BitOrScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(t, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "BitOr failed")
+	}
+
+	if err = scalarDtypeCheck(t, s); err != nil {
+		return nil, errors.Wrap(err, "BitOr failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitOr")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "BitOr")
+	}
+	a := t
+	typ := t.Dtype().Type
+	var ait, bit, iit Iterator
+	var dataA, dataB, dataReuse, scalarHeader *storage.Header
+	var useIter, newAlloc bool
+
+	if leftTensor {
+		if dataA, dataB, dataReuse, ait, iit, useIter, newAlloc, err = prepDataVS(t, s, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.BitOr")
+		}
+		scalarHeader = dataB
+	} else {
+		if dataA, dataB, dataReuse, bit, iit, useIter, newAlloc, err = prepDataSV(s, t, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.BitOr")
+		}
+		scalarHeader = dataA
+	}
+
+	if useIter {
+		switch {
+		case toReuse && leftTensor:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.BitOrIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case toReuse && !leftTensor:
+			storage.CopyIter(typ, dataReuse, dataB, iit, bit)
+			iit.Reset()
+			bit.Reset()
+			err = e.E.BitOrIter(typ, dataA, dataReuse, ait, iit)
+			retVal = reuse
+		case !safe:
+			err = e.E.BitOrIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			retVal = a.Clone().(Tensor)
+			if leftTensor {
+				err = e.E.BitOrIter(typ, retVal.hdr(), dataB, ait, bit)
+			} else {
+				err = e.E.BitOrIter(typ, dataA, retVal.hdr(), ait, bit)
+			}
+		}
+		if newAlloc {
+			freeScalar(scalarHeader.Raw)
+		}
+		returnHeader(scalarHeader)
+		return
+	}
+	switch {
+	case toReuse && leftTensor:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.BitOr(typ, dataReuse, dataB)
+		retVal = reuse
+	case toReuse && !leftTensor:
+		storage.Copy(typ, dataReuse, dataB)
+		err = e.E.BitOr(typ, dataA, dataReuse)
+		if t.Shape().IsScalarEquiv() {
+			storage.Copy(typ, dataReuse, dataA)
+		}
+		retVal = reuse
+	case !safe:
+		err = e.E.BitOr(typ, dataA, dataB)
+		if t.Shape().IsScalarEquiv() && !leftTensor {
+			storage.Copy(typ, dataB, dataA)
+		}
+		retVal = a
+	default:
+		retVal = a.Clone().(Tensor)
+		if !leftTensor {
+			storage.Fill(typ, retVal.hdr(), dataA)
+		}
+		err = e.E.BitOr(typ, retVal.hdr(), dataB)
+	}
+	if newAlloc {
+		freeScalar(scalarHeader.Raw)
+	}
+	returnHeader(scalarHeader)
+	return
+}
+
+// BitXorScalar performs t ^ s elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in s.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) BitXorScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(t, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "BitXor failed")
+	}
+
+	if err = scalarDtypeCheck(t, s); err != nil {
+		return nil, errors.Wrap(err, "BitXor failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.BitXor")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "BitXor")
+	}
+	a := t
+	typ := t.Dtype().Type
+	var ait, bit, iit Iterator
+	var dataA, dataB, dataReuse, scalarHeader *storage.Header
+	var useIter, newAlloc bool
+
+	if leftTensor {
+		if dataA, dataB, dataReuse, ait, iit, useIter, newAlloc, err = prepDataVS(t, s, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.BitXor")
+		}
+		scalarHeader = dataB
+	} else {
+		if dataA, dataB, dataReuse, bit, iit, useIter, newAlloc, err = prepDataSV(s, t, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.BitXor")
+		}
+		scalarHeader = dataA
+	}
+
+	if useIter {
+		switch {
+		case toReuse && leftTensor:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.BitXorIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case toReuse && !leftTensor:
+			storage.CopyIter(typ, dataReuse, dataB, iit, bit)
+			iit.Reset()
+			bit.Reset()
+			err = e.E.BitXorIter(typ, dataA, dataReuse, ait, iit)
+			retVal = reuse
+		case !safe:
+			err = e.E.BitXorIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			retVal = a.Clone().(Tensor)
+			if leftTensor {
+				err = e.E.BitXorIter(typ, retVal.hdr(), dataB, ait, bit)
+			} else {
+				err = e.E.BitXorIter(typ, dataA, retVal.hdr(), ait, bit)
+			}
+		}
+		if newAlloc {
+			freeScalar(scalarHeader.Raw)
+		}
+		returnHeader(scalarHeader)
+		return
+	}
+	switch {
+	case toReuse && leftTensor:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.BitXor(typ, dataReuse, dataB)
+		retVal = reuse
+	case toReuse && !leftTensor:
+		storage.Copy(typ, dataReuse, dataB)
+		err = e.E.BitXor(typ, dataA, dataReuse)
+		if t.Shape().IsScalarEquiv() {
+			storage.Copy(typ, dataReuse, dataA)
+		}
+		retVal = reuse
+	case !safe:
+		err = e.E.BitXor(typ, dataA, dataB)
+		if t.Shape().IsScalarEquiv() && !leftTensor {
+			storage.Copy(typ, dataB, dataA)
+		}
+		retVal = a
+	default:
+		retVal = a.Clone().(Tensor)
+		if !leftTensor {
+			storage.Fill(typ, retVal.hdr(), dataA)
+		}
+		err = e.E.BitXor(typ, retVal.hdr(), dataB)
+	}
+	if newAlloc {
+		freeScalar(scalarHeader.Raw)
+	}
+	returnHeader(scalarHeader)
+	return
+}
+
+// ShlScalar performs t << s elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in s.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) ShlScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(t, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "Shl failed")
+	}
+
+	if err = scalarDtypeCheck(t, s); err != nil {
+		return nil, errors.Wrap(err, "Shl failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Shl")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "Shl")
+	}
+	a := t
+	typ := t.Dtype().Type
+	var ait, bit, iit Iterator
+	var dataA, dataB, dataReuse, scalarHeader *storage.Header
+	var useIter, newAlloc bool
+
+	if leftTensor {
+		if dataA, dataB, dataReuse, ait, iit, useIter, newAlloc, err = prepDataVS(t, s, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.Shl")
+		}
+		scalarHeader = dataB
+	} else {
+		if dataA, dataB, dataReuse, bit, iit, useIter, newAlloc, err = prepDataSV(s, t, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.Shl")
+		}
+		scalarHeader = dataA
+	}
+
+	if useIter {
+		switch {
+		case toReuse && leftTensor:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.ShlIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case toReuse && !leftTensor:
+			storage.CopyIter(typ, dataReuse, dataB, iit, bit)
+			iit.Reset()
+			bit.Reset()
+			err = e.E.ShlIter(typ, dataA, dataReuse, ait, iit)
+			retVal = reuse
+		case !safe:
+			err = e.E.ShlIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			retVal = a.Clone().(Tensor)
+			if leftTensor {
+				err = e.E.ShlIter(typ, retVal.hdr(), dataB, ait, bit)
+			} else {
+				err = e.E.ShlIter(typ, dataA, retVal.hdr(), ait, bit)
+			}
+		}
+		if newAlloc {
+			freeScalar(scalarHeader.Raw)
+		}
+		returnHeader(scalarHeader)
+		return
+	}
+	switch {
+	case toReuse && leftTensor:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.Shl(typ, dataReuse, dataB)
+		retVal = reuse
+	case toReuse && !leftTensor:
+		storage.Copy(typ, dataReuse, dataB)
+		err = e.E.Shl(typ, dataA, dataReuse)
+		if t.Shape().IsScalarEquiv() {
+			storage.Copy(typ, dataReuse, dataA)
+		}
+		retVal = reuse
+	case !safe:
+		err = e.E.Shl(typ, dataA, dataB)
+		if t.Shape().IsScalarEquiv() && !leftTensor {
+			storage.Copy(typ, dataB, dataA)
+		}
+		retVal = a
+	default:
+		retVal = a.Clone().(Tensor)
+		if !leftTensor {
+			storage.Fill(typ, retVal.hdr(), dataA)
+		}
+		err = e.E.Shl(typ, retVal.hdr(), dataB)
+	}
+	if newAlloc {
+		freeScalar(scalarHeader.Raw)
+	}
+	returnHeader(scalarHeader)
+	return
+}
+
+// ShrScalar performs t >> s elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in s.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (e StdEng) ShrScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(t, integerTypes); err != nil {
+		return nil, errors.Wrapf(err, "Shr failed")
+	}
+
+	if err = scalarDtypeCheck(t, s); err != nil {
+		return nil, errors.Wrap(err, "Shr failed")
+	}
+
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(t, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.Shr")
+	}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "Shr")
+	}
+	a := t
+	typ := t.Dtype().Type
+	var ait, bit, iit Iterator
+	var dataA, dataB, dataReuse, scalarHeader *storage.Header
+	var useIter, newAlloc bool
+
+	if leftTensor {
+		if dataA, dataB, dataReuse, ait, iit, useIter, newAlloc, err = prepDataVS(t, s, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.Shr")
+		}
+		scalarHeader = dataB
+	} else {
+		if dataA, dataB, dataReuse, bit, iit, useIter, newAlloc, err = prepDataSV(s, t, reuse); err != nil {
+			return nil, errors.Wrapf(err, opFail, "StdEng.Shr")
+		}
+		scalarHeader = dataA
+	}
+
+	if useIter {
+		switch {
+		case toReuse && leftTensor:
+			storage.CopyIter(typ, dataReuse, dataA, iit, ait)
+			ait.Reset()
+			iit.Reset()
+			err = e.E.ShrIter(typ, dataReuse, dataB, iit, bit)
+			retVal = reuse
+		case toReuse && !leftTensor:
+			storage.CopyIter(typ, dataReuse, dataB, iit, bit)
+			iit.Reset()
+			bit.Reset()
+			err = e.E.ShrIter(typ, dataA, dataReuse, ait, iit)
+			retVal = reuse
+		case !safe:
+			err = e.E.ShrIter(typ, dataA, dataB, ait, bit)
+			retVal = a
+		default:
+			retVal = a.Clone().(Tensor)
+			if leftTensor {
+				err = e.E.ShrIter(typ, retVal.hdr(), dataB, ait, bit)
+			} else {
+				err = e.E.ShrIter(typ, dataA, retVal.hdr(), ait, bit)
+			}
+		}
+		if newAlloc {
+			freeScalar(scalarHeader.Raw)
+		}
+		returnHeader(scalarHeader)
+		return
+	}
+	switch {
+	case toReuse && leftTensor:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.Shr(typ, dataReuse, dataB)
+		retVal = reuse
+	case toReuse && !leftTensor:
+		storage.Copy(typ, dataReuse, dataB)
+		err = e.E.Shr(typ, dataA, dataReuse)
+		if t.Shape().IsScalarEquiv() {
+			storage.Copy(typ, dataReuse, dataA)
+		}
+		retVal = reuse
+	case !safe:
+		err = e.E.Shr(typ, dataA, dataB)
+		if t.Shape().IsScalarEquiv() && !leftTensor {
+			storage.Copy(typ, dataB, dataA)
+		}
+		retVal = a
+	default:
+		retVal = a.Clone().(Tensor)
+		if !leftTensor {
+			storage.Fill(typ, retVal.hdr(), dataA)
+		}
+		err = e.E.Shr(typ, retVal.hdr(), dataB)
+	}
+	if newAlloc {
+		freeScalar(scalarHeader.Raw)
+	}
+	returnHeader(scalarHeader)
+	return
+}
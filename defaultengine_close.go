@@ -0,0 +1,165 @@
+package tensor
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/pkg/errors"
+)
+
+var _ IsCloser = StdEng{}
+
+// IsClose compares the values of a and b up to a tolerance. See the package level function IsClose.
+func (e StdEng) IsClose(a, b Tensor, rtol, atol float64, equalNaN bool) (retVal Tensor, err error) {
+	var c closeness
+	if c, err = e.closeness(a, b, rtol, atol, equalNaN, "IsClose"); err != nil {
+		return nil, err
+	}
+	if c.shape.IsScalar() {
+		return New(FromScalar(c.close[0]), WithEngine(e)), nil
+	}
+	return New(WithShape(c.shape.Clone()...), WithBacking(c.close), WithEngine(e)), nil
+}
+
+// AllClose checks that all the values of a and b are close. See the package level function AllClose.
+func (e StdEng) AllClose(a, b Tensor, rtol, atol float64, equalNaN bool) (ok bool, err error) {
+	var c closeness
+	if c, err = e.closeness(a, b, rtol, atol, equalNaN, "AllClose"); err != nil {
+		return false, err
+	}
+
+	// the worst mismatch is the first one that can't be measured (a NaN or an infinity), or else the one furthest apart
+	ne := notCloseError{worst: -1}
+	for i, cl := range c.close {
+		if cl {
+			continue
+		}
+		ne.indices = append(ne.indices, i)
+		switch {
+		case ne.worst < 0:
+			ne.worst = i
+		case math.IsNaN(c.diff[ne.worst]) || math.IsInf(c.diff[ne.worst], 1):
+		case math.IsNaN(c.diff[i]) || c.diff[i] > c.diff[ne.worst]:
+			ne.worst = i
+		}
+	}
+	if len(ne.indices) == 0 {
+		return true, nil
+	}
+
+	ne.size = len(c.close)
+	ne.coord, _ = Itol(ne.worst, c.shape, c.shape.CalcStrides())
+	arrA, arrB := c.a.arr(), c.b.arr()
+	ne.a, ne.b = arrA.Get(ne.worst), arrB.Get(ne.worst)
+	ne.diff = c.diff[ne.worst]
+	ne.tol = c.tol[ne.worst]
+	return false, ne
+}
+
+// closeness is the elementwise comparison of two tensors up to a tolerance.
+type closeness struct {
+	shape Shape
+	a, b  DenseTensor
+
+	close     []bool
+	diff, tol []float64
+}
+
+func (e StdEng) closeness(a, b Tensor, rtol, atol float64, equalNaN bool, op string) (c closeness, err error) {
+	if !(rtol >= 0 && atol >= 0) {
+		return c, errors.Errorf("Expected non-negative tolerances for %v. Got rtol %v and atol %v", op, rtol, atol)
+	}
+	if !a.Shape().Eq(b.Shape()) {
+		return c, errors.Errorf(shapeMismatch, a.Shape(), b.Shape())
+	}
+	if a.Dtype() != b.Dtype() {
+		return c, errors.Errorf(dtypeMismatch, a.Dtype(), b.Dtype())
+	}
+	if err = typeclassCheck(a.Dtype(), floatcmplxTypes); err != nil {
+		return c, errors.Wrapf(err, opFail, op)
+	}
+	if c.a, err = e.contiguous(a, op); err != nil {
+		return c, err
+	}
+	if c.b, err = e.contiguous(b, op); err != nil {
+		return c, err
+	}
+
+	c.shape = a.Shape()
+	n := c.a.len()
+	c.close = make([]bool, n)
+	c.diff = make([]float64, n)
+	c.tol = make([]float64, n)
+	switch a.Dtype() {
+	case Float64:
+		x, y := c.a.Float64s(), c.b.Float64s()
+		for i := range c.close {
+			c.close[i], c.diff[i], c.tol[i] = isCloseF(x[i], y[i], rtol, atol, equalNaN)
+		}
+	case Float32:
+		x, y := c.a.Float32s(), c.b.Float32s()
+		for i := range c.close {
+			c.close[i], c.diff[i], c.tol[i] = isCloseF(float64(x[i]), float64(y[i]), rtol, atol, equalNaN)
+		}
+	case Complex128:
+		x, y := c.a.Complex128s(), c.b.Complex128s()
+		for i := range c.close {
+			c.close[i], c.diff[i], c.tol[i] = isCloseC(x[i], y[i], rtol, atol, equalNaN)
+		}
+	case Complex64:
+		x, y := c.a.Complex64s(), c.b.Complex64s()
+		for i := range c.close {
+			c.close[i], c.diff[i], c.tol[i] = isCloseC(complex128(x[i]), complex128(y[i]), rtol, atol, equalNaN)
+		}
+	}
+	return c, nil
+}
+
+// isCloseF returns whether a is close to b, as well as the distance between them and the tolerance it is compared against.
+func isCloseF(a, b, rtol, atol float64, equalNaN bool) (close bool, diff, tol float64) {
+	tol = atol + rtol*math.Abs(b)
+	switch {
+	case a == b:
+		return true, 0, tol
+	case math.IsNaN(a) || math.IsNaN(b):
+		return equalNaN && math.IsNaN(a) && math.IsNaN(b), math.NaN(), tol
+	case math.IsInf(a, 0) || math.IsInf(b, 0):
+		return false, math.Inf(1), tol
+	}
+	diff = math.Abs(a - b)
+	return diff <= tol, diff, tol
+}
+
+// isCloseC is isCloseF for complex numbers, which are measured by their modulus.
+func isCloseC(a, b complex128, rtol, atol float64, equalNaN bool) (close bool, diff, tol float64) {
+	tol = atol + rtol*cmplx.Abs(b)
+	switch {
+	case a == b:
+		return true, 0, tol
+	case cmplx.IsNaN(a) || cmplx.IsNaN(b):
+		return equalNaN && cmplx.IsNaN(a) && cmplx.IsNaN(b), math.NaN(), tol
+	case cmplx.IsInf(a) || cmplx.IsInf(b):
+		return false, math.Inf(1), tol
+	}
+	diff = cmplx.Abs(a - b)
+	return diff <= tol, diff, tol
+}
+
+// notCloseError describes the worst of the values that are not close in AllClose.
+type notCloseError struct {
+	indices []int
+	size    int
+
+	worst     int
+	coord     []int
+	a, b      interface{}
+	diff, tol float64
+}
+
+func (e notCloseError) Indices() []int { return e.indices }
+
+func (e notCloseError) Error() string {
+	return fmt.Sprintf("%d of %d values are not close. The worst is at %v: %v and %v are %v apart, with a tolerance of %v",
+		len(e.indices), e.size, e.coord, e.a, e.b, e.diff, e.tol)
+}
@@ -23,6 +23,9 @@ func (e StdEng) Gt(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err erro
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Gt")
+		}
 	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
@@ -101,6 +104,9 @@ func (e StdEng) Gte(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err err
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Gte")
+		}
 	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
@@ -179,6 +185,9 @@ func (e StdEng) Lt(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err erro
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Lt")
+		}
 	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
@@ -257,6 +266,9 @@ func (e StdEng) Lte(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err err
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Lte")
+		}
 	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
@@ -335,6 +347,9 @@ func (e StdEng) ElEq(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err er
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Eq")
+		}
 	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
@@ -413,6 +428,9 @@ func (e StdEng) ElNe(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor, err er
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Ne")
+		}
 	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
@@ -495,6 +513,9 @@ func (e StdEng) GtScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncO
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(t); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Gt")
+		}
 	}
 	a := t
 	typ := t.Dtype().Type
@@ -617,6 +638,9 @@ func (e StdEng) GteScalar(t Tensor, s interface{}, leftTensor bool, opts ...Func
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(t); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Gte")
+		}
 	}
 	a := t
 	typ := t.Dtype().Type
@@ -739,6 +763,9 @@ func (e StdEng) LtScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncO
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(t); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Lt")
+		}
 	}
 	a := t
 	typ := t.Dtype().Type
@@ -861,6 +888,9 @@ func (e StdEng) LteScalar(t Tensor, s interface{}, leftTensor bool, opts ...Func
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(t); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Lte")
+		}
 	}
 	a := t
 	typ := t.Dtype().Type
@@ -979,6 +1009,9 @@ func (e StdEng) EqScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncO
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(t); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Eq")
+		}
 	}
 	a := t
 	typ := t.Dtype().Type
@@ -1097,6 +1130,9 @@ func (e StdEng) NeScalar(t Tensor, s interface{}, leftTensor bool, opts ...FuncO
 	}
 	if !safe {
 		same = true
+		if err = checkWritable(t); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Ne")
+		}
 	}
 	a := t
 	typ := t.Dtype().Type
@@ -0,0 +1,166 @@
+package tensor
+
+import (
+	"math/cmplx"
+
+	"github.com/pkg/errors"
+)
+
+var _ Complexer = StdEng{}
+
+// Real returns the real parts of the values of a complex tensor. See the package level function Real.
+func (e StdEng) Real(t Tensor) (retVal Tensor, err error) {
+	return e.complexPart(t, realPart, "Real")
+}
+
+// Imag returns the imaginary parts of the values of a complex tensor. See the package level function Imag.
+func (e StdEng) Imag(t Tensor) (retVal Tensor, err error) {
+	return e.complexPart(t, imagPart, "Imag")
+}
+
+// Angle returns the arguments of the values of a complex tensor. See the package level function Angle.
+func (e StdEng) Angle(t Tensor) (retVal Tensor, err error) {
+	return e.complexPart(t, anglePart, "Angle")
+}
+
+// Magnitude returns the absolute values of the values of a complex tensor. See the package level function Magnitude.
+func (e StdEng) Magnitude(t Tensor) (retVal Tensor, err error) {
+	return e.complexPart(t, magnitudePart, "Magnitude")
+}
+
+// Conj returns the complex conjugates of the values of a complex tensor. See the package level function Conj.
+func (e StdEng) Conj(t Tensor) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, "Conj"); err != nil {
+		return nil, err
+	}
+	if err = typeclassCheck(x.Dtype(), complexTypes); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Conj")
+	}
+
+	ret := New(WithShape(x.Shape().Clone()...), Of(x.Dtype()), WithEngine(e))
+	copyMask(ret, x)
+	switch x.Dtype() {
+	case Complex64:
+		data := ret.Complex64s()
+		for i, v := range x.(unsafeMem).Complex64s() {
+			data[i] = complex(real(v), -imag(v))
+		}
+	case Complex128:
+		data := ret.Complex128s()
+		for i, v := range x.(unsafeMem).Complex128s() {
+			data[i] = cmplx.Conj(v)
+		}
+	}
+	return ret, nil
+}
+
+// Complex builds a complex tensor out of the real and imaginary parts of its values. See the package level function Complex.
+func (e StdEng) Complex(re, im Tensor) (retVal Tensor, err error) {
+	var r, i DenseTensor
+	if r, err = e.contiguous(re, "Complex"); err != nil {
+		return nil, err
+	}
+	if i, err = e.contiguous(im, "Complex"); err != nil {
+		return nil, err
+	}
+	if r.Dtype() != i.Dtype() {
+		return nil, errors.Errorf(dtypeMismatch, r.Dtype(), i.Dtype())
+	}
+	if !r.Shape().Eq(i.Shape()) {
+		return nil, errors.Errorf(shapeMismatch, r.Shape(), i.Shape())
+	}
+
+	var dt Dtype
+	switch r.Dtype() {
+	case Float32:
+		dt = Complex64
+	case Float64:
+		dt = Complex128
+	default:
+		return nil, errors.Errorf(unsupportedDtype, r.Dtype(), "Complex")
+	}
+
+	ret := New(WithShape(r.Shape().Clone()...), Of(dt), WithEngine(e))
+	copyMask(ret, r)
+	if mt, ok := i.(MaskedTensor); ok && mt.IsMasked() {
+		if !ret.IsMasked() {
+			ret.makeMask()
+		}
+		for j, m := range mt.Mask() {
+			ret.mask[j] = ret.mask[j] || m
+		}
+	}
+
+	switch dt {
+	case Complex64:
+		data, ims := ret.Complex64s(), i.(unsafeMem).Float32s()
+		for j, v := range r.(unsafeMem).Float32s() {
+			data[j] = complex(v, ims[j])
+		}
+	case Complex128:
+		data, ims := ret.Complex128s(), i.(unsafeMem).Float64s()
+		for j, v := range r.(unsafeMem).Float64s() {
+			data[j] = complex(v, ims[j])
+		}
+	}
+	return ret, nil
+}
+
+// complexPartKind is a function of a complex number that returns a real number.
+type complexPartKind byte
+
+const (
+	realPart complexPartKind = iota
+	imagPart
+	anglePart
+	magnitudePart
+)
+
+func (k complexPartKind) of(v complex128) float64 {
+	switch k {
+	case imagPart:
+		return imag(v)
+	case anglePart:
+		return cmplx.Phase(v)
+	case magnitudePart:
+		return cmplx.Abs(v)
+	}
+	return real(v)
+}
+
+// complexPart applies the function to each value of a complex tensor. Complex64 tensors give Float32 results and Complex128 tensors give Float64 results.
+func (e StdEng) complexPart(t Tensor, k complexPartKind, op string) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, op); err != nil {
+		return nil, err
+	}
+
+	var ret *Dense
+	switch x.Dtype() {
+	case Complex64:
+		ret = New(WithShape(x.Shape().Clone()...), Of(Float32), WithEngine(e))
+		data := ret.Float32s()
+		for i, v := range x.(unsafeMem).Complex64s() {
+			data[i] = float32(k.of(complex128(v)))
+		}
+	case Complex128:
+		ret = New(WithShape(x.Shape().Clone()...), Of(Float64), WithEngine(e))
+		data := ret.Float64s()
+		for i, v := range x.(unsafeMem).Complex128s() {
+			data[i] = k.of(v)
+		}
+	default:
+		return nil, errors.Errorf(unsupportedDtype, x.Dtype(), op)
+	}
+	copyMask(ret, x)
+	return ret, nil
+}
+
+// copyMask gives ret a copy of the mask of x, if x is masked. Both are expected to have the same size.
+func copyMask(ret *Dense, x Tensor) {
+	if mt, ok := x.(MaskedTensor); ok && mt.IsMasked() {
+		ret.makeMask()
+		copy(ret.mask, mt.Mask())
+	}
+}
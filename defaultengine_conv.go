@@ -0,0 +1,479 @@
+package tensor
+
+import (
+	"github.com/pkg/errors"
+	"gonum.org/v1/gonum/blas"
+)
+
+var (
+	_ Im2Coler  = StdEng{}
+	_ Convolver = StdEng{}
+)
+
+// convGeom holds the geometry of a convolution of a batch of images.
+type convGeom struct {
+	w Window2D
+
+	n, c, h, wd int // input
+	oc          int // output channels
+	oh, ow      int // output height and width
+	k, l        int // rows and columns of the unrolled image: k = c×KernelH×KernelW, l = oh×ow
+
+	// idx is the gather table of a single image. See im2colIndices.
+	idx []int
+}
+
+func (g *convGeom) imSize() int  { return g.c * g.h * g.wd }
+func (g *convGeom) colSize() int { return g.k * g.l }
+func (g *convGeom) outSize() int { return g.oc * g.l }
+
+func (g *convGeom) colShape() Shape {
+	if g.w.Layout == NHWC {
+		return Shape{g.n, g.l, g.k}
+	}
+	return Shape{g.n, g.k, g.l}
+}
+
+func (g *convGeom) outShape() Shape {
+	if g.w.Layout == NHWC {
+		return Shape{g.n, g.oh, g.ow, g.oc}
+	}
+	return Shape{g.n, g.oc, g.oh, g.ow}
+}
+
+// imageDims splits the shape of a batch of images into (batch, channels, height, width).
+func imageDims(s Shape, layout ImageLayout) (n, c, h, w int) {
+	if layout == NHWC {
+		return s[0], s[3], s[1], s[2]
+	}
+	return s[0], s[1], s[2], s[3]
+}
+
+// newConvGeom calculates the geometry of a window sliding over a batch of images of the given shape.
+func newConvGeom(imShape Shape, w Window2D) (g convGeom, err error) {
+	if err = w.check(); err != nil {
+		return
+	}
+	if imShape.Dims() != 4 {
+		err = errors.Errorf(dimMismatch, 4, imShape.Dims())
+		return
+	}
+	g.w = w.norm()
+	g.n, g.c, g.h, g.wd = imageDims(imShape, w.Layout)
+	if g.oh, g.ow, err = g.w.outputSize(g.h, g.wd); err != nil {
+		return
+	}
+	g.k = g.c * g.w.KernelH * g.w.KernelW
+	g.l = g.oh * g.ow
+	g.idx = im2colIndices(&g)
+	return
+}
+
+// im2colIndices builds the gather table for a single image: idx[i] is the offset into the image of the
+// i-th element of the unrolled image, or -1 if the element falls in the padding.
+//
+// The table only depends on the geometry, so it is computed once and shared across the batch.
+func im2colIndices(g *convGeom) []int {
+	w := g.w
+	idx := make([]int, g.k*g.l)
+	for ch := 0; ch < g.c; ch++ {
+		for i := 0; i < w.KernelH; i++ {
+			for j := 0; j < w.KernelW; j++ {
+				r := (ch*w.KernelH+i)*w.KernelW + j // NCHW row
+				if w.Layout == NHWC {
+					r = (i*w.KernelW+j)*g.c + ch
+				}
+				for oy := 0; oy < g.oh; oy++ {
+					y := oy*w.StrideH - w.PadH + i*w.DilationH
+					for ox := 0; ox < g.ow; ox++ {
+						x := ox*w.StrideW - w.PadW + j*w.DilationW
+						col := oy*g.ow + ox
+
+						src := -1
+						if y >= 0 && y < g.h && x >= 0 && x < g.wd {
+							if w.Layout == NHWC {
+								src = (y*g.wd+x)*g.c + ch
+							} else {
+								src = (ch*g.h+y)*g.wd + x
+							}
+						}
+
+						if w.Layout == NHWC {
+							idx[col*g.k+r] = src
+						} else {
+							idx[r*g.l+col] = src
+						}
+					}
+				}
+			}
+		}
+	}
+	return idx
+}
+
+// contiguousFloat returns a DenseTensor of floats that does not require an iterator to access, materializing views if necessary.
+func (e StdEng) contiguousFloat(t Tensor, op string) (DenseTensor, error) {
+	if err := e.checkAccessible(t); err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+	if v, ok := t.(View); ok && v.IsMaterializable() {
+		t = v.Materialize()
+	}
+	d, err := getFloatDenseTensor(t)
+	if err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+	return d, nil
+}
+
+// Im2Col unrolls the sliding windows of a batch of images into columns. See the package level function Im2Col for the resulting shapes.
+func (e StdEng) Im2Col(im Tensor, w Window2D, opts ...FuncOpt) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguousFloat(im, "Im2Col"); err != nil {
+		return nil, err
+	}
+	var g convGeom
+	if g, err = newConvGeom(x.Shape(), w); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Im2Col")
+	}
+
+	expectedShape := g.colShape()
+	var reuse DenseTensor
+	var toReuse bool
+	if reuse, _, toReuse, _, _, err = handleFuncOpts(expectedShape, x.Dtype(), x.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if !toReuse {
+		reuse = New(WithShape(expectedShape...), Of(x.Dtype()), WithEngine(e))
+	}
+
+	imSize, colSize := g.imSize(), g.colSize()
+	switch x.Dtype() {
+	case Float64:
+		src, dst := getFloat64s(x), getFloat64s(reuse)
+		for b := 0; b < g.n; b++ {
+			im2colF64(dst[b*colSize:(b+1)*colSize], src[b*imSize:(b+1)*imSize], g.idx)
+		}
+	case Float32:
+		src, dst := getFloat32s(x), getFloat32s(reuse)
+		for b := 0; b < g.n; b++ {
+			im2colF32(dst[b*colSize:(b+1)*colSize], src[b*imSize:(b+1)*imSize], g.idx)
+		}
+	}
+	return reuse, nil
+}
+
+// Col2Im folds columns back into a batch of images of the given shape, summing up the values of overlapping windows.
+func (e StdEng) Col2Im(col Tensor, imShape Shape, w Window2D, opts ...FuncOpt) (retVal Tensor, err error) {
+	var c DenseTensor
+	if c, err = e.contiguousFloat(col, "Col2Im"); err != nil {
+		return nil, err
+	}
+	var g convGeom
+	if g, err = newConvGeom(imShape, w); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Col2Im")
+	}
+	if !c.Shape().Eq(g.colShape()) {
+		return nil, errors.Errorf(shapeMismatch, g.colShape(), c.Shape())
+	}
+
+	var reuse DenseTensor
+	var toReuse bool
+	if reuse, _, toReuse, _, _, err = handleFuncOpts(imShape, c.Dtype(), c.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if toReuse {
+		reuse.Zero()
+	} else {
+		reuse = New(WithShape(imShape.Clone()...), Of(c.Dtype()), WithEngine(e))
+	}
+
+	imSize, colSize := g.imSize(), g.colSize()
+	switch c.Dtype() {
+	case Float64:
+		src, dst := getFloat64s(c), getFloat64s(reuse)
+		for b := 0; b < g.n; b++ {
+			col2imF64(dst[b*imSize:(b+1)*imSize], src[b*colSize:(b+1)*colSize], g.idx)
+		}
+	case Float32:
+		src, dst := getFloat32s(c), getFloat32s(reuse)
+		for b := 0; b < g.n; b++ {
+			col2imF32(dst[b*imSize:(b+1)*imSize], src[b*colSize:(b+1)*colSize], g.idx)
+		}
+	}
+	return reuse, nil
+}
+
+// convPrep checks the input and the filter of a convolution, and calculates its geometry.
+// The kernel size is inferred from the filter.
+func (e StdEng) convPrep(x, filter Tensor, w Window2D, op string) (xd, fd DenseTensor, g convGeom, err error) {
+	if xd, err = e.contiguousFloat(x, op); err != nil {
+		return
+	}
+	if fd, err = e.contiguousFloat(filter, op); err != nil {
+		return
+	}
+	if xd.Dtype() != fd.Dtype() {
+		err = errors.Errorf(dtypeMismatch, xd.Dtype(), fd.Dtype())
+		return
+	}
+	if fd.Dims() != 4 {
+		err = errors.Wrap(errors.Errorf(dimMismatch, 4, fd.Dims()), "filter")
+		return
+	}
+
+	fs := fd.Shape()
+	oc, fc, kh, kw := imageDims(fs, w.Layout)
+	if (w.KernelH != 0 && w.KernelH != kh) || (w.KernelW != 0 && w.KernelW != kw) {
+		err = errors.Errorf("Window kernel (%d, %d) does not match the filter of %v", w.KernelH, w.KernelW, fs)
+		return
+	}
+	w.KernelH, w.KernelW = kh, kw
+	if g, err = newConvGeom(xd.Shape(), w); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	if g.c != fc {
+		err = errors.Errorf("Expected filter of %v to have %d input channels", fs, g.c)
+		return
+	}
+	g.oc = oc
+	return
+}
+
+// Conv2D convolves x with the filter by unrolling each image with im2col and multiplying it with the filter using the configured BLAS.
+func (e StdEng) Conv2D(x, filter Tensor, w Window2D) (retVal Tensor, err error) {
+	var xd, fd DenseTensor
+	var g convGeom
+	if xd, fd, g, err = e.convPrep(x, filter, w, "Conv2D"); err != nil {
+		return nil, err
+	}
+
+	ret := New(WithShape(g.outShape()...), Of(xd.Dtype()), WithEngine(e))
+	switch xd.Dtype() {
+	case Float64:
+		conv2dF64(&g, getFloat64s(xd), getFloat64s(fd), getFloat64s(ret))
+	case Float32:
+		conv2dF32(&g, getFloat32s(xd), getFloat32s(fd), getFloat32s(ret))
+	}
+	return ret, nil
+}
+
+// Conv2DB computes the gradients of Conv2D with regards to its input and its filter.
+func (e StdEng) Conv2DB(x, filter, grad Tensor, w Window2D) (gradX, gradFilter Tensor, err error) {
+	var xd, fd, gd DenseTensor
+	var g convGeom
+	if xd, fd, g, err = e.convPrep(x, filter, w, "Conv2DB"); err != nil {
+		return nil, nil, err
+	}
+	if gd, err = e.contiguousFloat(grad, "Conv2DB"); err != nil {
+		return nil, nil, err
+	}
+	if gd.Dtype() != xd.Dtype() {
+		return nil, nil, errors.Errorf(dtypeMismatch, xd.Dtype(), gd.Dtype())
+	}
+	if !gd.Shape().Eq(g.outShape()) {
+		return nil, nil, errors.Errorf(shapeMismatch, g.outShape(), gd.Shape())
+	}
+
+	dx := New(WithShape(xd.Shape().Clone()...), Of(xd.Dtype()), WithEngine(e))
+	df := New(WithShape(fd.Shape().Clone()...), Of(xd.Dtype()), WithEngine(e))
+	switch xd.Dtype() {
+	case Float64:
+		conv2dBF64(&g, getFloat64s(xd), getFloat64s(fd), getFloat64s(gd), getFloat64s(dx), getFloat64s(df))
+	case Float32:
+		conv2dBF32(&g, getFloat32s(xd), getFloat32s(fd), getFloat32s(gd), getFloat32s(dx), getFloat32s(df))
+	}
+	return dx, df, nil
+}
+
+// Conv1D convolves a batch of sequences by treating them as images of height 1.
+func (e StdEng) Conv1D(x, filter Tensor, w Window1D) (retVal Tensor, err error) {
+	var x4, f4 *Dense
+	if x4, f4, err = conv1DAs2D(x, filter, w.Layout); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Conv1D")
+	}
+	if retVal, err = e.Conv2D(x4, f4, w.to2D()); err != nil {
+		return nil, err
+	}
+	return retVal, squeezeConv1D(retVal, w.Layout)
+}
+
+// Conv1DB computes the gradients of Conv1D with regards to its input and its filter.
+func (e StdEng) Conv1DB(x, filter, grad Tensor, w Window1D) (gradX, gradFilter Tensor, err error) {
+	var x4, f4, g4 *Dense
+	if x4, f4, err = conv1DAs2D(x, filter, w.Layout); err != nil {
+		return nil, nil, errors.Wrapf(err, opFail, "Conv1DB")
+	}
+	if g4, err = unsqueezedCopy(grad, conv1DSpatialAxis(w.Layout)); err != nil {
+		return nil, nil, errors.Wrapf(err, opFail, "Conv1DB")
+	}
+	if gradX, gradFilter, err = e.Conv2DB(x4, f4, g4, w.to2D()); err != nil {
+		return nil, nil, err
+	}
+	if err = gradX.Reshape(x.Shape().Clone()...); err != nil {
+		return nil, nil, err
+	}
+	if err = gradFilter.Reshape(filter.Shape().Clone()...); err != nil {
+		return nil, nil, err
+	}
+	return
+}
+
+// conv1DSpatialAxis is the axis at which a unit height is inserted to turn a batch of sequences into a batch of images.
+func conv1DSpatialAxis(layout ImageLayout) int {
+	if layout == NHWC {
+		return 1
+	}
+	return 2
+}
+
+func conv1DAs2D(x, filter Tensor, layout ImageLayout) (x4, f4 *Dense, err error) {
+	if x.Dims() != 3 {
+		return nil, nil, errors.Errorf(dimMismatch, 3, x.Dims())
+	}
+	if filter.Dims() != 3 {
+		return nil, nil, errors.Wrap(errors.Errorf(dimMismatch, 3, filter.Dims()), "filter")
+	}
+	axis := conv1DSpatialAxis(layout)
+	if x4, err = unsqueezedCopy(x, axis); err != nil {
+		return nil, nil, err
+	}
+	if f4, err = unsqueezedCopy(filter, axis); err != nil {
+		return nil, nil, err
+	}
+	return
+}
+
+// unsqueezedCopy returns a *Dense sharing the data of t (or a materialized copy if t is a non-contiguous view) with a dimension of size 1 inserted at the axis.
+// The input is left untouched.
+func unsqueezedCopy(t Tensor, axis int) (retVal *Dense, err error) {
+	var d *Dense
+	if d, err = assertDense(t); err != nil {
+		return nil, err
+	}
+	if d.IsMaterializable() {
+		retVal = d.Materialize().(*Dense)
+	} else {
+		retVal = d.ShallowClone()
+	}
+	shp := retVal.Shape().Clone()
+	shp = append(shp, 0)
+	copy(shp[axis+1:], shp[axis:])
+	shp[axis] = 1
+	return retVal, retVal.Reshape(shp...)
+}
+
+func squeezeConv1D(t Tensor, layout ImageLayout) error {
+	s := t.Shape()
+	if layout == NHWC {
+		return t.Reshape(s[0], s[2], s[3])
+	}
+	return t.Reshape(s[0], s[1], s[3])
+}
+
+/* float64 kernels */
+
+func im2colF64(col, im []float64, idx []int) {
+	for i, j := range idx {
+		if j < 0 {
+			col[i] = 0
+			continue
+		}
+		col[i] = im[j]
+	}
+}
+
+func col2imF64(im, col []float64, idx []int) {
+	for i, j := range idx {
+		if j >= 0 {
+			im[j] += col[i]
+		}
+	}
+}
+
+func conv2dF64(g *convGeom, x, filter, out []float64) {
+	imSize, outSize := g.imSize(), g.outSize()
+	col := make([]float64, g.colSize())
+	for b := 0; b < g.n; b++ {
+		im2colF64(col, x[b*imSize:(b+1)*imSize], g.idx)
+		o := out[b*outSize : (b+1)*outSize]
+		if g.w.Layout == NHWC {
+			// (l, k) × (oc, k)ᵀ
+			whichblas.Dgemm(blas.NoTrans, blas.Trans, g.l, g.oc, g.k, 1, col, g.k, filter, g.k, 0, o, g.oc)
+			continue
+		}
+		// (oc, k) × (k, l)
+		whichblas.Dgemm(blas.NoTrans, blas.NoTrans, g.oc, g.l, g.k, 1, filter, g.k, col, g.l, 0, o, g.l)
+	}
+}
+
+func conv2dBF64(g *convGeom, x, filter, grad, dx, dfilter []float64) {
+	imSize, outSize := g.imSize(), g.outSize()
+	col := make([]float64, g.colSize())
+	dcol := make([]float64, g.colSize())
+	for b := 0; b < g.n; b++ {
+		im2colF64(col, x[b*imSize:(b+1)*imSize], g.idx)
+		gr := grad[b*outSize : (b+1)*outSize]
+		if g.w.Layout == NHWC {
+			whichblas.Dgemm(blas.NoTrans, blas.NoTrans, g.l, g.k, g.oc, 1, gr, g.oc, filter, g.k, 0, dcol, g.k)
+			whichblas.Dgemm(blas.Trans, blas.NoTrans, g.oc, g.k, g.l, 1, gr, g.oc, col, g.k, 1, dfilter, g.k)
+		} else {
+			whichblas.Dgemm(blas.Trans, blas.NoTrans, g.k, g.l, g.oc, 1, filter, g.k, gr, g.l, 0, dcol, g.l)
+			whichblas.Dgemm(blas.NoTrans, blas.Trans, g.oc, g.k, g.l, 1, gr, g.l, col, g.l, 1, dfilter, g.k)
+		}
+		col2imF64(dx[b*imSize:(b+1)*imSize], dcol, g.idx)
+	}
+}
+
+/* float32 kernels */
+
+func im2colF32(col, im []float32, idx []int) {
+	for i, j := range idx {
+		if j < 0 {
+			col[i] = 0
+			continue
+		}
+		col[i] = im[j]
+	}
+}
+
+func col2imF32(im, col []float32, idx []int) {
+	for i, j := range idx {
+		if j >= 0 {
+			im[j] += col[i]
+		}
+	}
+}
+
+func conv2dF32(g *convGeom, x, filter, out []float32) {
+	imSize, outSize := g.imSize(), g.outSize()
+	col := make([]float32, g.colSize())
+	for b := 0; b < g.n; b++ {
+		im2colF32(col, x[b*imSize:(b+1)*imSize], g.idx)
+		o := out[b*outSize : (b+1)*outSize]
+		if g.w.Layout == NHWC {
+			whichblas.Sgemm(blas.NoTrans, blas.Trans, g.l, g.oc, g.k, 1, col, g.k, filter, g.k, 0, o, g.oc)
+			continue
+		}
+		whichblas.Sgemm(blas.NoTrans, blas.NoTrans, g.oc, g.l, g.k, 1, filter, g.k, col, g.l, 0, o, g.l)
+	}
+}
+
+func conv2dBF32(g *convGeom, x, filter, grad, dx, dfilter []float32) {
+	imSize, outSize := g.imSize(), g.outSize()
+	col := make([]float32, g.colSize())
+	dcol := make([]float32, g.colSize())
+	for b := 0; b < g.n; b++ {
+		im2colF32(col, x[b*imSize:(b+1)*imSize], g.idx)
+		gr := grad[b*outSize : (b+1)*outSize]
+		if g.w.Layout == NHWC {
+			whichblas.Sgemm(blas.NoTrans, blas.NoTrans, g.l, g.k, g.oc, 1, gr, g.oc, filter, g.k, 0, dcol, g.k)
+			whichblas.Sgemm(blas.Trans, blas.NoTrans, g.oc, g.k, g.l, 1, gr, g.oc, col, g.k, 1, dfilter, g.k)
+		} else {
+			whichblas.Sgemm(blas.Trans, blas.NoTrans, g.k, g.l, g.oc, 1, filter, g.k, gr, g.l, 0, dcol, g.l)
+			whichblas.Sgemm(blas.NoTrans, blas.Trans, g.oc, g.k, g.l, 1, gr, g.l, col, g.l, 1, dfilter, g.k)
+		}
+		col2imF32(dx[b*imSize:(b+1)*imSize], dcol, g.idx)
+	}
+}
@@ -0,0 +1,127 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+var (
+	_ Triangler    = StdEng{}
+	_ DiagEmbedder = StdEng{}
+)
+
+// Triu returns a copy of a (batch of) matrices with the values below the k-th diagonal set to zero.
+func (e StdEng) Triu(t Tensor, k int) (retVal Tensor, err error) {
+	return e.triangle(t, k, true, "Triu")
+}
+
+// Tril returns a copy of a (batch of) matrices with the values above the k-th diagonal set to zero.
+func (e StdEng) Tril(t Tensor, k int) (retVal Tensor, err error) {
+	return e.triangle(t, k, false, "Tril")
+}
+
+// triangle copies the values of the upper (or lower) triangle of the matrices in t, row by row, into a tensor of zeroes.
+func (e StdEng) triangle(t Tensor, k int, upper bool, op string) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, op); err != nil {
+		return nil, err
+	}
+	if x.Dims() < 2 {
+		return nil, errors.Errorf(atleastDims, 2)
+	}
+
+	shape := x.Shape()
+	dims := len(shape)
+	r, c := shape[dims-2], shape[dims-1]
+	ret := New(WithShape(shape.Clone()...), Of(x.Dtype()), WithEngine(e))
+	var mask []bool
+	if mt, ok := x.(MaskedTensor); ok && mt.IsMasked() {
+		mask = mt.Mask()
+		ret.makeMask()
+	}
+
+	size := int(x.Dtype().Size())
+	src, dst := x.arr().Header.Raw, ret.array.Header.Raw
+	for row, rows := 0, shape.TotalSize()/c; row < rows; row++ {
+		// the columns kept in this row are [start, end)
+		i := row % r
+		start, end := 0, MinInt(MaxInt(i+k+1, 0), c)
+		if upper {
+			start, end = MinInt(MaxInt(i+k, 0), c), c
+		}
+		lo, hi := row*c+start, row*c+end
+		copy(dst[lo*size:hi*size], src[lo*size:hi*size])
+		if mask != nil {
+			copy(ret.mask[lo:hi], mask[lo:hi])
+		}
+	}
+
+	if dims == 2 {
+		switch {
+		case upper && k >= 0:
+			ret.Δ = Upper
+		case !upper && k <= 0:
+			ret.Δ = Lower
+		}
+	}
+	return ret, nil
+}
+
+// DiagEmbed builds matrices whose offset-th diagonals hold the values along the last axis of t.
+// See the package level function DiagEmbed.
+func (e StdEng) DiagEmbed(t Tensor, offset int) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, "DiagEmbed"); err != nil {
+		return nil, err
+	}
+	if x.Dims() < 1 {
+		return nil, errors.Errorf(atleastDims, 1)
+	}
+
+	shape := x.Shape()
+	dims := len(shape)
+	n := shape[dims-1]
+	m := n
+	if offset < 0 {
+		m -= offset
+	} else {
+		m += offset
+	}
+	retShape := append(shape[:dims-1:dims-1], m, m)
+	ret := New(WithShape(retShape...), Of(x.Dtype()), WithEngine(e))
+	var mask []bool
+	if mt, ok := x.(MaskedTensor); ok && mt.IsMasked() {
+		mask = mt.Mask()
+		ret.makeMask()
+	}
+
+	// the first value of the diagonal, and the distance between two of its values in a matrix
+	first, stride := offset, m+1
+	if offset < 0 {
+		first = -offset * m
+	}
+	size := int(x.Dtype().Size())
+	src, dst := x.arr().Header.Raw, ret.array.Header.Raw
+	for b, batches := 0, shape.TotalSize()/n; b < batches; b++ {
+		for i := 0; i < n; i++ {
+			s, d := b*n+i, b*m*m+first+i*stride
+			copy(dst[d*size:(d+1)*size], src[s*size:(s+1)*size])
+			if mask != nil {
+				ret.mask[d] = mask[s]
+			}
+		}
+	}
+
+	if dims == 1 {
+		ret.Δ = diagTriangle(offset)
+	}
+	return ret, nil
+}
+
+// diagTriangle returns the Triangle of a matrix whose only non-zero values are on its k-th diagonal.
+func diagTriangle(k int) Triangle {
+	switch {
+	case k > 0:
+		return Upper
+	case k < 0:
+		return Lower
+	}
+	return Symmetric
+}
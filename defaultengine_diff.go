@@ -0,0 +1,201 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+var _ Differ = StdEng{}
+
+// Diff computes the n-th discrete difference of t along the axis. See the package level function Diff.
+func (e StdEng) Diff(t Tensor, n, axis int, pre, post Tensor) (retVal Tensor, err error) {
+	if n < 0 {
+		return nil, errors.Errorf("Expected a non-negative order of difference for Diff. Got %d", n)
+	}
+	if t.Dtype() != Bool {
+		if err = typeclassCheck(t.Dtype(), numberTypes); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Diff")
+		}
+	}
+	var axes []int
+	if axes, err = resolveAxes([]int{axis}, t.Dims()); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Diff")
+	}
+	axis = axes[0]
+	if n == 0 {
+		return t, nil
+	}
+
+	x := t
+	if pre != nil || post != nil {
+		var parts []Tensor
+		if parts, err = e.diffParts(t, axis, pre, post); err != nil {
+			return nil, err
+		}
+		if x, err = Concat(axis, parts[0], parts[1:]...); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Diff")
+		}
+	}
+	if n >= x.Shape()[axis] {
+		return nil, errors.Errorf("Unable to take the difference of order %d along axis %d which has size %d", n, axis, x.Shape()[axis])
+	}
+
+	// a thunked transpose (or any other view) is copied once, so that the slices below are views of plain storage.
+	if v, ok := x.(View); ok && v.IsMaterializable() {
+		x = v.Materialize()
+	}
+
+	// each difference is the tensor sliced from 1 along the axis, minus the tensor sliced up to the last value.
+	// The slices are views, which the arithmetic iterates over.
+	slices := make([]Slice, x.Dims())
+	for i := 0; i < n; i++ {
+		size := x.Shape()[axis]
+		var hi, lo View
+		slices[axis] = S(1, size)
+		if hi, err = x.Slice(slices...); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Diff")
+		}
+		slices[axis] = S(0, size-1)
+		if lo, err = x.Slice(slices...); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Diff")
+		}
+
+		shape := x.Shape().Clone()
+		shape[axis]--
+		reuse := New(WithShape(shape...), Of(x.Dtype()), WithEngine(e))
+		if x.Dtype() == Bool {
+			x, err = e.Xor(hi, lo, WithReuse(reuse))
+		} else {
+			x, err = e.Sub(hi, lo, WithReuse(reuse))
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, opFail, "Diff")
+		}
+	}
+	return x, nil
+}
+
+// diffParts returns the tensors to concatenate along the axis. Scalars are broadcast to a size of 1 along the axis.
+func (e StdEng) diffParts(t Tensor, axis int, pre, post Tensor) (parts []Tensor, err error) {
+	part := func(p Tensor) (Tensor, error) {
+		if p.Dtype() != t.Dtype() {
+			return nil, errors.Errorf(dtypeMismatch, t.Dtype(), p.Dtype())
+		}
+		if !p.Shape().IsScalar() {
+			return p, nil
+		}
+		shape := t.Shape().Clone()
+		shape[axis] = 1
+		bcast := New(WithShape(shape...), Of(t.Dtype()), WithEngine(e))
+		if err := bcast.Memset(p.Data()); err != nil {
+			return nil, err
+		}
+		return bcast, nil
+	}
+
+	if pre != nil {
+		var p Tensor
+		if p, err = part(pre); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Diff")
+		}
+		parts = append(parts, p)
+	}
+	parts = append(parts, t)
+	if post != nil {
+		var p Tensor
+		if p, err = part(post); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Diff")
+		}
+		parts = append(parts, p)
+	}
+	return parts, nil
+}
+
+// Gradient computes the gradients of t along the axes with central differences. See the package level function Gradient.
+func (e StdEng) Gradient(t Tensor, spacing []float64, axes ...int) (retVal []Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguousFloat(t, "Gradient"); err != nil {
+		return nil, err
+	}
+	dims := x.Dims()
+	if dims == 0 {
+		return nil, errors.Errorf(atleastDims, 1)
+	}
+	if len(axes) == 0 {
+		axes = make([]int, dims)
+		for i := range axes {
+			axes[i] = i
+		}
+	}
+	for _, a := range axes {
+		if a >= dims || a < -dims {
+			return nil, errors.Errorf(invalidAxis, a, dims)
+		}
+	}
+
+	switch len(spacing) {
+	case 0:
+		spacing = []float64{1}
+		fallthrough
+	case 1:
+		h := spacing[0]
+		spacing = make([]float64, len(axes))
+		for i := range spacing {
+			spacing[i] = h
+		}
+	case len(axes):
+	default:
+		return nil, errors.Errorf("Expected 1 spacing, or 1 spacing per axis (%d). Got %d", len(axes), len(spacing))
+	}
+
+	shape := x.Shape()
+	strides := shape.CalcStrides()
+	for i, a := range axes {
+		axis := resolveAxis(a, dims)
+		size := shape[axis]
+		if size < 2 {
+			return nil, errors.Errorf("Unable to compute the gradient along axis %d which has size %d. At least 2 values are required", axis, size)
+		}
+		inner := strides[axis]
+		outer := x.len() / (size * inner)
+		h := spacing[i]
+
+		var f64, out64 []float64
+		var f32, out32 []float32
+		switch x.Dtype() {
+		case Float64:
+			f64 = x.Float64s()
+			out64 = make([]float64, len(f64))
+		case Float32:
+			f32 = x.Float32s()
+			out32 = make([]float32, len(f32))
+		}
+
+		for o := 0; o < outer; o++ {
+			for k := 0; k < size; k++ {
+				// one-sided differences at the edges, and central differences in the interior
+				lo, hi, div := k-1, k+1, 2*h
+				if k == 0 {
+					lo, div = 0, h
+				}
+				if k == size-1 {
+					hi, div = size-1, h
+				}
+
+				base := o * size * inner
+				for j := 0; j < inner; j++ {
+					dst, l, r := base+k*inner+j, base+lo*inner+j, base+hi*inner+j
+					if f64 != nil {
+						out64[dst] = (f64[r] - f64[l]) / div
+					} else {
+						out32[dst] = float32(float64(f32[r]-f32[l]) / div)
+					}
+				}
+			}
+		}
+
+		if out64 != nil {
+			retVal = append(retVal, New(WithShape(shape.Clone()...), WithBacking(out64), WithEngine(e)))
+		} else {
+			retVal = append(retVal, New(WithShape(shape.Clone()...), WithBacking(out32), WithEngine(e)))
+		}
+	}
+	return retVal, nil
+}
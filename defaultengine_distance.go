@@ -0,0 +1,295 @@
+package tensor
+
+import (
+	"math"
+
+	"github.com/chewxy/math32"
+	"github.com/pkg/errors"
+	"gonum.org/v1/gonum/blas"
+)
+
+var _ Distancer = StdEng{}
+
+// cdistGemmMin is the number of rows of either input above which the Euclidean distances are computed with a matrix multiplication.
+// This is the same heuristic PyTorch uses.
+const cdistGemmMin = 25
+
+// cosineEps bounds the norms of CosineSimilarity away from zero.
+const cosineEps = 1e-8
+
+// Cdist computes the distances between the rows of the (batches of) matrices a and b. See the package level function Cdist.
+func (e StdEng) Cdist(a, b Tensor, metric DistanceMetric) (retVal Tensor, err error) {
+	if err = metric.check(); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Cdist")
+	}
+	var x, y DenseTensor
+	if x, err = e.contiguousFloat(a, "Cdist"); err != nil {
+		return nil, err
+	}
+	if y, err = e.contiguousFloat(b, "Cdist"); err != nil {
+		return nil, err
+	}
+	if x.Dtype() != y.Dtype() {
+		return nil, errors.Errorf(dtypeMismatch, x.Dtype(), y.Dtype())
+	}
+
+	as, bs := x.Shape(), y.Shape()
+	if len(as) < 2 || len(bs) < 2 {
+		return nil, errors.Errorf(atleastDims, 2)
+	}
+	dims := len(as)
+	if len(bs) != dims || !as[:dims-2].Eq(bs[:dims-2]) || as[dims-1] != bs[dims-1] {
+		return nil, errors.Errorf("Cannot compute the distances between the rows of %v and %v", as, bs)
+	}
+
+	m, n, d := as[dims-2], bs[dims-2], as[dims-1]
+	shape := append(as[:dims-2].Clone(), m, n)
+	ret := New(WithShape(shape...), Of(x.Dtype()), WithEngine(e))
+	gemm := (metric.kind == euclideanDist || metric.kind == sqEuclideanDist) && (m > cdistGemmMin || n > cdistGemmMin) && m > 0 && n > 0 && d > 0
+	sq := metric.kind == sqEuclideanDist
+
+	batches := as[:dims-2].TotalSize()
+	switch x.Dtype() {
+	case Float64:
+		A, B, C := getFloat64s(x), getFloat64s(y), getFloat64s(ret)
+		for i := 0; i < batches; i++ {
+			ab, bb, cb := A[i*m*d:(i+1)*m*d], B[i*n*d:(i+1)*n*d], C[i*m*n:(i+1)*m*n]
+			if gemm {
+				cdistGemmF64(ab, bb, cb, m, n, d, sq)
+			} else {
+				cdistF64(ab, bb, cb, m, n, d, metric)
+			}
+		}
+	case Float32:
+		A, B, C := getFloat32s(x), getFloat32s(y), getFloat32s(ret)
+		for i := 0; i < batches; i++ {
+			ab, bb, cb := A[i*m*d:(i+1)*m*d], B[i*n*d:(i+1)*n*d], C[i*m*n:(i+1)*m*n]
+			if gemm {
+				cdistGemmF32(ab, bb, cb, m, n, d, sq)
+			} else {
+				cdistF32(ab, bb, cb, m, n, d, metric)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// CosineSimilarity computes the cosine similarity of a and b along the given axis, broadcasting them against each other.
+func (e StdEng) CosineSimilarity(a, b Tensor, axis int) (retVal Tensor, err error) {
+	var x, y DenseTensor
+	if x, err = e.contiguousFloat(a, "CosineSimilarity"); err != nil {
+		return nil, err
+	}
+	if y, err = e.contiguousFloat(b, "CosineSimilarity"); err != nil {
+		return nil, err
+	}
+	if x.Dtype() != y.Dtype() {
+		return nil, errors.Errorf(dtypeMismatch, x.Dtype(), y.Dtype())
+	}
+
+	var shape Shape
+	if shape, err = broadcastShapes(x.Shape(), y.Shape()); err != nil {
+		return nil, errors.Wrapf(err, opFail, "CosineSimilarity")
+	}
+	dims := len(shape)
+	if dims == 0 {
+		return nil, errors.Errorf(atleastDims, 1)
+	}
+	if axis >= dims || axis < -dims {
+		return nil, errors.Errorf(invalidAxis, axis, dims)
+	}
+	axis = resolveAxis(axis, dims)
+
+	sa := broadcastedStrides(shape, x.Shape(), x.Shape().CalcStrides())
+	sb := broadcastedStrides(shape, y.Shape(), y.Shape().CalcStrides())
+	g := cosineGeom{n: shape[axis], strideA: sa[axis], strideB: sb[axis]}
+
+	// the starting offsets into a and b of each vector, in the order of the result
+	var outShape Shape
+	var outer, outerA, outerB []int
+	for i := range shape {
+		if i != axis {
+			outShape = append(outShape, shape[i])
+			outer = append(outer, i)
+			outerA = append(outerA, sa[i])
+			outerB = append(outerB, sb[i])
+		}
+	}
+	coord := make([]int, len(outer))
+	for o, size := 0, outShape.TotalSize(); o < size; o++ {
+		var offA, offB int
+		for i, c := range coord {
+			offA += c * outerA[i]
+			offB += c * outerB[i]
+		}
+		g.offA = append(g.offA, offA)
+		g.offB = append(g.offB, offB)
+		for i := len(coord) - 1; i >= 0; i-- {
+			if coord[i]++; coord[i] < outShape[i] {
+				break
+			}
+			coord[i] = 0
+		}
+	}
+
+	ret := New(WithShape(outShape...), Of(x.Dtype()), WithEngine(e))
+	switch x.Dtype() {
+	case Float64:
+		cosineF64(&g, getFloat64s(x), getFloat64s(y), getFloat64s(ret))
+	case Float32:
+		cosineF32(&g, getFloat32s(x), getFloat32s(y), getFloat32s(ret))
+	}
+	return ret, nil
+}
+
+// cosineGeom describes the pairs of vectors of CosineSimilarity. The i-th pair starts at offA[i] in a and offB[i] in b,
+// and has n values that are strideA and strideB apart.
+type cosineGeom struct {
+	offA, offB       []int
+	n                int
+	strideA, strideB int
+}
+
+func cosineF64(g *cosineGeom, a, b, ret []float64) {
+	for i := range g.offA {
+		var dot, na, nb float64
+		for k, ia, ib := 0, g.offA[i], g.offB[i]; k < g.n; k, ia, ib = k+1, ia+g.strideA, ib+g.strideB {
+			dot += a[ia] * b[ib]
+			na += a[ia] * a[ia]
+			nb += b[ib] * b[ib]
+		}
+		ret[i] = dot / (math.Max(math.Sqrt(na), cosineEps) * math.Max(math.Sqrt(nb), cosineEps))
+	}
+}
+
+func cosineF32(g *cosineGeom, a, b, ret []float32) {
+	for i := range g.offA {
+		var dot, na, nb float32
+		for k, ia, ib := 0, g.offA[i], g.offB[i]; k < g.n; k, ia, ib = k+1, ia+g.strideA, ib+g.strideB {
+			dot += a[ia] * b[ib]
+			na += a[ia] * a[ia]
+			nb += b[ib] * b[ib]
+		}
+		ret[i] = dot / (math32.Max(math32.Sqrt(na), cosineEps) * math32.Max(math32.Sqrt(nb), cosineEps))
+	}
+}
+
+// cdistF64 computes the distance between each row of the m×d matrix a and each row of the n×d matrix b directly.
+func cdistF64(a, b, ret []float64, m, n, d int, metric DistanceMetric) {
+	for i := 0; i < m; i++ {
+		x := a[i*d : (i+1)*d]
+		for j := 0; j < n; j++ {
+			y := b[j*d : (j+1)*d]
+			var acc float64
+			switch metric.kind {
+			case euclideanDist, sqEuclideanDist:
+				for k, v := range x {
+					diff := v - y[k]
+					acc += diff * diff
+				}
+				if metric.kind == euclideanDist {
+					acc = math.Sqrt(acc)
+				}
+			case manhattanDist:
+				for k, v := range x {
+					acc += math.Abs(v - y[k])
+				}
+			case chebyshevDist:
+				for k, v := range x {
+					acc = math.Max(acc, math.Abs(v-y[k]))
+				}
+			case minkowskiDist:
+				for k, v := range x {
+					acc += math.Pow(math.Abs(v-y[k]), metric.p)
+				}
+				acc = math.Pow(acc, 1/metric.p)
+			}
+			ret[i*n+j] = acc
+		}
+	}
+}
+
+func cdistF32(a, b, ret []float32, m, n, d int, metric DistanceMetric) {
+	p := float32(metric.p)
+	for i := 0; i < m; i++ {
+		x := a[i*d : (i+1)*d]
+		for j := 0; j < n; j++ {
+			y := b[j*d : (j+1)*d]
+			var acc float32
+			switch metric.kind {
+			case euclideanDist, sqEuclideanDist:
+				for k, v := range x {
+					diff := v - y[k]
+					acc += diff * diff
+				}
+				if metric.kind == euclideanDist {
+					acc = math32.Sqrt(acc)
+				}
+			case manhattanDist:
+				for k, v := range x {
+					acc += math32.Abs(v - y[k])
+				}
+			case chebyshevDist:
+				for k, v := range x {
+					acc = math32.Max(acc, math32.Abs(v-y[k]))
+				}
+			case minkowskiDist:
+				for k, v := range x {
+					acc += math32.Pow(math32.Abs(v-y[k]), p)
+				}
+				acc = math32.Pow(acc, 1/p)
+			}
+			ret[i*n+j] = acc
+		}
+	}
+}
+
+// cdistGemmF64 computes the (squared) Euclidean distances as ‖x‖² + ‖y‖² - 2x·y, where the dot products are a single Dgemm.
+// Rounding can make the squared distances slightly negative, so they are clamped at 0.
+func cdistGemmF64(a, b, ret []float64, m, n, d int, sq bool) {
+	whichblas.Dgemm(blas.NoTrans, blas.Trans, m, n, d, -2, a, d, b, d, 0, ret, n)
+	nb := make([]float64, n)
+	for j := range nb {
+		for _, v := range b[j*d : (j+1)*d] {
+			nb[j] += v * v
+		}
+	}
+	for i := 0; i < m; i++ {
+		var na float64
+		for _, v := range a[i*d : (i+1)*d] {
+			na += v * v
+		}
+		row := ret[i*n : (i+1)*n]
+		for j := range row {
+			v := math.Max(row[j]+na+nb[j], 0)
+			if !sq {
+				v = math.Sqrt(v)
+			}
+			row[j] = v
+		}
+	}
+}
+
+func cdistGemmF32(a, b, ret []float32, m, n, d int, sq bool) {
+	whichblas.Sgemm(blas.NoTrans, blas.Trans, m, n, d, -2, a, d, b, d, 0, ret, n)
+	nb := make([]float32, n)
+	for j := range nb {
+		for _, v := range b[j*d : (j+1)*d] {
+			nb[j] += v * v
+		}
+	}
+	for i := 0; i < m; i++ {
+		var na float32
+		for _, v := range a[i*d : (i+1)*d] {
+			na += v * v
+		}
+		row := ret[i*n : (i+1)*n]
+		for j := range row {
+			v := math32.Max(row[j]+na+nb[j], 0)
+			if !sq {
+				v = math32.Sqrt(v)
+			}
+			row[j] = v
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package tensor
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/pkg/errors"
+)
+
+var _ FFTer = StdEng{}
+
+// FFT computes the one dimensional discrete Fourier transform along the given axis. See the package level function FFT.
+func (e StdEng) FFT(t Tensor, n, axis int, norm FFTNorm) (retVal Tensor, err error) {
+	return e.fft(t, n, axis, norm, complexFFT, "FFT")
+}
+
+// IFFT computes the one dimensional inverse discrete Fourier transform along the given axis. See the package level function IFFT.
+func (e StdEng) IFFT(t Tensor, n, axis int, norm FFTNorm) (retVal Tensor, err error) {
+	return e.fft(t, n, axis, norm, complexIFFT, "IFFT")
+}
+
+// RFFT computes the one dimensional discrete Fourier transform of real values. See the package level function RFFT.
+func (e StdEng) RFFT(t Tensor, n, axis int, norm FFTNorm) (retVal Tensor, err error) {
+	return e.fft(t, n, axis, norm, realFFT, "RFFT")
+}
+
+// IRFFT computes the inverse of RFFT. See the package level function IRFFT.
+func (e StdEng) IRFFT(t Tensor, n, axis int, norm FFTNorm) (retVal Tensor, err error) {
+	return e.fft(t, n, axis, norm, realIFFT, "IRFFT")
+}
+
+// FFTN computes the discrete Fourier transform over several axes. See the package level function FFTN.
+func (e StdEng) FFTN(t Tensor, s, axes []int, norm FFTNorm) (retVal Tensor, err error) {
+	return e.fftn(t, s, axes, norm, complexFFT, "FFTN")
+}
+
+// IFFTN computes the inverse discrete Fourier transform over several axes. See the package level function IFFTN.
+func (e StdEng) IFFTN(t Tensor, s, axes []int, norm FFTNorm) (retVal Tensor, err error) {
+	return e.fftn(t, s, axes, norm, complexIFFT, "IFFTN")
+}
+
+type fftKind byte
+
+const (
+	complexFFT fftKind = iota
+	complexIFFT
+	realFFT
+	realIFFT
+)
+
+func (k fftKind) inverse() bool { return k == complexIFFT || k == realIFFT }
+
+func (e StdEng) fftn(t Tensor, s, axes []int, norm FFTNorm, kind fftKind, op string) (retVal Tensor, err error) {
+	dims := t.Dims()
+	switch {
+	case axes == nil && s == nil:
+		axes = make([]int, dims)
+		for i := range axes {
+			axes[i] = i
+		}
+	case axes == nil:
+		// like Numpy, the lengths apply to the last axes
+		if len(s) > dims {
+			return nil, errors.Errorf(dimMismatch, dims, len(s))
+		}
+		axes = make([]int, len(s))
+		for i := range axes {
+			axes[i] = dims - len(s) + i
+		}
+	}
+	if s != nil && len(s) != len(axes) {
+		return nil, errors.Errorf("Expected as many lengths as axes. Got %v and %v", s, axes)
+	}
+	if _, err = resolveAxes(axes, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+
+	retVal = t
+	for i, a := range axes {
+		n := -1
+		if s != nil {
+			n = s[i]
+		}
+		if retVal, err = e.fft(retVal, n, a, norm, kind, op); err != nil {
+			return nil, err
+		}
+	}
+	return retVal, nil
+}
+
+// fft transforms all the lanes of t along the axis. n is the length of the transform, with n < 1 standing for the default length.
+func (e StdEng) fft(t Tensor, n, axis int, norm FFTNorm, kind fftKind, op string) (retVal Tensor, err error) {
+	if norm > FFTNormForward {
+		return nil, errors.Errorf("Unknown FFT normalization %v", norm)
+	}
+	var x DenseTensor
+	if x, err = e.contiguous(t, op); err != nil {
+		return nil, err
+	}
+
+	var outDt Dtype
+	switch dt := x.Dtype(); {
+	case kind == realFFT && dt == Float32, kind < realFFT && (dt == Float32 || dt == Complex64):
+		outDt = Complex64
+	case kind == realFFT && dt == Float64, kind < realFFT && (dt == Float64 || dt == Complex128):
+		outDt = Complex128
+	case kind == realIFFT && dt == Complex64:
+		outDt = Float32
+	case kind == realIFFT && dt == Complex128:
+		outDt = Float64
+	default:
+		return nil, errors.Errorf(unsupportedDtype, dt, op)
+	}
+
+	shape := x.Shape()
+	dims := len(shape)
+	if dims == 0 {
+		return nil, errors.Errorf(atleastDims, 1)
+	}
+	if axis >= dims || axis < -dims {
+		return nil, errors.Errorf(invalidAxis, axis, dims)
+	}
+	axis = resolveAxis(axis, dims)
+
+	// the length of the transform, the number of input values it reads, and the number of output values it writes
+	inLen := shape[axis]
+	if n < 1 {
+		n = inLen
+		if kind == realIFFT {
+			n = 2 * (inLen - 1)
+		}
+	}
+	if n < 1 {
+		return nil, errors.Errorf("Invalid number of FFT data points (%d) along axis %d", n, axis)
+	}
+	read, outLen := n, n
+	switch kind {
+	case realFFT:
+		outLen = n/2 + 1
+	case realIFFT:
+		read = n/2 + 1
+	}
+	read = MinInt(read, inLen)
+
+	outShape := shape.Clone()
+	outShape[axis] = outLen
+	ret := New(WithShape(outShape...), Of(outDt), WithEngine(e))
+
+	inner := shape[axis+1:].TotalSize()
+	lanes := shape[:axis].TotalSize() * inner
+	p := newFFTPlan(n)
+	scale := complex(fftScale(n, norm, kind.inverse()), 0)
+	buf := make([]complex128, n)
+	for l := 0; l < lanes; l++ {
+		inStart := (l/inner)*inLen*inner + l%inner
+		outStart := (l/inner)*outLen*inner + l%inner
+
+		for i := range buf {
+			buf[i] = 0
+		}
+		fftRead(x, buf[:read], inStart, inner)
+		if kind == realIFFT {
+			// rebuild the Hermitian symmetric spectrum of a real signal. Like Numpy, the imaginary parts of X[0] and X[n/2] are ignored
+			buf[0] = complex(real(buf[0]), 0)
+			if n%2 == 0 && read > n/2 {
+				buf[n/2] = complex(real(buf[n/2]), 0)
+			}
+			for k := 1; k < (n+1)/2; k++ {
+				buf[n-k] = cmplx.Conj(buf[k])
+			}
+		}
+
+		p.transform(buf, kind.inverse())
+		for i := range buf[:outLen] {
+			buf[i] *= scale
+		}
+		fftWrite(ret, buf[:outLen], outStart, inner)
+	}
+	return ret, nil
+}
+
+// fftScale returns the factor the result of a transform of length n is scaled by, following Numpy's normalization modes.
+func fftScale(n int, norm FFTNorm, inverse bool) float64 {
+	switch {
+	case norm == FFTNormOrtho:
+		return 1 / math.Sqrt(float64(n))
+	case (norm == FFTNormBackward) == inverse:
+		return 1 / float64(n)
+	}
+	return 1
+}
+
+// fftRead reads the len(buf) values of the lane that starts at start and whose values are stride apart.
+func fftRead(x DenseTensor, buf []complex128, start, stride int) {
+	um := x.(unsafeMem)
+	switch x.Dtype() {
+	case Complex128:
+		data := um.Complex128s()
+		for i := range buf {
+			buf[i] = data[start+i*stride]
+		}
+	case Complex64:
+		data := um.Complex64s()
+		for i := range buf {
+			buf[i] = complex128(data[start+i*stride])
+		}
+	case Float64:
+		data := um.Float64s()
+		for i := range buf {
+			buf[i] = complex(data[start+i*stride], 0)
+		}
+	case Float32:
+		data := um.Float32s()
+		for i := range buf {
+			buf[i] = complex(float64(data[start+i*stride]), 0)
+		}
+	}
+}
+
+// fftWrite writes buf into the lane of ret that starts at start and whose values are stride apart. Real tensors get the real parts.
+func fftWrite(ret *Dense, buf []complex128, start, stride int) {
+	switch ret.Dtype() {
+	case Complex128:
+		data := ret.Complex128s()
+		for i, v := range buf {
+			data[start+i*stride] = v
+		}
+	case Complex64:
+		data := ret.Complex64s()
+		for i, v := range buf {
+			data[start+i*stride] = complex64(v)
+		}
+	case Float64:
+		data := ret.Float64s()
+		for i, v := range buf {
+			data[start+i*stride] = real(v)
+		}
+	case Float32:
+		data := ret.Float32s()
+		for i, v := range buf {
+			data[start+i*stride] = float32(real(v))
+		}
+	}
+}
+
+// fftPlan holds what is needed to compute unnormalized discrete Fourier transforms of length n.
+// Powers of two use the iterative radix-2 Cooley-Tukey algorithm. Other lengths use Bluestein's algorithm,
+// which turns the transform into a convolution computed with transforms of a power of two length.
+type fftPlan struct {
+	n int
+
+	// radix-2
+	twiddles []complex128 // e^(-2πik/n) for k < n/2
+	rev      []int        // the bit reversal permutation
+
+	// Bluestein
+	chirp []complex128 // e^(-πik²/n) for k < n
+	kern  []complex128 // the transform of the convolution kernel
+	sub   *fftPlan     // the plan of the power of two length of the convolution
+	work  []complex128
+}
+
+func newFFTPlan(n int) *fftPlan {
+	p := &fftPlan{n: n}
+	if n&(n-1) == 0 {
+		p.twiddles = make([]complex128, n/2)
+		for k := range p.twiddles {
+			sin, cos := math.Sincos(-2 * math.Pi * float64(k) / float64(n))
+			p.twiddles[k] = complex(cos, sin)
+		}
+		var bits uint
+		for 1<<bits < n {
+			bits++
+		}
+		p.rev = make([]int, n)
+		for i := 1; i < n; i++ {
+			p.rev[i] = p.rev[i>>1]>>1 | (i&1)<<(bits-1)
+		}
+		return p
+	}
+
+	m := 1
+	for m < 2*n-1 {
+		m <<= 1
+	}
+	p.sub = newFFTPlan(m)
+	p.chirp = make([]complex128, n)
+	for k := range p.chirp {
+		// k² mod 2n keeps the angle small, and therefore precise
+		sin, cos := math.Sincos(-math.Pi * float64((k*k)%(2*n)) / float64(n))
+		p.chirp[k] = complex(cos, sin)
+	}
+	p.kern = make([]complex128, m)
+	p.kern[0] = 1
+	for k := 1; k < n; k++ {
+		p.kern[k] = cmplx.Conj(p.chirp[k])
+		p.kern[m-k] = p.kern[k]
+	}
+	p.sub.transform(p.kern, false)
+	p.work = make([]complex128, m)
+	return p
+}
+
+// transform computes the unnormalized (inverse) discrete Fourier transform of x in place.
+func (p *fftPlan) transform(x []complex128, inverse bool) {
+	if inverse {
+		// the inverse transform is conj(FFT(conj(x)))
+		for i, v := range x {
+			x[i] = cmplx.Conj(v)
+		}
+		defer func() {
+			for i, v := range x {
+				x[i] = cmplx.Conj(v)
+			}
+		}()
+	}
+	if p.sub == nil {
+		p.radix2(x)
+		return
+	}
+	p.bluestein(x)
+}
+
+func (p *fftPlan) radix2(x []complex128) {
+	n := p.n
+	for i, j := range p.rev {
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+	for size := 2; size <= n; size <<= 1 {
+		half, step := size/2, n/size
+		for start := 0; start < n; start += size {
+			for k := 0; k < half; k++ {
+				a, b := x[start+k], x[start+k+half]*p.twiddles[k*step]
+				x[start+k], x[start+k+half] = a+b, a-b
+			}
+		}
+	}
+}
+
+// bluestein computes X[k] = chirp[k] Σ x[j] chirp[j] conj(chirp[k-j]), where the sum is a convolution.
+func (p *fftPlan) bluestein(x []complex128) {
+	w := p.work
+	for i := range w {
+		w[i] = 0
+	}
+	for k, v := range x {
+		w[k] = v * p.chirp[k]
+	}
+	p.sub.transform(w, false)
+	for i, v := range p.kern {
+		w[i] *= v
+	}
+	p.sub.transform(w, true)
+	scale := complex(1/float64(len(w)), 0)
+	for k := range x {
+		x[k] = w[k] * scale * p.chirp[k]
+	}
+}
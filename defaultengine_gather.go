@@ -0,0 +1,112 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+// gatherGeom describes an operation where each value of the result is copied from some position of a contiguous input,
+// and the position along each axis of the result only depends on the index along that axis.
+// This covers padding, flipping, rolling, tiling and rotating.
+type gatherGeom struct {
+	shape Shape // the shape of the result
+
+	// src holds, for each axis of the result, the index along the source axis of each of its indices. -1 marks values that are not copied.
+	src [][]int
+
+	// strides holds, for each axis of the result, the stride of its source axis in the input.
+	strides []int
+}
+
+// newGatherGeom returns the geometry of copying a contiguous tensor of the given shape as is.
+func newGatherGeom(shape Shape) gatherGeom {
+	g := gatherGeom{
+		shape:   shape.Clone(),
+		src:     make([][]int, len(shape)),
+		strides: shape.CalcStrides(),
+	}
+	for a, n := range shape {
+		g.src[a] = make([]int, n)
+		for i := range g.src[a] {
+			g.src[a][i] = i
+		}
+	}
+	return g
+}
+
+// swap swaps two axes of the result.
+func (g gatherGeom) swap(a, b int) {
+	g.shape[a], g.shape[b] = g.shape[b], g.shape[a]
+	g.src[a], g.src[b] = g.src[b], g.src[a]
+	g.strides[a], g.strides[b] = g.strides[b], g.strides[a]
+}
+
+// walk calls fn with each index of the result in order, along with the index of its source in the input (or -1).
+func (g gatherGeom) walk(fn func(o, s int)) {
+	dims := len(g.shape)
+	if dims == 0 {
+		fn(0, 0)
+		return
+	}
+	coord := make([]int, dims)
+	last := dims - 1
+	for o, size := 0, g.shape.TotalSize(); o < size; {
+		base, skip := 0, false
+		for a := 0; a < last; a++ {
+			s := g.src[a][coord[a]]
+			if s < 0 {
+				skip = true
+				break
+			}
+			base += s * g.strides[a]
+		}
+		for _, s := range g.src[last] {
+			if skip || s < 0 {
+				fn(o, -1)
+			} else {
+				fn(o, base+s*g.strides[last])
+			}
+			o++
+		}
+		for a := last - 1; a >= 0; a-- {
+			if coord[a]++; coord[a] < g.shape[a] {
+				break
+			}
+			coord[a] = 0
+		}
+	}
+}
+
+// gatherInto copies the values of x into ret as described by g, along with the mask of x if there is one.
+// The values are copied as raw bytes, so that all Dtypes are handled alike.
+func gatherInto(ret *Dense, x DenseTensor, g gatherGeom) {
+	var mask []bool
+	if mt, ok := x.(MaskedTensor); ok && mt.IsMasked() {
+		mask = mt.Mask()
+		ret.makeMask()
+	}
+
+	size := int(x.Dtype().Size())
+	src, dst := x.arr().Header.Raw, ret.array.Header.Raw
+	g.walk(func(o, s int) {
+		if s < 0 {
+			return
+		}
+		copy(dst[o*size:(o+1)*size], src[s*size:(s+1)*size])
+		if mask != nil {
+			ret.mask[o] = mask[s]
+		}
+	})
+}
+
+// contiguous returns the data of t as a DenseTensor in its natural order, materializing views.
+func (e StdEng) contiguous(t Tensor, op string) (DenseTensor, error) {
+	if err := e.checkAccessible(t); err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+	if v, ok := t.(View); ok && v.IsMaterializable() {
+		t = v.Materialize()
+	}
+	d, err := getDenseTensor(t)
+	if err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+	return d, nil
+}
@@ -0,0 +1,301 @@
+package tensor
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+var _ Histogrammer = StdEng{}
+
+// Histogram counts the values of a float tensor that fall into each of bins equal bins. See the package level function Histogram.
+func (e StdEng) Histogram(t Tensor, bins int, lo, hi float64) (counts, edges Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguousFloat(t, "Histogram"); err != nil {
+		return nil, nil, err
+	}
+	if bins < 1 {
+		return nil, nil, errors.Errorf("Expected at least one bin. Got %d", bins)
+	}
+	vals, mask := asFloat64s(x), maskOf(x)
+	if lo, hi, err = histRange(vals, mask, 0, 1, lo, hi); err != nil {
+		return nil, nil, errors.Wrapf(err, opFail, "Histogram")
+	}
+
+	e64 := histEdges(bins, lo, hi)
+	c := make([]int, bins)
+	for i, v := range vals {
+		if mask != nil && mask[i] {
+			continue
+		}
+		if b := histBin(e64, v); b >= 0 {
+			c[b]++
+		}
+	}
+	return New(WithShape(bins), WithBacking(c), WithEngine(e)), edgesTensor(e64, x.Dtype(), e), nil
+}
+
+// HistogramDD counts the points of a (N, D) float tensor that fall into each cell of a D dimensional grid. See the package level function HistogramDD.
+func (e StdEng) HistogramDD(t Tensor, bins []int, ranges [][2]float64) (counts Tensor, edges []Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguousFloat(t, "HistogramDD"); err != nil {
+		return nil, nil, err
+	}
+	if x.Dims() != 2 {
+		return nil, nil, errors.Errorf(dimMismatch, 2, x.Dims())
+	}
+	n, d := x.Shape()[0], x.Shape()[1]
+	if len(bins) != d {
+		return nil, nil, errors.Errorf("Expected one number of bins per dimension (%d). Got %v", d, bins)
+	}
+	if ranges != nil && len(ranges) != d {
+		return nil, nil, errors.Errorf("Expected one range per dimension (%d). Got %v", d, ranges)
+	}
+	for _, b := range bins {
+		if b < 1 {
+			return nil, nil, errors.Errorf("Expected at least one bin per dimension. Got %v", bins)
+		}
+	}
+
+	// a point is skipped if any of its coordinates is masked
+	vals, mask := asFloat64s(x), maskOf(x)
+	skip := make([]bool, n)
+	if mask != nil {
+		for i := range mask {
+			skip[i/d] = skip[i/d] || mask[i]
+		}
+		mask = skipMask(skip, d)
+	}
+
+	allEdges := make([][]float64, d)
+	edges = make([]Tensor, d)
+	for j := range allEdges {
+		var lo, hi float64
+		if ranges != nil {
+			lo, hi = ranges[j][0], ranges[j][1]
+		}
+		if lo, hi, err = histRange(vals, mask, j, d, lo, hi); err != nil {
+			return nil, nil, errors.Wrapf(err, opFail, "HistogramDD")
+		}
+		allEdges[j] = histEdges(bins[j], lo, hi)
+		edges[j] = edgesTensor(allEdges[j], x.Dtype(), e)
+	}
+
+	shape := Shape(bins).Clone()
+	strides := shape.CalcStrides()
+	c := make([]int, shape.TotalSize())
+outer:
+	for i := 0; i < n; i++ {
+		if skip[i] {
+			continue
+		}
+		var cell int
+		for j := 0; j < d; j++ {
+			b := histBin(allEdges[j], vals[i*d+j])
+			if b < 0 {
+				continue outer
+			}
+			cell += b * strides[j]
+		}
+		c[cell]++
+	}
+	return New(WithShape(shape...), WithBacking(c), WithEngine(e)), edges, nil
+}
+
+// Bincount counts the occurrences of each non negative integer in an Int tensor. See the package level function Bincount.
+func (e StdEng) Bincount(t, weights Tensor, minlength int) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguousInts(t, "Bincount"); err != nil {
+		return nil, err
+	}
+	if minlength < 0 {
+		return nil, errors.Errorf("minlength must not be negative. Got %d", minlength)
+	}
+	vals, mask := x.Ints(), maskOf(x)
+
+	length := minlength
+	for i, v := range vals {
+		if mask != nil && mask[i] {
+			continue
+		}
+		if v < 0 {
+			return nil, errors.Errorf("Bincount expects non negative values. Got %d", v)
+		}
+		if v >= length {
+			length = v + 1
+		}
+	}
+
+	if weights == nil {
+		c := make([]int, length)
+		for i, v := range vals {
+			if mask == nil || !mask[i] {
+				c[v]++
+			}
+		}
+		return New(WithShape(length), WithBacking(c), WithEngine(e)), nil
+	}
+
+	var w DenseTensor
+	if w, err = e.contiguousFloat(weights, "Bincount"); err != nil {
+		return nil, err
+	}
+	if !w.Shape().Eq(x.Shape()) {
+		return nil, errors.Errorf(shapeMismatch, x.Shape(), w.Shape())
+	}
+	ret := New(WithShape(length), Of(w.Dtype()), WithEngine(e))
+	switch w.Dtype() {
+	case Float64:
+		c, ws := ret.Float64s(), w.Float64s()
+		for i, v := range vals {
+			if mask == nil || !mask[i] {
+				c[v] += ws[i]
+			}
+		}
+	case Float32:
+		c, ws := ret.Float32s(), w.Float32s()
+		for i, v := range vals {
+			if mask == nil || !mask[i] {
+				c[v] += ws[i]
+			}
+		}
+	}
+	return ret, nil
+}
+
+// Digitize returns the indices of the bins the values of a float tensor fall into. See the package level function Digitize.
+func (e StdEng) Digitize(t, edges Tensor, right bool) (retVal Tensor, err error) {
+	var x, ed DenseTensor
+	if x, err = e.contiguousFloat(t, "Digitize"); err != nil {
+		return nil, err
+	}
+	if ed, err = e.contiguousFloat(edges, "Digitize"); err != nil {
+		return nil, err
+	}
+	if ed.Dims() != 1 {
+		return nil, errors.Errorf(dimMismatch, 1, ed.Dims())
+	}
+	es := asFloat64s(ed)
+	for i := 1; i < len(es); i++ {
+		if !(es[i] >= es[i-1]) {
+			return nil, errors.Errorf("Expected the edges to be sorted in increasing order. Got %v", es)
+		}
+	}
+
+	ret := New(WithShape(x.Shape().Clone()...), Of(Int), WithEngine(e))
+	copyMask(ret, x)
+	idx := ret.Ints()
+	for i, v := range asFloat64s(x) {
+		switch {
+		case math.IsNaN(v):
+			// like Numpy, NaNs are past the last edge
+			idx[i] = len(es)
+		case right:
+			idx[i] = sort.Search(len(es), func(j int) bool { return es[j] >= v })
+		default:
+			idx[i] = sort.Search(len(es), func(j int) bool { return es[j] > v })
+		}
+	}
+	return ret, nil
+}
+
+// asFloat64s returns the values of a Float64 or Float32 tensor as float64s. The values of Float32 tensors are copied.
+func asFloat64s(x DenseTensor) []float64 {
+	if x.Dtype() == Float64 {
+		return x.Float64s()
+	}
+	f32 := x.Float32s()
+	retVal := make([]float64, len(f32))
+	for i, v := range f32 {
+		retVal[i] = float64(v)
+	}
+	return retVal
+}
+
+func maskOf(x DenseTensor) []bool {
+	if mt, ok := x.(MaskedTensor); ok && mt.IsMasked() {
+		return mt.Mask()
+	}
+	return nil
+}
+
+// skipMask returns the mask of the values of the points to skip.
+func skipMask(skip []bool, d int) []bool {
+	retVal := make([]bool, len(skip)*d)
+	for i := range retVal {
+		retVal[i] = skip[i/d]
+	}
+	return retVal
+}
+
+// histRange returns the range of the bins. lo == hi stands for the range of the values vals[start], vals[start+stride], ... that are not masked or NaN.
+// Like Numpy, the range of no values is [0, 1], and the range of equal values is widened by 0.5 on both sides.
+func histRange(vals []float64, mask []bool, start, stride int, lo, hi float64) (float64, float64, error) {
+	if lo != hi {
+		if !(lo < hi) || math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+			return 0, 0, errors.Errorf("Invalid range [%v, %v]", lo, hi)
+		}
+		return lo, hi, nil
+	}
+
+	lo, hi = math.Inf(1), math.Inf(-1)
+	for i := start; i < len(vals); i += stride {
+		if v := vals[i]; !math.IsNaN(v) && (mask == nil || !mask[i]) {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+	}
+	switch {
+	case lo > hi:
+		return 0, 1, nil
+	case math.IsInf(lo, 0) || math.IsInf(hi, 0):
+		return 0, 0, errors.Errorf("Unable to make bins of the range [%v, %v]", lo, hi)
+	case lo == hi:
+		return lo - 0.5, hi + 0.5, nil
+	}
+	return lo, hi, nil
+}
+
+func histEdges(bins int, lo, hi float64) []float64 {
+	edges := make([]float64, bins+1)
+	step := (hi - lo) / float64(bins)
+	for i := range edges {
+		edges[i] = lo + float64(i)*step
+	}
+	edges[bins] = hi
+	return edges
+}
+
+// histBin returns the bin of v, or -1 if v is NaN or out of range. The bins are half open, except for the last one, which includes its right edge.
+func histBin(edges []float64, v float64) int {
+	bins := len(edges) - 1
+	lo, hi := edges[0], edges[bins]
+	if !(v >= lo && v <= hi) {
+		return -1
+	}
+	if v == hi {
+		return bins - 1
+	}
+	b := int((v - lo) / (hi - lo) * float64(bins))
+	// the division may be off by one near the edges
+	switch {
+	case b >= bins:
+		b = bins - 1
+	case v < edges[b]:
+		b--
+	case b < bins-1 && v >= edges[b+1]:
+		b++
+	}
+	return b
+}
+
+func edgesTensor(edges []float64, dt Dtype, e Engine) *Dense {
+	if dt == Float32 {
+		e32 := make([]float32, len(edges))
+		for i, v := range edges {
+			e32[i] = float32(v)
+		}
+		return New(WithShape(len(edges)), WithBacking(e32), WithEngine(e))
+	}
+	return New(WithShape(len(edges)), WithBacking(edges), WithEngine(e))
+}
@@ -0,0 +1,387 @@
+package tensor
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+var _ Indexer = StdEng{}
+
+// advIndex is an index tensor (or an int) of an advanced indexing operation, with its values resolved to be non-negative.
+type advIndex struct {
+	idx     []int
+	stride  int   // the stride of the indexed axis
+	strides []int // the strides of idx in the broadcast shape of all the index tensors
+}
+
+// indexPlan maps each position of the result of an advanced indexing operation to the position in the indexed tensor that its value comes from.
+type indexPlan struct {
+	shape Shape // the shape of the result
+	base  int   // the position of the first value
+
+	// strides holds, for each axis of the result, the stride of the sliced axis it comes from. It is 0 for the broadcast axes of the index tensors.
+	strides []int
+
+	advAt  int   // the axis of the result where the broadcast axes start
+	bshape Shape // the broadcast shape of the index tensors
+	adv    []advIndex
+}
+
+// newIndexPlan resolves the indices of an indexing of a tensor with the given shape, strides and origin. See Index for what indices may hold.
+func newIndexPlan(shape Shape, strides []int, origin int, indices []interface{}) (p indexPlan, err error) {
+	if indices, err = expandIndices(indices, shape.Dims()); err != nil {
+		return p, err
+	}
+
+	p.base = origin
+	var bshapes []Shape
+	var shapes, basicStrides []int
+	firstAdv, lastAdv := -1, -1
+	adjacent := true
+	axis := 0
+	for _, ix := range indices {
+		if ix == NewAxis {
+			shapes = append(shapes, 1)
+			basicStrides = append(basicStrides, 0)
+			continue
+		}
+
+		n, stride := shape[axis], strides[axis]
+		axis++
+		var vals []int
+		var vshape Shape
+		switch i := ix.(type) {
+		case nil:
+			shapes = append(shapes, n)
+			basicStrides = append(basicStrides, stride)
+			continue
+		case Slice:
+			var start, end, step int
+			if start, end, step, err = SliceDetails(i, n); err != nil {
+				return p, err
+			}
+			if step == 0 {
+				step = 1
+			}
+			shapes = append(shapes, sliceLen(start, end, step))
+			basicStrides = append(basicStrides, stride*step)
+			p.base += start * stride
+			continue
+		case int:
+			vals, vshape = []int{i}, ScalarShape()
+		case []int:
+			vals, vshape = append([]int(nil), i...), Shape{len(i)}
+		case Tensor:
+			if i.Dtype() != Int {
+				return p, errors.Errorf(dtypeMismatch, Int, i.Dtype())
+			}
+			var d DenseTensor
+			if d, err = (StdEng{}).contiguous(i, "Index"); err != nil {
+				return p, err
+			}
+			vals, vshape = append([]int(nil), getInts(d)...), d.Shape().Clone()
+		default:
+			return p, errors.Errorf("Cannot index with %v of %T", ix, ix)
+		}
+
+		for j, v := range vals {
+			if v < 0 {
+				v += n
+			}
+			if v < 0 || v >= n {
+				return p, errors.Errorf(indexOOBAxis, vals[j], axis-1, n)
+			}
+			vals[j] = v
+		}
+		if lastAdv >= 0 && lastAdv != len(shapes) {
+			adjacent = false
+		}
+		if firstAdv < 0 {
+			firstAdv = len(shapes)
+		}
+		lastAdv = len(shapes)
+		bshapes = append(bshapes, vshape)
+		p.adv = append(p.adv, advIndex{idx: vals, stride: stride, strides: vshape.CalcStrides()})
+	}
+
+	if len(p.adv) == 0 {
+		p.shape, p.strides = Shape(shapes), basicStrides
+		return p, nil
+	}
+
+	if p.bshape, err = broadcastShapes(bshapes...); err != nil {
+		return p, errors.Wrapf(err, opFail, "Index")
+	}
+	for i := range p.adv {
+		p.adv[i].strides = broadcastedStrides(p.bshape, bshapes[i], p.adv[i].strides)
+	}
+
+	// Like in Numpy, the axes of the index tensors replace the indexed axes if they are all next to each other, and come first otherwise.
+	if adjacent {
+		p.advAt = firstAdv
+	}
+	p.shape = append(append(append(Shape{}, shapes[:p.advAt]...), p.bshape...), shapes[p.advAt:]...)
+	p.strides = append(append(append([]int{}, basicStrides[:p.advAt]...), make([]int, len(p.bshape))...), basicStrides[p.advAt:]...)
+	return p, nil
+}
+
+// expandIndices replaces the Ellipsis in indices (if any) by nils, and pads indices with nils up to the given number of dims.
+func expandIndices(indices []interface{}, dims int) ([]interface{}, error) {
+	var n, ellipses int
+	for _, ix := range indices {
+		switch ix {
+		case NewAxis:
+		case Ellipsis:
+			ellipses++
+		default:
+			n++
+		}
+	}
+	if ellipses > 1 {
+		return nil, errors.New("Indices can only have one Ellipsis")
+	}
+	if n > dims {
+		return nil, errors.Errorf(dimMismatch, dims, n)
+	}
+
+	retVal := make([]interface{}, 0, len(indices)+dims-n)
+	for _, ix := range indices {
+		if ix == Ellipsis {
+			for i := n; i < dims; i++ {
+				retVal = append(retVal, nil)
+			}
+			continue
+		}
+		retVal = append(retVal, ix)
+	}
+	if ellipses == 0 {
+		for i := n; i < dims; i++ {
+			retVal = append(retVal, nil)
+		}
+	}
+	return retVal, nil
+}
+
+// broadcastShapes returns the shape that all the given shapes broadcast to, aligning them by their last axes.
+func broadcastShapes(shapes ...Shape) (retVal Shape, err error) {
+	var dims int
+	for _, s := range shapes {
+		if len(s) > dims {
+			dims = len(s)
+		}
+	}
+	retVal = make(Shape, dims)
+	for i := range retVal {
+		retVal[i] = 1
+	}
+	for _, s := range shapes {
+		off := dims - len(s)
+		for i, n := range s {
+			switch r := retVal[off+i]; {
+			case r == n || n == 1:
+			case r == 1:
+				retVal[off+i] = n
+			default:
+				return nil, errors.Errorf(shapeMismatch, retVal, s)
+			}
+		}
+	}
+	return retVal, nil
+}
+
+// broadcastedStrides returns the strides with which a tensor of the given shape and strides is read when broadcast to the target shape.
+func broadcastedStrides(target, shape Shape, strides []int) []int {
+	retVal := make([]int, len(target))
+	off := len(target) - len(shape)
+	for i, n := range shape {
+		if n != 1 {
+			retVal[off+i] = strides[i]
+		}
+	}
+	return retVal
+}
+
+// walk calls fn with each index of the result in order, along with the position of its source.
+func (p indexPlan) walk(fn func(o, s int)) {
+	dims := len(p.shape)
+	coord := make([]int, dims)
+	nb := len(p.bshape)
+	for o, size := 0, p.shape.TotalSize(); o < size; o++ {
+		s := p.base
+		for a, c := range coord {
+			s += c * p.strides[a]
+		}
+		for _, ai := range p.adv {
+			var at int
+			for b, c := range coord[p.advAt : p.advAt+nb] {
+				at += c * ai.strides[b]
+			}
+			s += ai.idx[at] * ai.stride
+		}
+		fn(o, s)
+
+		for a := dims - 1; a >= 0; a-- {
+			if coord[a]++; coord[a] < p.shape[a] {
+				break
+			}
+			coord[a] = 0
+		}
+	}
+}
+
+// Index indexes a *Dense with ints, slices and Int tensors. See the package level function Index for the meaning of the indices.
+func (e StdEng) Index(t Tensor, indices ...interface{}) (retVal Tensor, err error) {
+	d, ok := t.(*Dense)
+	if !ok {
+		return nil, errors.Errorf(typeNYI, "Index", t)
+	}
+	if err = e.checkAccessible(d); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Index")
+	}
+	d = unconjugated(d).(*Dense)
+	var p indexPlan
+	if p, err = newIndexPlan(d.Shape(), d.Strides(), d.origin(), indices); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Index")
+	}
+
+	ret := New(WithShape(p.shape...), Of(d.Dtype()), WithEngine(e))
+	if d.IsMasked() {
+		ret.makeMask()
+	}
+	size := int(d.Dtype().Size())
+	src, dst := d.array.Header.Raw, ret.array.Header.Raw
+	p.walk(func(o, s int) {
+		copy(dst[o*size:(o+1)*size], src[s*size:(s+1)*size])
+		if ret.mask != nil {
+			ret.mask[o] = d.mask[s]
+		}
+	})
+	return ret, nil
+}
+
+// IndexPut sets the values of a *Dense picked by the indices to value. See the package level function IndexPut.
+func (e StdEng) IndexPut(t Tensor, value interface{}, indices ...interface{}) (err error) {
+	var d, v *Dense
+	var p indexPlan
+	if d, v, p, err = e.prepIndexAssign(t, value, indices, "IndexPut"); err != nil {
+		return err
+	}
+
+	size := int(d.Dtype().Size())
+	src, dst := v.array.Header.Raw, d.array.Header.Raw
+	p.walk(func(o, s int) {
+		copy(dst[s*size:(s+1)*size], src[o*size:(o+1)*size])
+	})
+	return nil
+}
+
+// IndexAdd adds value to the values of a *Dense picked by the indices. See the package level function IndexAdd.
+func (e StdEng) IndexAdd(t Tensor, value interface{}, indices ...interface{}) (err error) {
+	var d, v *Dense
+	var p indexPlan
+	if d, v, p, err = e.prepIndexAssign(t, value, indices, "IndexAdd"); err != nil {
+		return err
+	}
+
+	switch d.Dtype() {
+	case Int:
+		dst, src := d.Ints(), v.Ints()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Int8:
+		dst, src := d.Int8s(), v.Int8s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Int16:
+		dst, src := d.Int16s(), v.Int16s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Int32:
+		dst, src := d.Int32s(), v.Int32s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Int64:
+		dst, src := d.Int64s(), v.Int64s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Uint:
+		dst, src := d.Uints(), v.Uints()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Uint8:
+		dst, src := d.Uint8s(), v.Uint8s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Uint16:
+		dst, src := d.Uint16s(), v.Uint16s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Uint32:
+		dst, src := d.Uint32s(), v.Uint32s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Uint64:
+		dst, src := d.Uint64s(), v.Uint64s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Float32:
+		dst, src := d.Float32s(), v.Float32s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Float64:
+		dst, src := d.Float64s(), v.Float64s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Complex64:
+		dst, src := d.Complex64s(), v.Complex64s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	case Complex128:
+		dst, src := d.Complex128s(), v.Complex128s()
+		p.walk(func(o, s int) { dst[s] += src[o] })
+	default:
+		return errors.Errorf(unsupportedDtype, d.Dtype(), "IndexAdd")
+	}
+	return nil
+}
+
+// prepIndexAssign resolves the indices of t, and broadcasts value to the shape of the values that they pick.
+func (e StdEng) prepIndexAssign(t Tensor, value interface{}, indices []interface{}, op string) (d, v *Dense, p indexPlan, err error) {
+	var ok bool
+	if d, ok = t.(*Dense); !ok {
+		return nil, nil, p, errors.Errorf(typeNYI, op, t)
+	}
+	if err = e.checkAccessible(d); err != nil {
+		return nil, nil, p, errors.Wrapf(err, opFail, op)
+	}
+	if err = checkWritable(d); err != nil {
+		return nil, nil, p, errors.Wrapf(err, opFail, op)
+	}
+	if p, err = newIndexPlan(d.Shape(), d.Strides(), d.origin(), indices); err != nil {
+		return nil, nil, p, errors.Wrapf(err, opFail, op)
+	}
+
+	var vt Tensor
+	switch x := value.(type) {
+	case Tensor:
+		vt = x
+	default:
+		if typ := reflect.TypeOf(value); typ != d.Dtype().Type {
+			return nil, nil, p, errors.Errorf(dtypeMismatch, d.Dtype(), typ)
+		}
+		vt = New(FromScalar(value))
+	}
+	if vt.Dtype() != d.Dtype() {
+		return nil, nil, p, errors.Errorf(dtypeMismatch, d.Dtype(), vt.Dtype())
+	}
+	var x DenseTensor
+	if x, err = e.contiguous(vt, op); err != nil {
+		return nil, nil, p, err
+	}
+
+	// broadcast the value to the shape of the picked values
+	vshape := x.Shape()
+	var bshape Shape
+	if bshape, err = broadcastShapes(p.shape, vshape); err != nil || !bshape.Eq(p.shape) {
+		return nil, nil, p, errors.Errorf("Cannot broadcast a value of %v to the %v values picked by the indices", vshape, p.shape)
+	}
+	g := newGatherGeom(p.shape)
+	g.strides = broadcastedStrides(p.shape, vshape, vshape.CalcStrides())
+	off := len(p.shape) - len(vshape)
+	for a := range g.src {
+		if a < off || vshape[a-off] == 1 {
+			g.src[a] = make([]int, p.shape[a])
+		}
+	}
+	v = New(WithShape(p.shape...), Of(d.Dtype()), WithEngine(e))
+	gatherInto(v, x, g)
+	return d, v, p, nil
+}
@@ -0,0 +1,289 @@
+package tensor
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	_ Resizer      = StdEng{}
+	_ Interpolator = StdEng{}
+)
+
+// cubicA is the parameter of the cubic convolution of ResizeBicubic. -0.75 is what PyTorch and OpenCV use.
+const cubicA = -0.75
+
+// resizeTaps lists, for every output position along an axis, the n input positions that contribute to it and their weights.
+type resizeTaps struct {
+	n   int
+	idx []int
+	w   []float64
+}
+
+func newResizeTaps(in, out int, r Resampling) resizeTaps {
+	var scale float64
+	switch {
+	case !r.AlignCorners:
+		scale = float64(in) / float64(out)
+	case out > 1:
+		scale = float64(in-1) / float64(out-1)
+	}
+	src := func(o int) float64 {
+		if r.AlignCorners {
+			return float64(o) * scale
+		}
+		return (float64(o)+0.5)*scale - 0.5
+	}
+	clamp := func(i int) int {
+		if i < 0 {
+			return 0
+		}
+		if i >= in {
+			return in - 1
+		}
+		return i
+	}
+
+	var t resizeTaps
+	switch r.Method {
+	case ResizeNearest:
+		t.n = 1
+	case ResizeBilinear:
+		t.n = 2
+	case ResizeBicubic:
+		t.n = 4
+	}
+	t.idx = make([]int, out*t.n)
+	t.w = make([]float64, out*t.n)
+	for o := 0; o < out; o++ {
+		idx, w := t.idx[o*t.n:(o+1)*t.n], t.w[o*t.n:(o+1)*t.n]
+		switch r.Method {
+		case ResizeNearest:
+			if r.AlignCorners {
+				idx[0] = clamp(int(math.Floor(src(o) + 0.5)))
+			} else {
+				idx[0] = clamp(int(math.Floor(float64(o) * scale)))
+			}
+			w[0] = 1
+		case ResizeBilinear:
+			s := math.Max(src(o), 0)
+			i0 := clamp(int(math.Floor(s)))
+			l := s - float64(i0)
+			idx[0], idx[1] = i0, clamp(i0+1)
+			w[0], w[1] = 1-l, l
+		case ResizeBicubic:
+			s := src(o)
+			i0 := int(math.Floor(s))
+			l := s - float64(i0)
+			for k := range idx {
+				idx[k] = clamp(i0 - 1 + k)
+			}
+			w[0], w[1], w[2], w[3] = cubicFar(l+1), cubicNear(l), cubicNear(1-l), cubicFar(2-l)
+		}
+	}
+	return t
+}
+
+// cubicNear is the cubic convolution kernel for distances within 1, and cubicFar is for distances within 2.
+func cubicNear(x float64) float64 { return ((cubicA+2)*x-(cubicA+3))*x*x + 1 }
+func cubicFar(x float64) float64  { return ((cubicA*x-5*cubicA)*x+8*cubicA)*x - 4*cubicA }
+
+// resizeGeom is the geometry of a resize of a batch of images.
+type resizeGeom struct {
+	n, c, h, w, oh, ow int
+	layout             ImageLayout
+	ty, tx             resizeTaps
+}
+
+func newResizeGeom(shape Shape, newH, newW int, r Resampling) (g resizeGeom, err error) {
+	if r.Method > ResizeBicubic {
+		return g, errors.Errorf("Unknown resize method %v", r.Method)
+	}
+	if r.Layout != NCHW && r.Layout != NHWC {
+		return g, errors.Errorf("Unknown layout %v", r.Layout)
+	}
+	if shape.Dims() != 4 {
+		return g, errors.Errorf(dimMismatch, 4, shape.Dims())
+	}
+	if newH <= 0 || newW <= 0 {
+		return g, errors.Errorf("Expected a positive output size. Got %d×%d", newH, newW)
+	}
+	g.layout = r.Layout
+	g.n, g.oh, g.ow = shape[0], newH, newW
+	if r.Layout == NHWC {
+		g.h, g.w, g.c = shape[1], shape[2], shape[3]
+	} else {
+		g.c, g.h, g.w = shape[1], shape[2], shape[3]
+	}
+	if g.h <= 0 || g.w <= 0 {
+		return g, errors.Errorf("Expected images with a positive size. Got %d×%d", g.h, g.w)
+	}
+	g.ty = newResizeTaps(g.h, newH, r)
+	g.tx = newResizeTaps(g.w, newW, r)
+	return g, nil
+}
+
+func (g *resizeGeom) outShape() Shape {
+	if g.layout == NHWC {
+		return Shape{g.n, g.oh, g.ow, g.c}
+	}
+	return Shape{g.n, g.c, g.oh, g.ow}
+}
+
+// strides returns the strides of the batch, channel, row and column of an image of height h and width w.
+func (g *resizeGeom) strides(h, w int) (sn, sc, sy, sx int) {
+	if g.layout == NHWC {
+		return h * w * g.c, 1, w * g.c, g.c
+	}
+	return g.c * h * w, h * w, w, 1
+}
+
+// do calls fn with the offsets of every output value and of every input value that contributes to it, as well as its weight.
+func (g *resizeGeom) do(fn func(in, out int, w float64)) {
+	sn, sc, sy, sx := g.strides(g.h, g.w)
+	on, oc, oy, ox := g.strides(g.oh, g.ow)
+	for b := 0; b < g.n; b++ {
+		for c := 0; c < g.c; c++ {
+			for y := 0; y < g.oh; y++ {
+				for x := 0; x < g.ow; x++ {
+					out := b*on + c*oc + y*oy + x*ox
+					for i := y * g.ty.n; i < (y+1)*g.ty.n; i++ {
+						for j := x * g.tx.n; j < (x+1)*g.tx.n; j++ {
+							in := b*sn + c*sc + g.ty.idx[i]*sy + g.tx.idx[j]*sx
+							fn(in, out, g.ty.w[i]*g.tx.w[j])
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Resize resamples the spatial dimensions of a batch of images. See the package level function Resize.
+func (e StdEng) Resize(x Tensor, newH, newW int, r Resampling) (retVal Tensor, err error) {
+	var xd DenseTensor
+	if xd, err = e.contiguousFloat(x, "Resize"); err != nil {
+		return nil, err
+	}
+	var g resizeGeom
+	if g, err = newResizeGeom(xd.Shape(), newH, newW, r); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Resize")
+	}
+
+	src := asFloat64s(xd)
+	out := make([]float64, g.outShape().TotalSize())
+	g.do(func(in, o int, w float64) { out[o] += w * src[in] })
+	return floatsTensor(out, g.outShape(), xd.Dtype(), e), nil
+}
+
+// ResizeB computes the gradient of the input of Resize. See the package level function ResizeB.
+func (e StdEng) ResizeB(x, grad Tensor, r Resampling) (retVal Tensor, err error) {
+	if err = typeclassCheck(x.Dtype(), floatTypes); err != nil {
+		return nil, errors.Wrapf(err, opFail, "ResizeB")
+	}
+	var gd DenseTensor
+	if gd, err = e.contiguousFloat(grad, "ResizeB"); err != nil {
+		return nil, err
+	}
+	if gd.Dtype() != x.Dtype() {
+		return nil, errors.Errorf(dtypeMismatch, x.Dtype(), gd.Dtype())
+	}
+	if gd.Dims() != 4 {
+		return nil, errors.Errorf(dimMismatch, 4, gd.Dims())
+	}
+	newH, newW := gd.Shape()[2], gd.Shape()[3]
+	if r.Layout == NHWC {
+		newH, newW = gd.Shape()[1], gd.Shape()[2]
+	}
+	var g resizeGeom
+	if g, err = newResizeGeom(x.Shape(), newH, newW, r); err != nil {
+		return nil, errors.Wrapf(err, opFail, "ResizeB")
+	}
+	if !gd.Shape().Eq(g.outShape()) {
+		return nil, errors.Errorf(shapeMismatch, g.outShape(), gd.Shape())
+	}
+
+	dy := asFloat64s(gd)
+	dx := make([]float64, x.Shape().TotalSize())
+	g.do(func(in, o int, w float64) { dx[in] += w * dy[o] })
+	return floatsTensor(dx, x.Shape().Clone(), x.Dtype(), e), nil
+}
+
+// Interp evaluates a piecewise linear function. See the package level function Interp.
+func (e StdEng) Interp(x, xp, fp Tensor) (retVal Tensor, err error) {
+	var xd, xpd, fpd DenseTensor
+	if xd, err = e.contiguousFloat(x, "Interp"); err != nil {
+		return nil, err
+	}
+	if xpd, err = e.contiguousFloat(xp, "Interp"); err != nil {
+		return nil, err
+	}
+	if fpd, err = e.contiguousFloat(fp, "Interp"); err != nil {
+		return nil, err
+	}
+	if xpd.Dtype() != xd.Dtype() {
+		return nil, errors.Errorf(dtypeMismatch, xd.Dtype(), xpd.Dtype())
+	}
+	if fpd.Dtype() != xd.Dtype() {
+		return nil, errors.Errorf(dtypeMismatch, xd.Dtype(), fpd.Dtype())
+	}
+	if xpd.Dims() != 1 || fpd.Dims() != 1 {
+		return nil, errors.Errorf("Expected xp and fp to be vectors. Got %v and %v", xpd.Shape(), fpd.Shape())
+	}
+	if xpd.len() != fpd.len() {
+		return nil, errors.Errorf(sizeMismatch, xpd.len(), fpd.len())
+	}
+	if xpd.len() == 0 {
+		return nil, errors.New("Expected xp and fp to have at least one value")
+	}
+
+	xs, xps, fps := asFloat64s(xd), asFloat64s(xpd), asFloat64s(fpd)
+	for i := 1; i < len(xps); i++ {
+		if !(xps[i] >= xps[i-1]) {
+			return nil, errors.Errorf("Expected xp to be increasing. xp[%d] = %v comes after %v", i, xps[i], xps[i-1])
+		}
+	}
+
+	last := len(xps) - 1
+	out := make([]float64, len(xs))
+	for i, v := range xs {
+		switch {
+		case math.IsNaN(v):
+			out[i] = v
+		case v <= xps[0]:
+			out[i] = fps[0]
+		case v >= xps[last]:
+			out[i] = fps[last]
+		default:
+			// xps[j-1] < v <= xps[j]
+			j := sort.SearchFloat64s(xps, v)
+			if xps[j] == v {
+				out[i] = fps[j]
+				continue
+			}
+			l := (v - xps[j-1]) / (xps[j] - xps[j-1])
+			out[i] = fps[j-1] + l*(fps[j]-fps[j-1])
+		}
+	}
+	if xd.Shape().IsScalar() {
+		if xd.Dtype() == Float32 {
+			return New(FromScalar(float32(out[0])), WithEngine(e)), nil
+		}
+		return New(FromScalar(out[0]), WithEngine(e)), nil
+	}
+	return floatsTensor(out, xd.Shape().Clone(), xd.Dtype(), e), nil
+}
+
+// floatsTensor returns a tensor of the given float Dtype with the values of data. The values are copied for Float32.
+func floatsTensor(data []float64, shape Shape, dt Dtype, e Engine) *Dense {
+	if dt == Float32 {
+		d32 := make([]float32, len(data))
+		for i, v := range data {
+			d32[i] = float32(v)
+		}
+		return New(WithShape(shape...), WithBacking(d32), WithEngine(e))
+	}
+	return New(WithShape(shape...), WithBacking(data), WithEngine(e))
+}
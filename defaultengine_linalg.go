@@ -372,6 +372,7 @@ func (e StdEng) SVD(a Tensor, uv, full bool) (s, u, v Tensor, err error) {
 // Inner is a thin layer over BLAS's D/Sdot.
 // It returns a scalar value, wrapped in an interface{}, which is not quite nice.
 func (e StdEng) Inner(a, b Tensor) (retVal interface{}, err error) {
+	a, b = broadcastOperand(a), broadcastOperand(b)
 	var ad, bd DenseTensor
 	if ad, bd, err = e.checkTwoFloatComplexTensors(a, b); err != nil {
 		return nil, errors.Wrapf(err, opFail, "StdEng.Inner")
@@ -399,6 +400,13 @@ func (e StdEng) Inner(a, b Tensor) (retVal interface{}, err error) {
 // 		y = αA * x + βy
 // we set beta to 0, so we don't have to manually zero out the reused/retval tensor data
 func (e StdEng) MatVecMul(a, b, prealloc Tensor) (err error) {
+	// BLAS can only conjugate the matrix, and only as part of a transpose
+	a = conjOperand(a)
+	if isConjugated(b) {
+		b = b.(*Dense).Materialize()
+	}
+	a, b = broadcastOperand(a), broadcastOperand(b)
+
 	// check all are DenseTensors
 	var ad, bd, pd DenseTensor
 	if ad, bd, pd, err = e.checkThreeFloatComplexTensors(a, b, prealloc); err != nil {
@@ -418,6 +426,9 @@ func (e StdEng) MatVecMul(a, b, prealloc Tensor) (err error) {
 		lda = n
 	case do.IsRowMajor() && !z:
 		tA = blas.Trans
+		if isConjugated(a) {
+			tA = blas.ConjTrans
+		}
 		lda = n
 	case do.IsColMajor() && z:
 		tA = blas.Trans
@@ -471,6 +482,9 @@ func (e StdEng) MatVecMul(a, b, prealloc Tensor) (err error) {
 //		C = αA * B +  βC
 // To prevent needless zeroing out of the slice, we just set β to 0
 func (e StdEng) MatMul(a, b, prealloc Tensor) (err error) {
+	a, b = conjOperand(a), conjOperand(b)
+	a, b = broadcastOperand(a), broadcastOperand(b)
+
 	// check all are DenseTensors
 	var ad, bd, pd DenseTensor
 	if ad, bd, pd, err = e.checkThreeFloatComplexTensors(a, b, prealloc); err != nil {
@@ -519,6 +533,9 @@ func (e StdEng) MatMul(a, b, prealloc Tensor) (err error) {
 	tA, tB := blas.NoTrans, blas.NoTrans
 	if !ad.oldAP().IsZero() {
 		tA = blas.Trans
+		if isConjugated(a) {
+			tA = blas.ConjTrans
+		}
 		if ado.IsRowMajor() {
 			lda = m
 		} else {
@@ -527,6 +544,9 @@ func (e StdEng) MatMul(a, b, prealloc Tensor) (err error) {
 	}
 	if !bd.oldAP().IsZero() {
 		tB = blas.Trans
+		if isConjugated(b) {
+			tB = blas.ConjTrans
+		}
 		if bdo.IsRowMajor() {
 			ldb = bd.Shape()[0]
 		} else {
@@ -577,8 +597,43 @@ func (e StdEng) MatMul(a, b, prealloc Tensor) (err error) {
 	return
 }
 
+// conjOperand returns the operand of a BLAS call. Conjugate transposes of row major matrices are passed on as they are,
+// as BLAS conjugates them with ConjTrans. Any other lazily conjugated view is materialized.
+func conjOperand(t Tensor) Tensor {
+	d, ok := t.(*Dense)
+	if !ok || !d.IsConjugated() {
+		return t
+	}
+	if !d.old.IsZero() && d.DataOrder().IsRowMajor() {
+		return t
+	}
+	return d.Materialize()
+}
+
+// broadcastOperand returns the operand of a BLAS call. BLAS cannot step through an axis with a stride of 0, so views
+// that repeat their elements, such as broadcast views, are materialized.
+func broadcastOperand(t Tensor) Tensor {
+	d, ok := t.(*Dense)
+	if !ok {
+		return t
+	}
+	shape := d.Shape()
+	for i, s := range d.Strides() {
+		if s == 0 && shape[i] > 1 {
+			return d.Materialize()
+		}
+	}
+	return t
+}
+
+func isConjugated(t Tensor) bool {
+	d, ok := t.(*Dense)
+	return ok && d.IsConjugated()
+}
+
 // Outer is a thin wrapper over S/Dger
 func (e StdEng) Outer(a, b, prealloc Tensor) (err error) {
+	a, b = broadcastOperand(a), broadcastOperand(b)
 	// check all are DenseTensors
 	var ad, bd, pd DenseTensor
 	if ad, bd, pd, err = e.checkThreeFloatComplexTensors(a, b, prealloc); err != nil {
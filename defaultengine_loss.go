@@ -0,0 +1,314 @@
+package tensor
+
+import (
+	"math"
+
+	"github.com/chewxy/math32"
+	"github.com/pkg/errors"
+	"gorgonia.org/tensor/internal/execution"
+)
+
+var _ CrossEntropyer = StdEng{}
+
+// xentGeom describes a batch of class scores laid out as (outer, classes, inner), where the classes are along the axis being reduced.
+// Samples are numbered in the row major order of the remaining (outer, inner) dimensions, which is also the order of the targets.
+type xentGeom struct {
+	outer, classes, inner int
+	opt                   lossOpt
+}
+
+// row returns the offset of the first class of sample s, and the distance between successive classes.
+func (g *xentGeom) row(s int) (start, stride int) {
+	o, in := divmod(s, g.inner)
+	return o*g.classes*g.inner + in, g.inner
+}
+
+func (g *xentGeom) ignored(target int) bool { return g.opt.hasIgnore && target == g.opt.ignore }
+
+// counted returns the number of samples that are not ignored.
+func (g *xentGeom) counted(targets []int) (n int) {
+	for _, t := range targets {
+		if !g.ignored(t) {
+			n++
+		}
+	}
+	return
+}
+
+// targetShape returns the shape of the scores without the class axis.
+func targetShape(s Shape, axis int) Shape {
+	retVal := make(Shape, 0, len(s)-1)
+	retVal = append(retVal, s[:axis]...)
+	return append(retVal, s[axis+1:]...)
+}
+
+func (e StdEng) crossEntropyPrep(x, targets Tensor, axis int, op string, opts ...LossOpt) (xd, td DenseTensor, g xentGeom, err error) {
+	if g.opt, err = parseLossOpts(opts...); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	if xd, err = e.contiguousFloat(x, op); err != nil {
+		return
+	}
+	if td, err = e.contiguousInts(targets, op); err != nil {
+		return
+	}
+	if xd.Dims() == 0 {
+		err = errors.Errorf(dimMismatch, 1, 0)
+		return
+	}
+
+	s := xd.Shape()
+	axis = resolveAxis(axis, len(s))
+	if ts := targetShape(s, axis); !td.Shape().Eq(ts) {
+		err = errors.Errorf(shapeMismatch, ts, td.Shape())
+		return
+	}
+	g.outer, g.classes, g.inner = ProdInts([]int(s[:axis])), s[axis], ProdInts([]int(s[axis+1:]))
+	for _, t := range getInts(td) {
+		if (t < 0 || t >= g.classes) && !g.ignored(t) {
+			err = errors.Errorf("Target class %d is out of range for %d classes", t, g.classes)
+			return
+		}
+	}
+	return
+}
+
+func (e StdEng) crossEntropy(x, targets Tensor, axis int, fromLogits bool, op string, opts ...LossOpt) (retVal Tensor, err error) {
+	var xd, td DenseTensor
+	var g xentGeom
+	if xd, td, g, err = e.crossEntropyPrep(x, targets, axis, op, opts...); err != nil {
+		return nil, err
+	}
+	tgt := getInts(td)
+
+	var losses Tensor
+	if g.opt.reduction == ReduceNone && !td.Shape().IsScalar() {
+		losses = New(WithShape(td.Shape().Clone()...), Of(xd.Dtype()), WithEngine(e))
+	} else {
+		losses = New(WithShape(len(tgt)), Of(xd.Dtype()), WithEngine(e))
+	}
+
+	n := float64(g.counted(tgt))
+	switch xd.Dtype() {
+	case Float64:
+		l := getFloat64s(losses)
+		xentF64(&g, getFloat64s(xd), tgt, fromLogits, l)
+		switch g.opt.reduction {
+		case ReduceNone:
+			if td.Shape().IsScalar() {
+				return New(FromScalar(l[0])), nil
+			}
+			return losses, nil
+		case ReduceSum:
+			return New(FromScalar(execution.SumF64(l))), nil
+		default:
+			return New(FromScalar(execution.SumF64(l) / n)), nil
+		}
+	case Float32:
+		l := getFloat32s(losses)
+		xentF32(&g, getFloat32s(xd), tgt, fromLogits, l)
+		switch g.opt.reduction {
+		case ReduceNone:
+			if td.Shape().IsScalar() {
+				return New(FromScalar(l[0])), nil
+			}
+			return losses, nil
+		case ReduceSum:
+			return New(FromScalar(execution.SumF32(l))), nil
+		default:
+			return New(FromScalar(execution.SumF32(l) / float32(n))), nil
+		}
+	}
+	panic("unreachable")
+}
+
+func (e StdEng) crossEntropyB(x, targets, grad Tensor, axis int, fromLogits bool, op string, opts ...LossOpt) (retVal Tensor, err error) {
+	var xd, td, gd DenseTensor
+	var g xentGeom
+	if xd, td, g, err = e.crossEntropyPrep(x, targets, axis, op, opts...); err != nil {
+		return nil, err
+	}
+	if gd, err = e.contiguousFloat(grad, op); err != nil {
+		return nil, err
+	}
+	if gd.Dtype() != xd.Dtype() {
+		return nil, errors.Errorf(dtypeMismatch, xd.Dtype(), gd.Dtype())
+	}
+	tgt := getInts(td)
+
+	// scales holds the gradient of the loss of each sample
+	scales := make([]float64, len(tgt))
+	var gs []float64
+	switch gd.Dtype() {
+	case Float64:
+		gs = getFloat64s(gd)
+	case Float32:
+		gs = make([]float64, gd.Size())
+		for i, v := range getFloat32s(gd) {
+			gs[i] = float64(v)
+		}
+	}
+	switch g.opt.reduction {
+	case ReduceNone:
+		if len(gs) != len(tgt) {
+			return nil, errors.Errorf(shapeMismatch, td.Shape(), gd.Shape())
+		}
+		copy(scales, gs)
+	default:
+		if len(gs) != 1 {
+			return nil, errors.Errorf(shapeMismatch, ScalarShape(), gd.Shape())
+		}
+		v := gs[0]
+		if g.opt.reduction == ReduceMean {
+			v /= float64(g.counted(tgt))
+		}
+		for i := range scales {
+			scales[i] = v
+		}
+	}
+
+	dx := New(WithShape(xd.Shape().Clone()...), Of(xd.Dtype()), WithEngine(e))
+	switch xd.Dtype() {
+	case Float64:
+		xentBF64(&g, getFloat64s(xd), tgt, fromLogits, scales, getFloat64s(dx))
+	case Float32:
+		xentBF32(&g, getFloat32s(xd), tgt, fromLogits, scales, getFloat32s(dx))
+	}
+	return dx, nil
+}
+
+// SoftmaxCrossEntropy computes the cross entropy of the softmax of the logits without materializing the softmax.
+func (e StdEng) SoftmaxCrossEntropy(logits, targets Tensor, axis int, opts ...LossOpt) (Tensor, error) {
+	return e.crossEntropy(logits, targets, axis, true, "SoftmaxCrossEntropy", opts...)
+}
+
+// SoftmaxCrossEntropyB computes the gradient of SoftmaxCrossEntropy, which is simply (softmax(logits) - q) scaled by the gradient of the loss.
+func (e StdEng) SoftmaxCrossEntropyB(logits, targets, grad Tensor, axis int, opts ...LossOpt) (Tensor, error) {
+	return e.crossEntropyB(logits, targets, grad, axis, true, "SoftmaxCrossEntropyB", opts...)
+}
+
+// NLLLoss computes the negative log likelihood of the targets. The classes are along the last axis.
+func (e StdEng) NLLLoss(logProbs, targets Tensor, opts ...LossOpt) (Tensor, error) {
+	return e.crossEntropy(logProbs, targets, -1, false, "NLLLoss", opts...)
+}
+
+// NLLLossB computes the gradient of NLLLoss.
+func (e StdEng) NLLLossB(logProbs, targets, grad Tensor, opts ...LossOpt) (Tensor, error) {
+	return e.crossEntropyB(logProbs, targets, grad, -1, false, "NLLLossB", opts...)
+}
+
+/* float64 kernels */
+
+// xentF64 computes the loss of each sample: -Σ q·log p, where q is the (smoothed) target distribution.
+// If fromLogits is true, log p is computed from x with a log softmax, otherwise x is taken to be log p.
+func xentF64(g *xentGeom, x []float64, targets []int, fromLogits bool, losses []float64) {
+	eps := g.opt.smoothing
+	for s, t := range targets {
+		if g.ignored(t) {
+			losses[s] = 0
+			continue
+		}
+		start, stride := g.row(s)
+
+		// log p = x - shift
+		var shift float64
+		if fromLogits {
+			max, lse := logSumExpF64(x, start, stride, g.classes)
+			shift = max + lse
+		}
+		loss := -(1 - eps) * (x[start+t*stride] - shift)
+		if eps > 0 {
+			var sum float64
+			for c := 0; c < g.classes; c++ {
+				sum += x[start+c*stride] - shift
+			}
+			loss -= eps / float64(g.classes) * sum
+		}
+		losses[s] = loss
+	}
+}
+
+func xentBF64(g *xentGeom, x []float64, targets []int, fromLogits bool, scales, dx []float64) {
+	eps := g.opt.smoothing
+	uniform := eps / float64(g.classes)
+	for s, t := range targets {
+		if g.ignored(t) {
+			continue
+		}
+		start, stride := g.row(s)
+		var shift float64
+		if fromLogits {
+			max, lse := logSumExpF64(x, start, stride, g.classes)
+			shift = max + lse
+		}
+		for c := 0; c < g.classes; c++ {
+			q := uniform
+			if c == t {
+				q += 1 - eps
+			}
+			i := start + c*stride
+			if fromLogits {
+				dx[i] = (math.Exp(x[i]-shift) - q) * scales[s]
+			} else {
+				dx[i] = -q * scales[s]
+			}
+		}
+	}
+}
+
+/* float32 kernels */
+
+func xentF32(g *xentGeom, x []float32, targets []int, fromLogits bool, losses []float32) {
+	eps := float32(g.opt.smoothing)
+	for s, t := range targets {
+		if g.ignored(t) {
+			losses[s] = 0
+			continue
+		}
+		start, stride := g.row(s)
+		var shift float32
+		if fromLogits {
+			max, lse := logSumExpF32(x, start, stride, g.classes)
+			shift = max + lse
+		}
+		loss := -(1 - eps) * (x[start+t*stride] - shift)
+		if eps > 0 {
+			var sum float32
+			for c := 0; c < g.classes; c++ {
+				sum += x[start+c*stride] - shift
+			}
+			loss -= eps / float32(g.classes) * sum
+		}
+		losses[s] = loss
+	}
+}
+
+func xentBF32(g *xentGeom, x []float32, targets []int, fromLogits bool, scales []float64, dx []float32) {
+	eps := float32(g.opt.smoothing)
+	uniform := eps / float32(g.classes)
+	for s, t := range targets {
+		if g.ignored(t) {
+			continue
+		}
+		start, stride := g.row(s)
+		var shift float32
+		if fromLogits {
+			max, lse := logSumExpF32(x, start, stride, g.classes)
+			shift = max + lse
+		}
+		scale := float32(scales[s])
+		for c := 0; c < g.classes; c++ {
+			q := uniform
+			if c == t {
+				q += 1 - eps
+			}
+			i := start + c*stride
+			if fromLogits {
+				dx[i] = (math32.Exp(x[i]-shift) - q) * scale
+			} else {
+				dx[i] = -q * scale
+			}
+		}
+	}
+}
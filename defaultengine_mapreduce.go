@@ -21,6 +21,11 @@ func (e StdEng) Map(fn interface{}, a Tensor, opts ...FuncOpt) (retVal Tensor, e
 	if reuse, safe, _, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return
 	}
+	if !safe {
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.Map")
+		}
+	}
 	switch {
 	case safe && reuse == nil:
 		// create reuse
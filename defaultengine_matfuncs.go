@@ -0,0 +1,285 @@
+package tensor
+
+import (
+	"math"
+
+	"github.com/chewxy/math32"
+	"github.com/pkg/errors"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Pinver        = StdEng{}
+	_ MatrixRanker  = StdEng{}
+	_ MatrixPowerer = StdEng{}
+	_ Kroner        = StdEng{}
+	_ Conder        = StdEng{}
+)
+
+// Pinv computes the Moore-Penrose pseudo-inverse of a matrix from its SVD.
+// Singular values at or below rcond times the largest one are treated as zeroes. A negative rcond picks max(m, n)·ε.
+func (e StdEng) Pinv(a Tensor, rcond float64) (retVal Tensor, err error) {
+	var t *Dense
+	var m *mat.Dense
+	if t, m, err = e.floatMatrix(a, "Pinv"); err != nil {
+		return nil, err
+	}
+
+	var svd mat.SVD
+	if !svd.Factorize(m, mat.SVDThin) {
+		return nil, errors.Errorf("Unable to compute SVD")
+	}
+	s := svd.Values(nil)
+	var u, v mat.Dense
+	svd.UTo(&u)
+	svd.VTo(&v)
+
+	cutoff := svCutoff(s, rcond, t)
+	for i, sv := range s {
+		if sv > cutoff {
+			s[i] = 1 / sv
+		} else {
+			s[i] = 0
+		}
+	}
+
+	// A⁺ = V Σ⁺ Uᵀ
+	var vs, p mat.Dense
+	vs.Mul(&v, mat.NewDiagDense(len(s), s))
+	p.Mul(&vs, u.T())
+	return FromMat64(&p, UseUnsafe(), As(t.t)), nil
+}
+
+// MatrixRank returns the number of singular values of a matrix above tol. A negative tol picks the largest singular value times max(m, n)·ε.
+func (e StdEng) MatrixRank(a Tensor, tol float64) (retVal int, err error) {
+	var t *Dense
+	var m *mat.Dense
+	if t, m, err = e.floatMatrix(a, "MatrixRank"); err != nil {
+		return 0, err
+	}
+
+	var svd mat.SVD
+	if !svd.Factorize(m, mat.SVDNone) {
+		return 0, errors.Errorf("Unable to compute SVD")
+	}
+	s := svd.Values(nil)
+	if tol < 0 {
+		tol = svCutoff(s, tol, t)
+	}
+	for _, sv := range s {
+		if sv > tol {
+			retVal++
+		}
+	}
+	return retVal, nil
+}
+
+// MatrixPower raises a square matrix to the n-th power by repeated squaring. Negative powers are powers of the inverse of the matrix,
+// which requires a float matrix that is not singular. The 0-th power is the identity matrix.
+func (e StdEng) MatrixPower(a Tensor, n int) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(a, "MatrixPower"); err != nil {
+		return nil, err
+	}
+	shape := x.Shape()
+	if len(shape) != 2 || shape[0] != shape[1] {
+		return nil, errors.Errorf("Cannot raise a matrix of %v to a power. Expected a square matrix", shape)
+	}
+	if err = typeclassCheck(x.Dtype(), floatcmplxTypes); err != nil {
+		return nil, errors.Wrapf(err, opFail, "MatrixPower")
+	}
+
+	if n == 0 {
+		return I(x.Dtype(), shape[0], shape[0], 0), nil
+	}
+
+	var base Tensor = x
+	if n < 0 {
+		var t *Dense
+		var m *mat.Dense
+		if t, m, err = e.floatMatrix(x, "MatrixPower"); err != nil {
+			return nil, err
+		}
+		var inv mat.Dense
+		if err = invert(&inv, m); err != nil {
+			return nil, errors.Wrapf(err, opFail, "MatrixPower")
+		}
+		base = FromMat64(&inv, UseUnsafe(), As(t.t))
+		n = -n
+	}
+
+	matMul := func(p, q Tensor) (Tensor, error) {
+		ret := New(WithShape(shape.Clone()...), Of(x.Dtype()), WithEngine(e))
+		if err := e.MatMul(p, q, ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "MatrixPower")
+		}
+		return ret, nil
+	}
+
+	for n > 0 {
+		if n&1 == 1 {
+			if retVal == nil {
+				retVal = base
+			} else if retVal, err = matMul(retVal, base); err != nil {
+				return nil, err
+			}
+		}
+		if n >>= 1; n > 0 {
+			if base, err = matMul(base, base); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if retVal == Tensor(x) {
+		retVal = x.Clone().(Tensor)
+	}
+	return retVal, nil
+}
+
+// Kron computes the Kronecker product of two tensors of any number of dimensions.
+// The shapes are padded with leading 1s to the same number of dimensions, and the result has the shape a.Shape()[i] * b.Shape()[i].
+func (e StdEng) Kron(a, b Tensor) (retVal Tensor, err error) {
+	if a.Dtype() != b.Dtype() {
+		return nil, errors.Errorf(dtypeMismatch, a.Dtype(), b.Dtype())
+	}
+	if err = typeclassCheck(a.Dtype(), numberTypes); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Kron")
+	}
+
+	var x, y DenseTensor
+	if x, err = e.contiguous(a, "Kron"); err != nil {
+		return nil, err
+	}
+	if y, err = e.contiguous(b, "Kron"); err != nil {
+		return nil, err
+	}
+
+	as, bs := x.Shape().Clone(), y.Shape().Clone()
+	for len(as) < len(bs) {
+		as = append(Shape{1}, as...)
+	}
+	for len(bs) < len(as) {
+		bs = append(Shape{1}, bs...)
+	}
+
+	// every value of a is repeated into a block the size of b, and b is tiled once per value of a. The product is then elementwise.
+	ga, gb := newGatherGeom(as), newGatherGeom(bs)
+	for i := range as {
+		n := as[i] * bs[i]
+		ga.shape[i], gb.shape[i] = n, n
+		ga.src[i], gb.src[i] = make([]int, n), make([]int, n)
+		for j := 0; j < n; j++ {
+			ga.src[i][j] = j / bs[i]
+			gb.src[i][j] = j % bs[i]
+		}
+	}
+	ra := New(WithShape(ga.shape...), Of(a.Dtype()), WithEngine(e))
+	rb := New(WithShape(gb.shape...), Of(b.Dtype()), WithEngine(e))
+	gatherInto(ra, x, ga)
+	gatherInto(rb, y, gb)
+	if retVal, err = e.Mul(ra, rb, UseUnsafe()); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Kron")
+	}
+	return retVal, nil
+}
+
+// Cond computes the condition number of a matrix in the given norm, that is ‖A‖ ‖A⁻¹‖.
+// Norm(2) and Norm(-2) are computed from the singular values, and also work on non square matrices.
+// The other orders are those that (*Dense).Norm accepts for matrices. Singular matrices have a condition number of +Inf.
+func (e StdEng) Cond(a Tensor, ord NormOrder) (retVal float64, err error) {
+	var m *mat.Dense
+	if _, m, err = e.floatMatrix(a, "Cond"); err != nil {
+		return 0, err
+	}
+
+	if ord == Norm(2) || ord == Norm(-2) {
+		var svd mat.SVD
+		if !svd.Factorize(m, mat.SVDNone) {
+			return 0, errors.Errorf("Unable to compute SVD")
+		}
+		s := svd.Values(nil)
+		largest, smallest := s[0], s[len(s)-1]
+		if ord == Norm(-2) {
+			largest, smallest = smallest, largest
+		}
+		if smallest == 0 {
+			return math.Inf(1), nil
+		}
+		return largest / smallest, nil
+	}
+
+	if r, c := m.Dims(); r != c {
+		return 0, errors.Errorf("Cannot compute the condition number in %v of a matrix of (%d, %d). Expected a square matrix", ord, r, c)
+	}
+	var inv mat.Dense
+	if err = invert(&inv, m); err != nil {
+		return math.Inf(1), nil
+	}
+
+	var n, ninv *Dense
+	if n, err = FromMat64(m, UseUnsafe()).Norm(ord); err != nil {
+		return 0, errors.Wrapf(err, opFail, "Cond")
+	}
+	if ninv, err = FromMat64(&inv, UseUnsafe()).Norm(ord); err != nil {
+		return 0, errors.Wrapf(err, opFail, "Cond")
+	}
+	return n.Float64s()[0] * ninv.Float64s()[0], nil
+}
+
+// floatMatrix checks that a is a float *Dense matrix, and copies it into a *mat.Dense.
+func (e StdEng) floatMatrix(a Tensor, op string) (t *Dense, m *mat.Dense, err error) {
+	if err = e.checkAccessible(a); err != nil {
+		return nil, nil, errors.Wrapf(err, opFail, op)
+	}
+	var ok bool
+	if t, ok = a.(*Dense); !ok {
+		return nil, nil, errors.Errorf(typeNYI, op, a)
+	}
+	if err = typeclassCheck(t.Dtype(), floatTypes); err != nil {
+		return nil, nil, errors.Wrapf(err, opFail, op)
+	}
+	if !t.IsMatrix() {
+		return nil, nil, errors.Errorf(dimMismatch, 2, t.Dims())
+	}
+	if m, err = ToMat64(t); err != nil {
+		return nil, nil, errors.Wrapf(err, opFail, op)
+	}
+	return t, m, nil
+}
+
+// svCutoff returns rcond times the largest of the (descending) singular values s.
+// A negative rcond is replaced by the machine epsilon of the Dtype of t, times the largest side of t.
+func svCutoff(s []float64, rcond float64, t *Dense) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+	if rcond < 0 {
+		eps := math.Nextafter(1, 2) - 1
+		if t.t == Float32 {
+			eps = float64(math32.Nextafter(1, 2) - 1)
+		}
+		rcond = float64(MaxInt(t.Shape()[0], t.Shape()[1])) * eps
+	}
+	return rcond * s[0]
+}
+
+// invert inverts m into inv. Ill conditioned matrices are still inverted, but singular ones return an error.
+// The inverse is solved from the LU factorization of m, because (*mat.Dense).Inverse may report invertible matrices as singular.
+func invert(inv, m *mat.Dense) error {
+	n, _ := m.Dims()
+	var lu mat.LU
+	lu.Factorize(m)
+	eye := mat.NewDiagDense(n, nil)
+	for i := 0; i < n; i++ {
+		eye.SetDiag(i, 1)
+	}
+	err := lu.SolveTo(inv, false, eye)
+	if c, ok := err.(mat.Condition); ok && !math.IsInf(float64(c), 1) {
+		return nil
+	}
+	if err != nil {
+		return errors.Errorf("Cannot invert a singular matrix")
+	}
+	return nil
+}
@@ -235,7 +235,10 @@ func (e StdEng) Concat(t Tensor, axis int, others ...Tensor) (retVal Tensor, err
 		if denses, err = tensorsToDenseTensors(others); err != nil {
 			return nil, errors.Wrap(err, "Concat failed")
 		}
-		return e.denseConcat(tt, axis, denses)
+		for i, d := range denses {
+			denses[i] = unconjugated(d)
+		}
+		return e.denseConcat(unconjugated(tt), axis, denses)
 	default:
 		return nil, errors.Errorf("NYI")
 	}
@@ -358,6 +361,53 @@ func (e StdEng) denseConcat(a DenseTensor, axis int, Ts []DenseTensor) (DenseTen
 	return retVal, nil
 }
 
+// Split splits a tensor along the given axis into pieces of the given sizes. The pieces are views of t, unless materialize is true.
+func (e StdEng) Split(t Tensor, axis int, sizes []int, materialize bool) (retVal []Tensor, err error) {
+	switch tt := t.(type) {
+	case *Dense:
+		return e.denseSplit(tt, axis, sizes, materialize)
+	default:
+		return nil, errors.Errorf(typeNYI, "Split", t)
+	}
+}
+
+func (e StdEng) denseSplit(t *Dense, axis int, sizes []int, materialize bool) (retVal []Tensor, err error) {
+	var axes []int
+	if axes, err = resolveAxes([]int{axis}, t.Dims()); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Split")
+	}
+	axis = axes[0]
+
+	var total int
+	for _, s := range sizes {
+		if s < 1 {
+			return nil, errors.Errorf("Cannot split into a piece of size %d", s)
+		}
+		total += s
+	}
+	if total != t.Shape()[axis] {
+		return nil, errors.Errorf("Cannot split axis %d of %v into pieces of sizes %v", axis, t.Shape(), sizes)
+	}
+
+	// the pieces are built directly rather than with Slice(), which drops the axes of pieces of size 1
+	strides := t.Strides()
+	retVal = make([]Tensor, 0, len(sizes))
+	var start int
+	for _, s := range sizes {
+		shape := t.Shape().Clone()
+		shape[axis] = s
+		view := t.viewAt(start*strides[axis], shape, append([]int(nil), strides...), packedOrder(shape, strides, t.o))
+		start += s
+
+		if materialize {
+			retVal = append(retVal, view.Materialize())
+			continue
+		}
+		retVal = append(retVal, view)
+	}
+	return
+}
+
 // Diag ...
 func (e StdEng) Diag(t Tensor) (retVal Tensor, err error) {
 	a, ok := t.(DenseTensor)
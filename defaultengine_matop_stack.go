@@ -7,6 +7,11 @@ import (
 // This file contains code for the execution engine to stack tensors
 
 func (e StdEng) StackDense(t DenseTensor, axis int, others ...DenseTensor) (retVal DenseTensor, err error) {
+	t = unconjugated(t)
+	others = append([]DenseTensor(nil), others...)
+	for i, ot := range others {
+		others[i] = unconjugated(ot)
+	}
 	opdims := t.Dims()
 	if axis >= opdims+1 {
 		err = errors.Errorf(dimMismatch, opdims+1, axis)
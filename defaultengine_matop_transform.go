@@ -0,0 +1,142 @@
+package tensor
+
+import "github.com/pkg/errors"
+
+var (
+	_ Flipper = StdEng{}
+	_ Roller  = StdEng{}
+	_ Tiler   = StdEng{}
+)
+
+// Flip reverses the order of the values along the given axes, or along all the axes if none are given.
+// A *Dense is flipped into a view with negative strides. Other tensors are copied.
+func (e StdEng) Flip(t Tensor, axes ...int) (retVal Tensor, err error) {
+	if axes, err = e.transformAxes(axes, t.Dims(), "Flip"); err != nil {
+		return nil, err
+	}
+	if d, ok := t.(*Dense); ok {
+		return d.flipView(axes), nil
+	}
+
+	var x DenseTensor
+	if x, err = e.contiguous(t, "Flip"); err != nil {
+		return nil, err
+	}
+
+	g := newGatherGeom(x.Shape())
+	for _, a := range axes {
+		reverseInts(g.src[a])
+	}
+	ret := New(WithShape(g.shape...), Of(x.Dtype()), WithEngine(e))
+	gatherInto(ret, x, g)
+	return ret, nil
+}
+
+// Rot90 rotates the plane given by the two axes by 90 degrees, k times, in the direction from the first axis to the second.
+func (e StdEng) Rot90(t Tensor, k, axis1, axis2 int) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, "Rot90"); err != nil {
+		return nil, err
+	}
+	if _, err = e.transformAxes([]int{axis1, axis2}, x.Dims(), "Rot90"); err != nil {
+		return nil, err
+	}
+	// transformAxes sorts the axes, but the direction of the rotation depends on their order
+	axis1, axis2 = resolveAxis(axis1, x.Dims()), resolveAxis(axis2, x.Dims())
+
+	g := newGatherGeom(x.Shape())
+	switch k = (k%4 + 4) % 4; k {
+	case 1:
+		// result[..i..j..] = x[..j..n2-1-i..]
+		reverseInts(g.src[axis2])
+		g.swap(axis1, axis2)
+	case 2:
+		reverseInts(g.src[axis1])
+		reverseInts(g.src[axis2])
+	case 3:
+		// result[..i..j..] = x[..n1-1-j..i..]
+		reverseInts(g.src[axis1])
+		g.swap(axis1, axis2)
+	}
+	ret := New(WithShape(g.shape...), Of(x.Dtype()), WithEngine(e))
+	gatherInto(ret, x, g)
+	return ret, nil
+}
+
+// Roll shifts the values along the given axes by shift places, wrapping around at the end.
+// If no axes are given, the tensor is rolled as if it were flattened.
+func (e StdEng) Roll(t Tensor, shift int, axes ...int) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, "Roll"); err != nil {
+		return nil, err
+	}
+
+	var g gatherGeom
+	if len(axes) == 0 {
+		g = newGatherGeom(Shape{x.Shape().TotalSize()})
+		axes = []int{0}
+	} else {
+		if axes, err = e.transformAxes(axes, x.Dims(), "Roll"); err != nil {
+			return nil, err
+		}
+		g = newGatherGeom(x.Shape())
+	}
+	for _, a := range axes {
+		n := len(g.src[a])
+		for i := range g.src[a] {
+			g.src[a][i] = ((i-shift)%n + n) % n
+		}
+	}
+	ret := New(WithShape(x.Shape().Clone()...), Of(x.Dtype()), WithEngine(e))
+	gatherInto(ret, x, g)
+	return ret, nil
+}
+
+// Tile repeats the whole tensor reps[i] times along the i-th axis. Like Numpy's tile(), if there are fewer reps than axes,
+// the reps are padded with leading 1s, and if there are more, the tensor is treated as having leading axes of size 1.
+func (e StdEng) Tile(t Tensor, reps ...int) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, "Tile"); err != nil {
+		return nil, err
+	}
+
+	shape := x.Shape().Clone()
+	for len(shape) < len(reps) {
+		shape = append(Shape{1}, shape...)
+	}
+	for len(reps) < len(shape) {
+		reps = append([]int{1}, reps...)
+	}
+
+	g := newGatherGeom(shape)
+	for a, r := range reps {
+		if r < 1 {
+			return nil, errors.Errorf("Cannot tile axis %d %d times", a, r)
+		}
+		n := shape[a]
+		g.shape[a] = n * r
+		g.src[a] = make([]int, n*r)
+		for i := range g.src[a] {
+			g.src[a][i] = i % n
+		}
+	}
+	ret := New(WithShape(g.shape...), Of(x.Dtype()), WithEngine(e))
+	gatherInto(ret, x, g)
+	return ret, nil
+}
+
+// transformAxes checks and resolves the axes of Flip, Roll and Rot90. No axes stands for all of the axes.
+func (StdEng) transformAxes(axes []int, dims int, op string) ([]int, error) {
+	if len(axes) == 0 {
+		axes = make([]int, dims)
+		for i := range axes {
+			axes[i] = i
+		}
+		return axes, nil
+	}
+	retVal, err := resolveAxes(axes, dims)
+	if err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+	return retVal, nil
+}
@@ -22,6 +22,11 @@ func (e StdEng) MinBetween(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor,
 	if reuse, safe, _, _, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if !safe {
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.MinBetween")
+		}
+	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
 	var ait, bit, iit Iterator
@@ -84,6 +89,11 @@ func (e StdEng) MaxBetween(a Tensor, b Tensor, opts ...FuncOpt) (retVal Tensor,
 	if reuse, safe, _, _, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if !safe {
+		if err = checkWritable(a); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.MaxBetween")
+		}
+	}
 	typ := a.Dtype().Type
 	var dataA, dataB, dataReuse *storage.Header
 	var ait, bit, iit Iterator
@@ -150,6 +160,11 @@ func (e StdEng) MinBetweenScalar(t Tensor, s interface{}, leftTensor bool, opts
 	if reuse, safe, _, _, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if !safe {
+		if err = checkWritable(t); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.MinBetween")
+		}
+	}
 	a := t
 	typ := t.Dtype().Type
 	var ait, bit, iit Iterator
@@ -256,6 +271,11 @@ func (e StdEng) MaxBetweenScalar(t Tensor, s interface{}, leftTensor bool, opts
 	if reuse, safe, _, _, _, err = handleFuncOpts(t.Shape(), t.Dtype(), t.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if !safe {
+		if err = checkWritable(t); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.MaxBetween")
+		}
+	}
 	a := t
 	typ := t.Dtype().Type
 	var ait, bit, iit Iterator
@@ -0,0 +1,256 @@
+package tensor
+
+import (
+	"math"
+
+	"github.com/chewxy/math32"
+	"github.com/pkg/errors"
+)
+
+var _ NanReducer = StdEng{}
+
+type nanKind byte
+
+const (
+	nanSum nanKind = iota
+	nanMean
+	nanMax
+	nanMin
+)
+
+// NanSum sums the values that are not NaN along the given axes. See the package level function NanSum.
+func (e StdEng) NanSum(t Tensor, skipMasked bool, along ...int) (retVal Tensor, err error) {
+	return e.nanReduce(t, skipMasked, along, nanSum, "NanSum")
+}
+
+// NanMean averages the values that are not NaN along the given axes. See the package level function NanMean.
+func (e StdEng) NanMean(t Tensor, skipMasked bool, along ...int) (retVal Tensor, err error) {
+	return e.nanReduce(t, skipMasked, along, nanMean, "NanMean")
+}
+
+// NanMax finds the largest values that are not NaN along the given axes. See the package level function NanMax.
+func (e StdEng) NanMax(t Tensor, skipMasked bool, along ...int) (retVal Tensor, err error) {
+	return e.nanReduce(t, skipMasked, along, nanMax, "NanMax")
+}
+
+// NanMin finds the smallest values that are not NaN along the given axes. See the package level function NanMin.
+func (e StdEng) NanMin(t Tensor, skipMasked bool, along ...int) (retVal Tensor, err error) {
+	return e.nanReduce(t, skipMasked, along, nanMin, "NanMin")
+}
+
+// NanArgmax finds the indices of the largest values that are not NaN along the axis. See the package level function NanArgmax.
+func (e StdEng) NanArgmax(t Tensor, axis int, skipMasked bool) (retVal Tensor, err error) {
+	return e.nanArg(t, axis, skipMasked, true, "NanArgmax")
+}
+
+// NanArgmin finds the indices of the smallest values that are not NaN along the axis. See the package level function NanArgmin.
+func (e StdEng) NanArgmin(t Tensor, axis int, skipMasked bool) (retVal Tensor, err error) {
+	return e.nanArg(t, axis, skipMasked, false, "NanArgmin")
+}
+
+// nanPrep checks t, and returns the geometry of a reduction along the given axes (all of them if there are none),
+// as well as the mask of the elements to skip besides the NaNs, if any.
+func (e StdEng) nanPrep(t Tensor, along []int, skipMasked bool, op string) (x DenseTensor, mask []bool, g normGeom, err error) {
+	if x, err = e.contiguousFloat(t, op); err != nil {
+		return nil, nil, g, err
+	}
+	dims := x.Dims()
+	if len(along) == 0 {
+		along = make([]int, dims)
+		for i := range along {
+			along[i] = i
+		}
+	}
+	var axes []int
+	if axes, err = resolveAxes(along, dims); err != nil {
+		return nil, nil, g, errors.Wrapf(err, opFail, op)
+	}
+	g = newNormGeom(x.Shape(), axes, false)
+	if mt, ok := x.(MaskedTensor); ok && skipMasked && mt.IsMasked() {
+		mask = mt.Mask()
+	}
+	return x, mask, g, nil
+}
+
+func (e StdEng) nanReduce(t Tensor, skipMasked bool, along []int, kind nanKind, op string) (retVal Tensor, err error) {
+	var x DenseTensor
+	var mask []bool
+	var g normGeom
+	if x, mask, g, err = e.nanPrep(t, along, skipMasked, op); err != nil {
+		return nil, err
+	}
+
+	switch x.Dtype() {
+	case Float64:
+		out := make([]float64, len(g.base))
+		nanReduceF64(x.Float64s(), mask, &g, kind, out)
+		if len(g.keptShape) == 0 {
+			return New(FromScalar(out[0]), WithEngine(e)), nil
+		}
+		return New(WithShape(g.keptShape...), WithBacking(out), WithEngine(e)), nil
+	case Float32:
+		out := make([]float32, len(g.base))
+		nanReduceF32(x.Float32s(), mask, &g, kind, out)
+		if len(g.keptShape) == 0 {
+			return New(FromScalar(out[0]), WithEngine(e)), nil
+		}
+		return New(WithShape(g.keptShape...), WithBacking(out), WithEngine(e)), nil
+	}
+	return nil, errors.Errorf(unsupportedDtype, x.Dtype(), op)
+}
+
+func (e StdEng) nanArg(t Tensor, axis int, skipMasked, max bool, op string) (retVal Tensor, err error) {
+	var along []int
+	if axis != AllAxes {
+		if axis < 0 || axis >= t.Dims() {
+			return nil, errors.Errorf(invalidAxis, axis, t.Dims())
+		}
+		along = []int{axis}
+	}
+	var x DenseTensor
+	var mask []bool
+	var g normGeom
+	if x, mask, g, err = e.nanPrep(t, along, skipMasked, op); err != nil {
+		return nil, err
+	}
+
+	out := make([]int, len(g.base))
+	var ok bool
+	switch x.Dtype() {
+	case Float64:
+		ok = nanArgF64(x.Float64s(), mask, &g, max, out)
+	case Float32:
+		ok = nanArgF32(x.Float32s(), mask, &g, max, out)
+	default:
+		return nil, errors.Errorf(unsupportedDtype, x.Dtype(), op)
+	}
+	if !ok {
+		return nil, errors.Errorf("Unable to perform %s. A slice has no values that are not NaN or skipped", op)
+	}
+	if len(g.keptShape) == 0 {
+		return New(FromScalar(out[0]), WithEngine(e)), nil
+	}
+	return New(WithShape(g.keptShape...), WithBacking(out), WithEngine(e)), nil
+}
+
+/* KERNELS */
+
+// nanReduceF64 reduces each group of g into out, skipping NaNs and the masked values. Groups with no values left
+// sum to 0 and have a NaN mean, max or min.
+func nanReduceF64(x []float64, mask []bool, g *normGeom, kind nanKind, out []float64) {
+	for grp, base := range g.base {
+		var acc float64
+		var n int
+		for _, r := range g.rel {
+			i := base + r
+			v := x[i]
+			if math.IsNaN(v) || (mask != nil && mask[i]) {
+				continue
+			}
+			switch {
+			case n == 0 && kind >= nanMax:
+				acc = v
+			case kind == nanMax:
+				if v > acc {
+					acc = v
+				}
+			case kind == nanMin:
+				if v < acc {
+					acc = v
+				}
+			default:
+				acc += v
+			}
+			n++
+		}
+		switch {
+		case n == 0 && kind != nanSum:
+			acc = math.NaN()
+		case kind == nanMean:
+			acc /= float64(n)
+		}
+		out[grp] = acc
+	}
+}
+
+func nanReduceF32(x []float32, mask []bool, g *normGeom, kind nanKind, out []float32) {
+	for grp, base := range g.base {
+		var acc float32
+		var n int
+		for _, r := range g.rel {
+			i := base + r
+			v := x[i]
+			if math32.IsNaN(v) || (mask != nil && mask[i]) {
+				continue
+			}
+			switch {
+			case n == 0 && kind >= nanMax:
+				acc = v
+			case kind == nanMax:
+				if v > acc {
+					acc = v
+				}
+			case kind == nanMin:
+				if v < acc {
+					acc = v
+				}
+			default:
+				acc += v
+			}
+			n++
+		}
+		switch {
+		case n == 0 && kind != nanSum:
+			acc = math32.NaN()
+		case kind == nanMean:
+			acc /= float32(n)
+		}
+		out[grp] = acc
+	}
+}
+
+// nanArgF64 writes the position within each group of g of its largest (or smallest) value, skipping NaNs and the masked values.
+// The first of several equal values wins. It returns false if a group has no values left.
+func nanArgF64(x []float64, mask []bool, g *normGeom, max bool, out []int) bool {
+	for grp, base := range g.base {
+		best := -1
+		var bestV float64
+		for k, r := range g.rel {
+			i := base + r
+			v := x[i]
+			if math.IsNaN(v) || (mask != nil && mask[i]) {
+				continue
+			}
+			if best < 0 || (max && v > bestV) || (!max && v < bestV) {
+				best, bestV = k, v
+			}
+		}
+		if best < 0 {
+			return false
+		}
+		out[grp] = best
+	}
+	return true
+}
+
+func nanArgF32(x []float32, mask []bool, g *normGeom, max bool, out []int) bool {
+	for grp, base := range g.base {
+		best := -1
+		var bestV float32
+		for k, r := range g.rel {
+			i := base + r
+			v := x[i]
+			if math32.IsNaN(v) || (mask != nil && mask[i]) {
+				continue
+			}
+			if best < 0 || (max && v > bestV) || (!max && v < bestV) {
+				best, bestV = k, v
+			}
+		}
+		if best < 0 {
+			return false
+		}
+		out[grp] = best
+	}
+	return true
+}
@@ -0,0 +1,576 @@
+package tensor
+
+import (
+	"math"
+	"sort"
+
+	"github.com/chewxy/math32"
+	"github.com/pkg/errors"
+)
+
+var _ Normalizer = StdEng{}
+
+// normGeom splits the elements of a tensor into groups that are normalized independently.
+// The k-th element of the grp-th group is at base[grp] + rel[k].
+type normGeom struct {
+	base, rel []int
+
+	keptShape Shape // the shape of the statistics
+	normShape Shape // the shape of a group
+
+	// perGroup indicates that the scale and bias are indexed by group (as in BatchNorm), rather than by the position within the group (as in LayerNorm).
+	perGroup bool
+}
+
+func (g *normGeom) param(grp, k int) int {
+	if g.perGroup {
+		return grp
+	}
+	return k
+}
+
+func (g *normGeom) paramShape() Shape {
+	if g.perGroup {
+		return g.keptShape
+	}
+	return g.normShape
+}
+
+// resolveAxes checks the axes and returns them resolved and sorted.
+func resolveAxes(axes []int, dims int) ([]int, error) {
+	retVal := make([]int, len(axes))
+	for i, a := range axes {
+		if a >= dims || a < -dims {
+			return nil, errors.Errorf(invalidAxis, a, dims)
+		}
+		retVal[i] = resolveAxis(a, dims)
+	}
+	sort.Ints(retVal)
+	for i := 1; i < len(retVal); i++ {
+		if retVal[i] == retVal[i-1] {
+			return nil, errors.Errorf(repeatedAxis, retVal[i])
+		}
+	}
+	return retVal, nil
+}
+
+// otherAxes returns the axes of a tensor of the given dimensions that are not in the sorted list of axes.
+func otherAxes(axes []int, dims int) []int {
+	retVal := make([]int, 0, dims-len(axes))
+	for a := 0; a < dims; a++ {
+		if i := sort.SearchInts(axes, a); i == len(axes) || axes[i] != a {
+			retVal = append(retVal, a)
+		}
+	}
+	return retVal
+}
+
+// axisOffsets enumerates, in row major order, the offsets of all the positions spanned by the given axes.
+func axisOffsets(s Shape, strides []int, axes []int) []int {
+	n := 1
+	for _, a := range axes {
+		n *= s[a]
+	}
+	retVal := make([]int, 0, n)
+	idx := make([]int, len(axes))
+	for i := 0; i < n; i++ {
+		var off int
+		for j, a := range axes {
+			off += idx[j] * strides[a]
+		}
+		retVal = append(retVal, off)
+		for j := len(axes) - 1; j >= 0; j-- {
+			if idx[j]++; idx[j] < s[axes[j]] {
+				break
+			}
+			idx[j] = 0
+		}
+	}
+	return retVal
+}
+
+func newNormGeom(s Shape, axes []int, perGroup bool) normGeom {
+	kept := otherAxes(axes, s.Dims())
+	strides := s.CalcStrides()
+	g := normGeom{
+		base:     axisOffsets(s, strides, kept),
+		rel:      axisOffsets(s, strides, axes),
+		perGroup: perGroup,
+	}
+	for _, a := range kept {
+		g.keptShape = append(g.keptShape, s[a])
+	}
+	for _, a := range axes {
+		g.normShape = append(g.normShape, s[a])
+	}
+	return g
+}
+
+// layerNormPrep checks x and returns the geometry of a normalization over the given axes (or the last axis).
+func (e StdEng) layerNormPrep(x Tensor, axes []int, op string) (xd DenseTensor, g normGeom, err error) {
+	if xd, err = e.contiguousFloat(x, op); err != nil {
+		return
+	}
+	if xd.Dims() == 0 {
+		err = errors.Errorf(atleastDims, 1)
+		return
+	}
+	if len(axes) == 0 {
+		axes = []int{-1}
+	}
+	if axes, err = resolveAxes(axes, xd.Dims()); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	g = newNormGeom(xd.Shape(), axes, false)
+	return
+}
+
+func (e StdEng) batchNormPrep(x Tensor, axis int, op string) (xd DenseTensor, g normGeom, err error) {
+	if xd, err = e.contiguousFloat(x, op); err != nil {
+		return
+	}
+	if xd.Dims() < 2 {
+		err = errors.Errorf(atleastDims, 2)
+		return
+	}
+	var axes []int
+	if axes, err = resolveAxes([]int{axis}, xd.Dims()); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	g = newNormGeom(xd.Shape(), otherAxes(axes, xd.Dims()), true)
+	return
+}
+
+// normParam checks an optional parameter of a normalization. A nil parameter is returned as nil.
+func (e StdEng) normParam(t Tensor, s Shape, dt Dtype, op string) (DenseTensor, error) {
+	if t == nil {
+		return nil, nil
+	}
+	d, err := e.contiguousFloat(t, op)
+	if err != nil {
+		return nil, err
+	}
+	if d.Dtype() != dt {
+		return nil, errors.Errorf(dtypeMismatch, dt, d.Dtype())
+	}
+	if !d.Shape().Eq(s) {
+		return nil, errors.Errorf(shapeMismatch, s, d.Shape())
+	}
+	return d, nil
+}
+
+// normParams checks a list of parameters that are all required to have the same shape.
+func (e StdEng) normParams(s Shape, dt Dtype, op string, ts ...Tensor) (retVal []DenseTensor, err error) {
+	retVal = make([]DenseTensor, len(ts))
+	for i, t := range ts {
+		if retVal[i], err = e.normParam(t, s, dt, op); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// norm runs the forward kernel. mean is nil for RMSNorm.
+func (e StdEng) norm(g *normGeom, xd, scale, bias, mean, invStd, variance DenseTensor, eps float64, stats bool) (retVal Tensor) {
+	retVal = New(WithShape(xd.Shape().Clone()...), Of(xd.Dtype()), WithEngine(e))
+	switch xd.Dtype() {
+	case Float64:
+		normF64(g, getFloat64s(xd), getFloat64s(retVal), optFloat64s(scale), optFloat64s(bias), optFloat64s(mean), getFloat64s(invStd), optFloat64s(variance), eps, stats)
+	case Float32:
+		normF32(g, getFloat32s(xd), getFloat32s(retVal), optFloat32s(scale), optFloat32s(bias), optFloat32s(mean), getFloat32s(invStd), optFloat32s(variance), float32(eps), stats)
+	}
+	return
+}
+
+// normB runs the backwards kernel. mean is nil for RMSNorm.
+func (e StdEng) normB(g *normGeom, xd, scale, mean, invStd, grad DenseTensor, throughStats bool) (dx, dscale, dbias Tensor) {
+	dt := xd.Dtype()
+	dx = New(WithShape(xd.Shape().Clone()...), Of(dt), WithEngine(e))
+	dscale = New(WithShape(g.paramShape().Clone()...), Of(dt), WithEngine(e))
+	dbias = New(WithShape(g.paramShape().Clone()...), Of(dt), WithEngine(e))
+	switch dt {
+	case Float64:
+		normBF64(g, getFloat64s(xd), optFloat64s(scale), optFloat64s(mean), getFloat64s(invStd), getFloat64s(grad), getFloat64s(dx), getFloat64s(dscale), getFloat64s(dbias), throughStats)
+	case Float32:
+		normBF32(g, getFloat32s(xd), optFloat32s(scale), optFloat32s(mean), getFloat32s(invStd), getFloat32s(grad), getFloat32s(dx), getFloat32s(dscale), getFloat32s(dbias), throughStats)
+	}
+	return
+}
+
+// LayerNorm normalizes each group spanned by the given axes without allocating any intermediate tensors.
+func (e StdEng) LayerNorm(x, scale, bias Tensor, eps float64, axes ...int) (retVal, mean, invStd Tensor, err error) {
+	var xd DenseTensor
+	var g normGeom
+	if xd, g, err = e.layerNormPrep(x, axes, "LayerNorm"); err != nil {
+		return nil, nil, nil, err
+	}
+	var params []DenseTensor
+	if params, err = e.normParams(g.paramShape(), xd.Dtype(), "LayerNorm", scale, bias); err != nil {
+		return nil, nil, nil, err
+	}
+	m := New(WithShape(g.keptShape...), Of(xd.Dtype()), WithEngine(e))
+	inv := New(WithShape(g.keptShape...), Of(xd.Dtype()), WithEngine(e))
+	return e.norm(&g, xd, params[0], params[1], m, inv, nil, eps, true), m, inv, nil
+}
+
+// LayerNormB computes the gradients of LayerNorm, including the gradient that flows through the mean and variance of each group.
+func (e StdEng) LayerNormB(x, scale, mean, invStd, grad Tensor, axes ...int) (dx, dscale, dbias Tensor, err error) {
+	var xd DenseTensor
+	var g normGeom
+	if xd, g, err = e.layerNormPrep(x, axes, "LayerNormB"); err != nil {
+		return nil, nil, nil, err
+	}
+	if mean == nil || invStd == nil || grad == nil {
+		return nil, nil, nil, errors.Errorf(opFail, "LayerNormB: the statistics and the gradient are required")
+	}
+	var params []DenseTensor
+	if params, err = e.normParams(g.keptShape, xd.Dtype(), "LayerNormB", mean, invStd); err != nil {
+		return nil, nil, nil, err
+	}
+	var sd, gd DenseTensor
+	if sd, err = e.normParam(scale, g.paramShape(), xd.Dtype(), "LayerNormB"); err != nil {
+		return nil, nil, nil, err
+	}
+	if gd, err = e.normParam(grad, xd.Shape(), xd.Dtype(), "LayerNormB"); err != nil {
+		return nil, nil, nil, err
+	}
+	dx, dscale, dbias = e.normB(&g, xd, sd, params[0], params[1], gd, true)
+	return
+}
+
+// RMSNorm is LayerNorm without the centering and without the bias.
+func (e StdEng) RMSNorm(x, scale Tensor, eps float64, axes ...int) (retVal, invRMS Tensor, err error) {
+	var xd DenseTensor
+	var g normGeom
+	if xd, g, err = e.layerNormPrep(x, axes, "RMSNorm"); err != nil {
+		return nil, nil, err
+	}
+	var sd DenseTensor
+	if sd, err = e.normParam(scale, g.paramShape(), xd.Dtype(), "RMSNorm"); err != nil {
+		return nil, nil, err
+	}
+	inv := New(WithShape(g.keptShape...), Of(xd.Dtype()), WithEngine(e))
+	return e.norm(&g, xd, sd, nil, nil, inv, nil, eps, true), inv, nil
+}
+
+// RMSNormB computes the gradients of RMSNorm.
+func (e StdEng) RMSNormB(x, scale, invRMS, grad Tensor, axes ...int) (dx, dscale Tensor, err error) {
+	var xd DenseTensor
+	var g normGeom
+	if xd, g, err = e.layerNormPrep(x, axes, "RMSNormB"); err != nil {
+		return nil, nil, err
+	}
+	if invRMS == nil || grad == nil {
+		return nil, nil, errors.Errorf(opFail, "RMSNormB: the statistics and the gradient are required")
+	}
+	var sd, id, gd DenseTensor
+	if sd, err = e.normParam(scale, g.paramShape(), xd.Dtype(), "RMSNormB"); err != nil {
+		return nil, nil, err
+	}
+	if id, err = e.normParam(invRMS, g.keptShape, xd.Dtype(), "RMSNormB"); err != nil {
+		return nil, nil, err
+	}
+	if gd, err = e.normParam(grad, xd.Shape(), xd.Dtype(), "RMSNormB"); err != nil {
+		return nil, nil, err
+	}
+	dx, dscale, _ = e.normB(&g, xd, sd, nil, id, gd, true)
+	return
+}
+
+// BatchNorm normalizes each channel of x. It is the same kernel as LayerNorm, with the scale and bias indexed by channel.
+func (e StdEng) BatchNorm(x, scale, bias, runningMean, runningVar Tensor, axis int, momentum, eps float64, training bool) (retVal, mean, invStd Tensor, err error) {
+	var xd DenseTensor
+	var g normGeom
+	if xd, g, err = e.batchNormPrep(x, axis, "BatchNorm"); err != nil {
+		return nil, nil, nil, err
+	}
+	if !training && (runningMean == nil || runningVar == nil) {
+		return nil, nil, nil, errors.Errorf(opFail, "BatchNorm: the running statistics are required in inference mode")
+	}
+	// the running statistics are updated in place, so they cannot be copies
+	for _, t := range []Tensor{runningMean, runningVar} {
+		if v, ok := t.(View); ok && v.IsMaterializable() {
+			return nil, nil, nil, errors.Errorf(opFail, "BatchNorm: the running statistics cannot be non-contiguous views")
+		}
+	}
+	var params []DenseTensor
+	if params, err = e.normParams(g.keptShape, xd.Dtype(), "BatchNorm", scale, bias, runningMean, runningVar); err != nil {
+		return nil, nil, nil, err
+	}
+	sd, bd, rm, rv := params[0], params[1], params[2], params[3]
+
+	m := New(WithShape(g.keptShape...), Of(xd.Dtype()), WithEngine(e))
+	inv := New(WithShape(g.keptShape...), Of(xd.Dtype()), WithEngine(e))
+	if !training {
+		switch xd.Dtype() {
+		case Float64:
+			copy(getFloat64s(m), getFloat64s(rm))
+			for i, v := range getFloat64s(rv) {
+				getFloat64s(inv)[i] = 1 / math.Sqrt(v+eps)
+			}
+		case Float32:
+			copy(getFloat32s(m), getFloat32s(rm))
+			for i, v := range getFloat32s(rv) {
+				getFloat32s(inv)[i] = 1 / math32.Sqrt(v+float32(eps))
+			}
+		}
+		return e.norm(&g, xd, sd, bd, m, inv, nil, eps, false), m, inv, nil
+	}
+
+	variance := New(WithShape(g.keptShape...), Of(xd.Dtype()), WithEngine(e))
+	retVal = e.norm(&g, xd, sd, bd, m, inv, variance, eps, true)
+
+	// the running variance is unbiased
+	n := float64(len(g.rel))
+	correction := 1.0
+	if n > 1 {
+		correction = n / (n - 1)
+	}
+	switch xd.Dtype() {
+	case Float64:
+		ms, vs := getFloat64s(m), getFloat64s(variance)
+		if rm != nil {
+			for i, v := range getFloat64s(rm) {
+				getFloat64s(rm)[i] = (1-momentum)*v + momentum*ms[i]
+			}
+		}
+		if rv != nil {
+			for i, v := range getFloat64s(rv) {
+				getFloat64s(rv)[i] = (1-momentum)*v + momentum*vs[i]*correction
+			}
+		}
+	case Float32:
+		ms, vs := getFloat32s(m), getFloat32s(variance)
+		mom := float32(momentum)
+		if rm != nil {
+			for i, v := range getFloat32s(rm) {
+				getFloat32s(rm)[i] = (1-mom)*v + mom*ms[i]
+			}
+		}
+		if rv != nil {
+			for i, v := range getFloat32s(rv) {
+				getFloat32s(rv)[i] = (1-mom)*v + mom*vs[i]*float32(correction)
+			}
+		}
+	}
+	return retVal, m, inv, nil
+}
+
+// BatchNormB computes the gradients of BatchNorm.
+func (e StdEng) BatchNormB(x, scale, mean, invStd, grad Tensor, axis int, training bool) (dx, dscale, dbias Tensor, err error) {
+	var xd DenseTensor
+	var g normGeom
+	if xd, g, err = e.batchNormPrep(x, axis, "BatchNormB"); err != nil {
+		return nil, nil, nil, err
+	}
+	if mean == nil || invStd == nil || grad == nil {
+		return nil, nil, nil, errors.Errorf(opFail, "BatchNormB: the statistics and the gradient are required")
+	}
+	var params []DenseTensor
+	if params, err = e.normParams(g.keptShape, xd.Dtype(), "BatchNormB", scale, mean, invStd); err != nil {
+		return nil, nil, nil, err
+	}
+	var gd DenseTensor
+	if gd, err = e.normParam(grad, xd.Shape(), xd.Dtype(), "BatchNormB"); err != nil {
+		return nil, nil, nil, err
+	}
+	dx, dscale, dbias = e.normB(&g, xd, params[0], params[1], params[2], gd, training)
+	return
+}
+
+func optFloat64s(t DenseTensor) []float64 {
+	if t == nil {
+		return nil
+	}
+	return getFloat64s(t)
+}
+
+func optFloat32s(t DenseTensor) []float32 {
+	if t == nil {
+		return nil
+	}
+	return getFloat32s(t)
+}
+
+/* float64 kernels */
+
+// normF64 normalizes each group of x into y. If stats is true, the mean (unless it is nil, as in RMSNorm) and the inverse
+// standard deviation of each group are computed, along with the biased variance if variance is not nil, otherwise the given
+// ones are used. scale and bias may be nil.
+func normF64(g *normGeom, x, y, scale, bias, mean, invStd, variance []float64, eps float64, stats bool) {
+	n := float64(len(g.rel))
+	for grp, b := range g.base {
+		if stats {
+			var m float64
+			if mean != nil {
+				for _, r := range g.rel {
+					m += x[b+r]
+				}
+				m /= n
+				mean[grp] = m
+			}
+			var v float64
+			for _, r := range g.rel {
+				d := x[b+r] - m
+				v += d * d
+			}
+			invStd[grp] = 1 / math.Sqrt(v/n+eps)
+			if variance != nil {
+				variance[grp] = v / n
+			}
+		}
+
+		var m float64
+		if mean != nil {
+			m = mean[grp]
+		}
+		inv := invStd[grp]
+		for k, r := range g.rel {
+			v := (x[b+r] - m) * inv
+			p := g.param(grp, k)
+			if scale != nil {
+				v *= scale[p]
+			}
+			if bias != nil {
+				v += bias[p]
+			}
+			y[b+r] = v
+		}
+	}
+}
+
+// normBF64 computes the gradients of normF64. If throughStats is true, the gradient also flows through the statistics of each group.
+func normBF64(g *normGeom, x, scale, mean, invStd, grad, dx, dscale, dbias []float64, throughStats bool) {
+	n := float64(len(g.rel))
+	for grp, b := range g.base {
+		var m float64
+		if mean != nil {
+			m = mean[grp]
+		}
+		inv := invStd[grp]
+
+		// Σ dxhat and Σ dxhat·xhat, where dxhat is the gradient of the normalized input
+		var sumD, sumDX float64
+		for k, r := range g.rel {
+			i := b + r
+			xhat := (x[i] - m) * inv
+			p := g.param(grp, k)
+			d := grad[i]
+			dscale[p] += d * xhat
+			dbias[p] += d
+			if scale != nil {
+				d *= scale[p]
+			}
+			sumD += d
+			sumDX += d * xhat
+		}
+
+		for k, r := range g.rel {
+			i := b + r
+			d := grad[i]
+			if scale != nil {
+				d *= scale[g.param(grp, k)]
+			}
+			if !throughStats {
+				dx[i] = d * inv
+				continue
+			}
+			xhat := (x[i] - m) * inv
+			d -= xhat * sumDX / n
+			if mean != nil {
+				d -= sumD / n
+			}
+			dx[i] = d * inv
+		}
+	}
+}
+
+/* float32 kernels */
+
+func normF32(g *normGeom, x, y, scale, bias, mean, invStd, variance []float32, eps float32, stats bool) {
+	n := float32(len(g.rel))
+	for grp, b := range g.base {
+		if stats {
+			var m float32
+			if mean != nil {
+				for _, r := range g.rel {
+					m += x[b+r]
+				}
+				m /= n
+				mean[grp] = m
+			}
+			var v float32
+			for _, r := range g.rel {
+				d := x[b+r] - m
+				v += d * d
+			}
+			invStd[grp] = 1 / math32.Sqrt(v/n+eps)
+			if variance != nil {
+				variance[grp] = v / n
+			}
+		}
+
+		var m float32
+		if mean != nil {
+			m = mean[grp]
+		}
+		inv := invStd[grp]
+		for k, r := range g.rel {
+			v := (x[b+r] - m) * inv
+			p := g.param(grp, k)
+			if scale != nil {
+				v *= scale[p]
+			}
+			if bias != nil {
+				v += bias[p]
+			}
+			y[b+r] = v
+		}
+	}
+}
+
+func normBF32(g *normGeom, x, scale, mean, invStd, grad, dx, dscale, dbias []float32, throughStats bool) {
+	n := float32(len(g.rel))
+	for grp, b := range g.base {
+		var m float32
+		if mean != nil {
+			m = mean[grp]
+		}
+		inv := invStd[grp]
+
+		var sumD, sumDX float32
+		for k, r := range g.rel {
+			i := b + r
+			xhat := (x[i] - m) * inv
+			p := g.param(grp, k)
+			d := grad[i]
+			dscale[p] += d * xhat
+			dbias[p] += d
+			if scale != nil {
+				d *= scale[p]
+			}
+			sumD += d
+			sumDX += d * xhat
+		}
+
+		for k, r := range g.rel {
+			i := b + r
+			d := grad[i]
+			if scale != nil {
+				d *= scale[g.param(grp, k)]
+			}
+			if !throughStats {
+				dx[i] = d * inv
+				continue
+			}
+			xhat := (x[i] - m) * inv
+			d -= xhat * sumDX / n
+			if mean != nil {
+				d -= sumD / n
+			}
+			dx[i] = d * inv
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package tensor
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+var _ Padder = StdEng{}
+
+// newPadGeom maps each position of a padded tensor to the position in the unpadded tensor that its value comes from.
+// -1 marks constant padding.
+func newPadGeom(inner Shape, widths [][2]int, mode PadMode) (g gatherGeom, err error) {
+	if mode > PadCircular {
+		return g, errors.Errorf("Unknown PadMode %d", mode)
+	}
+	if len(widths) != inner.Dims() {
+		return g, errors.Errorf(dimMismatch, inner.Dims(), len(widths))
+	}
+
+	g = newGatherGeom(inner)
+	for a, n := range inner {
+		before, after := widths[a][0], widths[a][1]
+		if before < 0 || after < 0 {
+			return g, errors.Errorf("Cannot pad axis %d by negative widths %v", a, widths[a])
+		}
+		if n == 0 && mode != PadConstant && before+after > 0 {
+			return g, errors.Errorf("Cannot pad the empty axis %d in %v mode. Only constant padding can extend an empty axis", a, mode)
+		}
+		g.shape[a] = n + before + after
+		g.src[a] = make([]int, g.shape[a])
+		for i := range g.src[a] {
+			g.src[a][i] = padSource(i-before, n, mode)
+		}
+	}
+	return g, nil
+}
+
+// padSource returns the index into an axis of size n that the value at index i, which may lie outside of the axis, is copied from.
+func padSource(i, n int, mode PadMode) int {
+	if i >= 0 && i < n {
+		return i
+	}
+	switch mode {
+	case PadEdge:
+		if i < 0 {
+			return 0
+		}
+		return n - 1
+	case PadCircular:
+		return (i%n + n) % n
+	case PadReflect:
+		if n == 1 {
+			return 0
+		}
+		p := 2 * (n - 1)
+		if i = (i%p + p) % p; i >= n {
+			i = p - i
+		}
+		return i
+	case PadSymmetric:
+		p := 2 * n
+		if i = (i%p + p) % p; i >= n {
+			i = p - 1 - i
+		}
+		return i
+	}
+	return -1
+}
+
+// Pad pads a tensor of any Dtype. See the package level function Pad for the meaning of the arguments.
+func (e StdEng) Pad(t Tensor, widths [][2]int, mode PadMode, value interface{}) (retVal Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.contiguous(t, "Pad"); err != nil {
+		return nil, err
+	}
+	var g gatherGeom
+	if g, err = newPadGeom(x.Shape(), widths, mode); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Pad")
+	}
+
+	ret := New(WithShape(g.shape...), Of(x.Dtype()), WithEngine(e))
+	if mode == PadConstant && value != nil {
+		if vt := reflect.TypeOf(value); vt != x.Dtype().Type {
+			return nil, errors.Errorf(dtypeMismatch, x.Dtype(), vt)
+		}
+		if err = ret.Memset(value); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Pad")
+		}
+	}
+
+	gatherInto(ret, x, g)
+	return ret, nil
+}
+
+// PadB computes the gradient of Pad. Only PadConstant, which merely crops, supports all Dtypes; the other modes require floats.
+func (e StdEng) PadB(grad Tensor, widths [][2]int, mode PadMode) (retVal Tensor, err error) {
+	var gd DenseTensor
+	if gd, err = e.contiguous(grad, "PadB"); err != nil {
+		return nil, err
+	}
+	if len(widths) != gd.Dims() {
+		return nil, errors.Errorf(dimMismatch, gd.Dims(), len(widths))
+	}
+	inner := gd.Shape().Clone()
+	for a := range inner {
+		if inner[a] -= widths[a][0] + widths[a][1]; inner[a] < 1 {
+			return nil, errors.Errorf("Cannot remove padding of widths %v from axis %d of %v", widths[a], a, gd.Shape())
+		}
+	}
+	var g gatherGeom
+	if g, err = newPadGeom(inner, widths, mode); err != nil {
+		return nil, errors.Wrapf(err, opFail, "PadB")
+	}
+
+	ret := New(WithShape(inner...), Of(gd.Dtype()), WithEngine(e))
+	if mode == PadConstant {
+		size := int(gd.Dtype().Size())
+		src, dst := gd.arr().Header.Raw, ret.array.Header.Raw
+		g.walk(func(o, s int) {
+			if s >= 0 {
+				copy(dst[s*size:(s+1)*size], src[o*size:(o+1)*size])
+			}
+		})
+		return ret, nil
+	}
+
+	switch gd.Dtype() {
+	case Float64:
+		dx, dy := ret.Float64s(), getFloat64s(gd)
+		g.walk(func(o, s int) { dx[s] += dy[o] })
+	case Float32:
+		dx, dy := ret.Float32s(), getFloat32s(gd)
+		g.walk(func(o, s int) { dx[s] += dy[o] })
+	default:
+		return nil, errors.Errorf(unsupportedDtype, gd.Dtype(), "PadB")
+	}
+	return ret, nil
+}
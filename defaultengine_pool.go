@@ -0,0 +1,675 @@
+package tensor
+
+import (
+	"math"
+
+	"github.com/chewxy/math32"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ Pooler       = StdEng{}
+	_ GlobalPooler = StdEng{}
+)
+
+// Pooling reuses the gather table of im2col: for every channel and every output position, the window is a
+// strided run of KernelH×KernelW entries of the table.
+
+// window returns the offset into the gather table of the first element of the window of the given channel
+// at output position l, as well as the distance between successive elements of the window.
+func (g *convGeom) window(ch, l int) (start, step int) {
+	if g.w.Layout == NHWC {
+		return l*g.k + ch, g.c
+	}
+	return ch*g.w.KernelH*g.w.KernelW*g.l + l, g.l
+}
+
+// outIndex is the offset into a single output image of the given channel at output position l.
+func (g *convGeom) outIndex(ch, l int) int {
+	if g.w.Layout == NHWC {
+		return l*g.c + ch
+	}
+	return ch*g.l + l
+}
+
+// planeIndex converts an offset into a single input image to the position y×W + x within its image plane.
+func (g *convGeom) planeIndex(ch, j int) int {
+	if j < 0 {
+		return -1
+	}
+	if g.w.Layout == NHWC {
+		return j / g.c
+	}
+	return j - ch*g.h*g.wd
+}
+
+// planeOffset is the inverse of planeIndex.
+func (g *convGeom) planeOffset(ch, p int) int {
+	if g.w.Layout == NHWC {
+		return p*g.c + ch
+	}
+	return ch*g.h*g.wd + p
+}
+
+func (e StdEng) poolPrep(x Tensor, w Window2D, op string) (xd DenseTensor, g convGeom, err error) {
+	if xd, err = e.contiguousFloat(x, op); err != nil {
+		return
+	}
+	if g, err = newConvGeom(xd.Shape(), w); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	g.oc = g.c
+	return
+}
+
+// poolBPrep checks the gradient (and the argmax, if any) of a pooling operation against the geometry of the input.
+// Only the shape and type of x is used.
+func (e StdEng) poolBPrep(x, argmax, grad Tensor, w Window2D, op string) (am, gd DenseTensor, g convGeom, err error) {
+	if err = typeclassCheck(x.Dtype(), floatTypes); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	if g, err = newConvGeom(x.Shape(), w); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	g.oc = g.c
+	if gd, err = e.contiguousFloat(grad, op); err != nil {
+		return
+	}
+	if gd.Dtype() != x.Dtype() {
+		err = errors.Errorf(dtypeMismatch, x.Dtype(), gd.Dtype())
+		return
+	}
+	if !gd.Shape().Eq(g.outShape()) {
+		err = errors.Errorf(shapeMismatch, g.outShape(), gd.Shape())
+		return
+	}
+	if argmax == nil {
+		return
+	}
+	if am, err = e.contiguousInts(argmax, op); err != nil {
+		return
+	}
+	if !am.Shape().Eq(g.outShape()) {
+		err = errors.Errorf(shapeMismatch, g.outShape(), am.Shape())
+		return
+	}
+	err = checkArgmax(getInts(am), g.h*g.wd, op)
+	return
+}
+
+// checkArgmax checks that the positions of the maxima lie within a plane of the given size. -1 marks the maximum of a window
+// that lies entirely in the padding.
+func checkArgmax(argmax []int, size int, op string) error {
+	for i, p := range argmax {
+		if p < -1 || p >= size {
+			return errors.Errorf("%v: expected the positions of the maxima to lie within a plane of %d values. Got %d at %d", op, size, p, i)
+		}
+	}
+	return nil
+}
+
+// contiguousInts is like contiguousFloat, but for tensors of Int, such as indices.
+func (e StdEng) contiguousInts(t Tensor, op string) (DenseTensor, error) {
+	if err := e.checkAccessible(t); err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+	if t.Dtype() != Int {
+		return nil, errors.Errorf(dtypeMismatch, Int, t.Dtype())
+	}
+	if v, ok := t.(View); ok && v.IsMaterializable() {
+		t = v.Materialize()
+	}
+	d, err := getDenseTensor(t)
+	if err != nil {
+		return nil, errors.Wrapf(err, opFail, op)
+	}
+	return d, nil
+}
+
+// MaxPool2D returns the maximum of each window, as well as its position within the image plane.
+func (e StdEng) MaxPool2D(x Tensor, w Window2D) (retVal, argmax Tensor, err error) {
+	var xd DenseTensor
+	var g convGeom
+	if xd, g, err = e.poolPrep(x, w, "MaxPool2D"); err != nil {
+		return nil, nil, err
+	}
+	retVal = New(WithShape(g.outShape()...), Of(xd.Dtype()), WithEngine(e))
+	argmax = New(WithShape(g.outShape()...), Of(Int), WithEngine(e))
+	switch xd.Dtype() {
+	case Float64:
+		maxPoolF64(&g, getFloat64s(xd), getFloat64s(retVal), getInts(argmax))
+	case Float32:
+		maxPoolF32(&g, getFloat32s(xd), getFloat32s(retVal), getInts(argmax))
+	}
+	return
+}
+
+// MaxPool2DB routes the gradient of each window to the position of its maximum.
+func (e StdEng) MaxPool2DB(x, argmax, grad Tensor, w Window2D) (retVal Tensor, err error) {
+	if argmax == nil {
+		return nil, errors.Errorf(opFail, "MaxPool2DB: argmax is nil")
+	}
+	var am, gd DenseTensor
+	var g convGeom
+	if am, gd, g, err = e.poolBPrep(x, argmax, grad, w, "MaxPool2DB"); err != nil {
+		return nil, err
+	}
+	retVal = New(WithShape(x.Shape().Clone()...), Of(x.Dtype()), WithEngine(e))
+	switch x.Dtype() {
+	case Float64:
+		maxPoolBF64(&g, getInts(am), getFloat64s(gd), getFloat64s(retVal))
+	case Float32:
+		maxPoolBF32(&g, getInts(am), getFloat32s(gd), getFloat32s(retVal))
+	}
+	return
+}
+
+// AvgPool2D returns the mean of each window. Padding counts towards the size of the window.
+func (e StdEng) AvgPool2D(x Tensor, w Window2D) (retVal Tensor, err error) {
+	var xd DenseTensor
+	var g convGeom
+	if xd, g, err = e.poolPrep(x, w, "AvgPool2D"); err != nil {
+		return nil, err
+	}
+	retVal = New(WithShape(g.outShape()...), Of(xd.Dtype()), WithEngine(e))
+	switch xd.Dtype() {
+	case Float64:
+		avgPoolF64(&g, getFloat64s(xd), getFloat64s(retVal))
+	case Float32:
+		avgPoolF32(&g, getFloat32s(xd), getFloat32s(retVal))
+	}
+	return
+}
+
+// AvgPool2DB spreads the gradient of each window evenly across the window.
+func (e StdEng) AvgPool2DB(x, grad Tensor, w Window2D) (retVal Tensor, err error) {
+	var gd DenseTensor
+	var g convGeom
+	if _, gd, g, err = e.poolBPrep(x, nil, grad, w, "AvgPool2DB"); err != nil {
+		return nil, err
+	}
+	retVal = New(WithShape(x.Shape().Clone()...), Of(x.Dtype()), WithEngine(e))
+	switch x.Dtype() {
+	case Float64:
+		avgPoolBF64(&g, getFloat64s(gd), getFloat64s(retVal))
+	case Float32:
+		avgPoolBF32(&g, getFloat32s(gd), getFloat32s(retVal))
+	}
+	return
+}
+
+// MaxPool1D pools a batch of sequences by treating them as images of height 1.
+func (e StdEng) MaxPool1D(x Tensor, w Window1D) (retVal, argmax Tensor, err error) {
+	var x4 *Dense
+	if x4, err = unsqueezedCopy3D(x, w.Layout); err != nil {
+		return nil, nil, errors.Wrapf(err, opFail, "MaxPool1D")
+	}
+	if retVal, argmax, err = e.MaxPool2D(x4, w.to2D()); err != nil {
+		return nil, nil, err
+	}
+	if err = squeezeConv1D(retVal, w.Layout); err != nil {
+		return nil, nil, err
+	}
+	return retVal, argmax, squeezeConv1D(argmax, w.Layout)
+}
+
+// MaxPool1DB computes the gradient of MaxPool1D.
+func (e StdEng) MaxPool1DB(x, argmax, grad Tensor, w Window1D) (retVal Tensor, err error) {
+	var x4, am4, g4 *Dense
+	if x4, err = unsqueezedCopy3D(x, w.Layout); err != nil {
+		return nil, errors.Wrapf(err, opFail, "MaxPool1DB")
+	}
+	if am4, err = unsqueezedCopy3D(argmax, w.Layout); err != nil {
+		return nil, errors.Wrapf(err, opFail, "MaxPool1DB")
+	}
+	if g4, err = unsqueezedCopy3D(grad, w.Layout); err != nil {
+		return nil, errors.Wrapf(err, opFail, "MaxPool1DB")
+	}
+	if retVal, err = e.MaxPool2DB(x4, am4, g4, w.to2D()); err != nil {
+		return nil, err
+	}
+	return retVal, retVal.Reshape(x.Shape().Clone()...)
+}
+
+// AvgPool1D pools a batch of sequences by treating them as images of height 1.
+func (e StdEng) AvgPool1D(x Tensor, w Window1D) (retVal Tensor, err error) {
+	var x4 *Dense
+	if x4, err = unsqueezedCopy3D(x, w.Layout); err != nil {
+		return nil, errors.Wrapf(err, opFail, "AvgPool1D")
+	}
+	if retVal, err = e.AvgPool2D(x4, w.to2D()); err != nil {
+		return nil, err
+	}
+	return retVal, squeezeConv1D(retVal, w.Layout)
+}
+
+// AvgPool1DB computes the gradient of AvgPool1D.
+func (e StdEng) AvgPool1DB(x, grad Tensor, w Window1D) (retVal Tensor, err error) {
+	var x4, g4 *Dense
+	if x4, err = unsqueezedCopy3D(x, w.Layout); err != nil {
+		return nil, errors.Wrapf(err, opFail, "AvgPool1DB")
+	}
+	if g4, err = unsqueezedCopy3D(grad, w.Layout); err != nil {
+		return nil, errors.Wrapf(err, opFail, "AvgPool1DB")
+	}
+	if retVal, err = e.AvgPool2DB(x4, g4, w.to2D()); err != nil {
+		return nil, err
+	}
+	return retVal, retVal.Reshape(x.Shape().Clone()...)
+}
+
+// unsqueezedCopy3D checks that t is a batch of sequences and turns it into a batch of images of height 1.
+func unsqueezedCopy3D(t Tensor, layout ImageLayout) (*Dense, error) {
+	if t == nil {
+		return nil, errors.New("nil is not a *Dense")
+	}
+	if t.Dims() != 3 {
+		return nil, errors.Errorf(dimMismatch, 3, t.Dims())
+	}
+	return unsqueezedCopy(t, conv1DSpatialAxis(layout))
+}
+
+// globalGeom describes a batch of images with any number of spatial dimensions, flattened into a single spatial dimension of size sp.
+type globalGeom struct {
+	layout   ImageLayout
+	n, c, sp int
+}
+
+func newGlobalGeom(s Shape, layout ImageLayout) (g globalGeom, err error) {
+	if layout != NCHW && layout != NHWC {
+		err = errors.Errorf("Unknown layout %v", layout)
+		return
+	}
+	if s.Dims() < 3 {
+		err = errors.Errorf("Expected a batch of images with at least one spatial dimension. Got %v", s)
+		return
+	}
+	g.layout = layout
+	g.n = s[0]
+	if layout == NHWC {
+		g.c = s[len(s)-1]
+		g.sp = ProdInts([]int(s[1 : len(s)-1]))
+	} else {
+		g.c = s[1]
+		g.sp = ProdInts([]int(s[2:]))
+	}
+	return
+}
+
+// offset returns the offset into the input of the p-th spatial position of the given image and channel.
+func (g *globalGeom) offset(b, ch, p int) int {
+	if g.layout == NHWC {
+		return (b*g.sp+p)*g.c + ch
+	}
+	return (b*g.c+ch)*g.sp + p
+}
+
+func (e StdEng) globalPoolBPrep(x, argmax, grad Tensor, layout ImageLayout, op string) (am, gd DenseTensor, g globalGeom, err error) {
+	if err = typeclassCheck(x.Dtype(), floatTypes); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	if g, err = newGlobalGeom(x.Shape(), layout); err != nil {
+		err = errors.Wrapf(err, opFail, op)
+		return
+	}
+	if gd, err = e.contiguousFloat(grad, op); err != nil {
+		return
+	}
+	if gd.Dtype() != x.Dtype() {
+		err = errors.Errorf(dtypeMismatch, x.Dtype(), gd.Dtype())
+		return
+	}
+	expShape := Shape{g.n, g.c}
+	if !gd.Shape().Eq(expShape) {
+		err = errors.Errorf(shapeMismatch, expShape, gd.Shape())
+		return
+	}
+	if argmax == nil {
+		return
+	}
+	if am, err = e.contiguousInts(argmax, op); err != nil {
+		return
+	}
+	if !am.Shape().Eq(expShape) {
+		err = errors.Errorf(shapeMismatch, expShape, am.Shape())
+		return
+	}
+	err = checkArgmax(getInts(am), g.sp, op)
+	return
+}
+
+// GlobalMaxPool returns the maximum of each channel of each image, as well as its flat spatial position.
+func (e StdEng) GlobalMaxPool(x Tensor, layout ImageLayout) (retVal, argmax Tensor, err error) {
+	var xd DenseTensor
+	if xd, err = e.contiguousFloat(x, "GlobalMaxPool"); err != nil {
+		return nil, nil, err
+	}
+	var g globalGeom
+	if g, err = newGlobalGeom(xd.Shape(), layout); err != nil {
+		return nil, nil, errors.Wrapf(err, opFail, "GlobalMaxPool")
+	}
+	retVal = New(WithShape(g.n, g.c), Of(xd.Dtype()), WithEngine(e))
+	argmax = New(WithShape(g.n, g.c), Of(Int), WithEngine(e))
+	switch xd.Dtype() {
+	case Float64:
+		globalMaxPoolF64(&g, getFloat64s(xd), getFloat64s(retVal), getInts(argmax))
+	case Float32:
+		globalMaxPoolF32(&g, getFloat32s(xd), getFloat32s(retVal), getInts(argmax))
+	}
+	return
+}
+
+// GlobalMaxPoolB routes the gradient of each channel to the position of its maximum.
+func (e StdEng) GlobalMaxPoolB(x, argmax, grad Tensor, layout ImageLayout) (retVal Tensor, err error) {
+	if argmax == nil {
+		return nil, errors.Errorf(opFail, "GlobalMaxPoolB: argmax is nil")
+	}
+	var am, gd DenseTensor
+	var g globalGeom
+	if am, gd, g, err = e.globalPoolBPrep(x, argmax, grad, layout, "GlobalMaxPoolB"); err != nil {
+		return nil, err
+	}
+	retVal = New(WithShape(x.Shape().Clone()...), Of(x.Dtype()), WithEngine(e))
+	idx := getInts(am)
+	switch x.Dtype() {
+	case Float64:
+		dx, gr := getFloat64s(retVal), getFloat64s(gd)
+		for i, p := range idx {
+			if p >= 0 {
+				dx[g.offset(i/g.c, i%g.c, p)] += gr[i]
+			}
+		}
+	case Float32:
+		dx, gr := getFloat32s(retVal), getFloat32s(gd)
+		for i, p := range idx {
+			if p >= 0 {
+				dx[g.offset(i/g.c, i%g.c, p)] += gr[i]
+			}
+		}
+	}
+	return
+}
+
+// GlobalAvgPool returns the mean of each channel of each image.
+func (e StdEng) GlobalAvgPool(x Tensor, layout ImageLayout) (retVal Tensor, err error) {
+	var xd DenseTensor
+	if xd, err = e.contiguousFloat(x, "GlobalAvgPool"); err != nil {
+		return nil, err
+	}
+	var g globalGeom
+	if g, err = newGlobalGeom(xd.Shape(), layout); err != nil {
+		return nil, errors.Wrapf(err, opFail, "GlobalAvgPool")
+	}
+	retVal = New(WithShape(g.n, g.c), Of(xd.Dtype()), WithEngine(e))
+	switch xd.Dtype() {
+	case Float64:
+		globalAvgPoolF64(&g, getFloat64s(xd), getFloat64s(retVal))
+	case Float32:
+		globalAvgPoolF32(&g, getFloat32s(xd), getFloat32s(retVal))
+	}
+	return
+}
+
+// GlobalAvgPoolB spreads the gradient of each channel evenly across the spatial dimensions.
+func (e StdEng) GlobalAvgPoolB(x, grad Tensor, layout ImageLayout) (retVal Tensor, err error) {
+	var gd DenseTensor
+	var g globalGeom
+	if _, gd, g, err = e.globalPoolBPrep(x, nil, grad, layout, "GlobalAvgPoolB"); err != nil {
+		return nil, err
+	}
+	retVal = New(WithShape(x.Shape().Clone()...), Of(x.Dtype()), WithEngine(e))
+	switch x.Dtype() {
+	case Float64:
+		dx, gr := getFloat64s(retVal), getFloat64s(gd)
+		for i, v := range gr {
+			v /= float64(g.sp)
+			for p := 0; p < g.sp; p++ {
+				dx[g.offset(i/g.c, i%g.c, p)] = v
+			}
+		}
+	case Float32:
+		dx, gr := getFloat32s(retVal), getFloat32s(gd)
+		for i, v := range gr {
+			v /= float32(g.sp)
+			for p := 0; p < g.sp; p++ {
+				dx[g.offset(i/g.c, i%g.c, p)] = v
+			}
+		}
+	}
+	return
+}
+
+/* float64 kernels */
+
+// maxPoolF64 finds the maximum of each window. NaNs are propagated. A window that lies entirely in the padding yields 0 and an argmax of -1.
+func maxPoolF64(g *convGeom, x, out []float64, argmax []int) {
+	imSize, outSize := g.imSize(), g.outSize()
+	kk := g.w.KernelH * g.w.KernelW
+	for b := 0; b < g.n; b++ {
+		im := x[b*imSize : (b+1)*imSize]
+		o := out[b*outSize : (b+1)*outSize]
+		am := argmax[b*outSize : (b+1)*outSize]
+		for ch := 0; ch < g.c; ch++ {
+			for l := 0; l < g.l; l++ {
+				start, step := g.window(ch, l)
+				var best float64
+				at := -1
+				for q := 0; q < kk; q++ {
+					j := g.idx[start+q*step]
+					if j < 0 {
+						continue
+					}
+					if v := im[j]; at < 0 || v > best || math.IsNaN(v) && !math.IsNaN(best) {
+						best, at = v, j
+					}
+				}
+				oi := g.outIndex(ch, l)
+				o[oi] = best
+				am[oi] = g.planeIndex(ch, at)
+			}
+		}
+	}
+}
+
+func maxPoolBF64(g *convGeom, argmax []int, grad, dx []float64) {
+	imSize, outSize := g.imSize(), g.outSize()
+	for b := 0; b < g.n; b++ {
+		d := dx[b*imSize : (b+1)*imSize]
+		for ch := 0; ch < g.c; ch++ {
+			for l := 0; l < g.l; l++ {
+				oi := b*outSize + g.outIndex(ch, l)
+				if p := argmax[oi]; p >= 0 {
+					d[g.planeOffset(ch, p)] += grad[oi]
+				}
+			}
+		}
+	}
+}
+
+func avgPoolF64(g *convGeom, x, out []float64) {
+	imSize, outSize := g.imSize(), g.outSize()
+	kk := g.w.KernelH * g.w.KernelW
+	for b := 0; b < g.n; b++ {
+		im := x[b*imSize : (b+1)*imSize]
+		o := out[b*outSize : (b+1)*outSize]
+		for ch := 0; ch < g.c; ch++ {
+			for l := 0; l < g.l; l++ {
+				start, step := g.window(ch, l)
+				var sum float64
+				for q := 0; q < kk; q++ {
+					if j := g.idx[start+q*step]; j >= 0 {
+						sum += im[j]
+					}
+				}
+				o[g.outIndex(ch, l)] = sum / float64(kk)
+			}
+		}
+	}
+}
+
+func avgPoolBF64(g *convGeom, grad, dx []float64) {
+	imSize, outSize := g.imSize(), g.outSize()
+	kk := g.w.KernelH * g.w.KernelW
+	for b := 0; b < g.n; b++ {
+		d := dx[b*imSize : (b+1)*imSize]
+		gr := grad[b*outSize : (b+1)*outSize]
+		for ch := 0; ch < g.c; ch++ {
+			for l := 0; l < g.l; l++ {
+				start, step := g.window(ch, l)
+				v := gr[g.outIndex(ch, l)] / float64(kk)
+				for q := 0; q < kk; q++ {
+					if j := g.idx[start+q*step]; j >= 0 {
+						d[j] += v
+					}
+				}
+			}
+		}
+	}
+}
+
+func globalMaxPoolF64(g *globalGeom, x, out []float64, argmax []int) {
+	for b := 0; b < g.n; b++ {
+		for ch := 0; ch < g.c; ch++ {
+			var best float64
+			at := -1
+			for p := 0; p < g.sp; p++ {
+				if v := x[g.offset(b, ch, p)]; at < 0 || v > best || math.IsNaN(v) && !math.IsNaN(best) {
+					best, at = v, p
+				}
+			}
+			out[b*g.c+ch] = best
+			argmax[b*g.c+ch] = at
+		}
+	}
+}
+
+func globalAvgPoolF64(g *globalGeom, x, out []float64) {
+	for b := 0; b < g.n; b++ {
+		for ch := 0; ch < g.c; ch++ {
+			var sum float64
+			for p := 0; p < g.sp; p++ {
+				sum += x[g.offset(b, ch, p)]
+			}
+			out[b*g.c+ch] = sum / float64(g.sp)
+		}
+	}
+}
+
+/* float32 kernels */
+
+func maxPoolF32(g *convGeom, x, out []float32, argmax []int) {
+	imSize, outSize := g.imSize(), g.outSize()
+	kk := g.w.KernelH * g.w.KernelW
+	for b := 0; b < g.n; b++ {
+		im := x[b*imSize : (b+1)*imSize]
+		o := out[b*outSize : (b+1)*outSize]
+		am := argmax[b*outSize : (b+1)*outSize]
+		for ch := 0; ch < g.c; ch++ {
+			for l := 0; l < g.l; l++ {
+				start, step := g.window(ch, l)
+				var best float32
+				at := -1
+				for q := 0; q < kk; q++ {
+					j := g.idx[start+q*step]
+					if j < 0 {
+						continue
+					}
+					if v := im[j]; at < 0 || v > best || math32.IsNaN(v) && !math32.IsNaN(best) {
+						best, at = v, j
+					}
+				}
+				oi := g.outIndex(ch, l)
+				o[oi] = best
+				am[oi] = g.planeIndex(ch, at)
+			}
+		}
+	}
+}
+
+func maxPoolBF32(g *convGeom, argmax []int, grad, dx []float32) {
+	imSize, outSize := g.imSize(), g.outSize()
+	for b := 0; b < g.n; b++ {
+		d := dx[b*imSize : (b+1)*imSize]
+		for ch := 0; ch < g.c; ch++ {
+			for l := 0; l < g.l; l++ {
+				oi := b*outSize + g.outIndex(ch, l)
+				if p := argmax[oi]; p >= 0 {
+					d[g.planeOffset(ch, p)] += grad[oi]
+				}
+			}
+		}
+	}
+}
+
+func avgPoolF32(g *convGeom, x, out []float32) {
+	imSize, outSize := g.imSize(), g.outSize()
+	kk := g.w.KernelH * g.w.KernelW
+	for b := 0; b < g.n; b++ {
+		im := x[b*imSize : (b+1)*imSize]
+		o := out[b*outSize : (b+1)*outSize]
+		for ch := 0; ch < g.c; ch++ {
+			for l := 0; l < g.l; l++ {
+				start, step := g.window(ch, l)
+				var sum float32
+				for q := 0; q < kk; q++ {
+					if j := g.idx[start+q*step]; j >= 0 {
+						sum += im[j]
+					}
+				}
+				o[g.outIndex(ch, l)] = sum / float32(kk)
+			}
+		}
+	}
+}
+
+func avgPoolBF32(g *convGeom, grad, dx []float32) {
+	imSize, outSize := g.imSize(), g.outSize()
+	kk := g.w.KernelH * g.w.KernelW
+	for b := 0; b < g.n; b++ {
+		d := dx[b*imSize : (b+1)*imSize]
+		gr := grad[b*outSize : (b+1)*outSize]
+		for ch := 0; ch < g.c; ch++ {
+			for l := 0; l < g.l; l++ {
+				start, step := g.window(ch, l)
+				v := gr[g.outIndex(ch, l)] / float32(kk)
+				for q := 0; q < kk; q++ {
+					if j := g.idx[start+q*step]; j >= 0 {
+						d[j] += v
+					}
+				}
+			}
+		}
+	}
+}
+
+func globalMaxPoolF32(g *globalGeom, x, out []float32, argmax []int) {
+	for b := 0; b < g.n; b++ {
+		for ch := 0; ch < g.c; ch++ {
+			var best float32
+			at := -1
+			for p := 0; p < g.sp; p++ {
+				if v := x[g.offset(b, ch, p)]; at < 0 || v > best || math32.IsNaN(v) && !math32.IsNaN(best) {
+					best, at = v, p
+				}
+			}
+			out[b*g.c+ch] = best
+			argmax[b*g.c+ch] = at
+		}
+	}
+}
+
+func globalAvgPoolF32(g *globalGeom, x, out []float32) {
+	for b := 0; b < g.n; b++ {
+		for ch := 0; ch < g.c; ch++ {
+			var sum float32
+			for p := 0; p < g.sp; p++ {
+				sum += x[g.offset(b, ch, p)]
+			}
+			out[b*g.c+ch] = sum / float32(g.sp)
+		}
+	}
+}
@@ -29,6 +29,11 @@ func handleFuncOpts(expShape Shape, expType Dtype, o DataOrder, strict bool, opt
 			return
 		}
 
+		if err = checkWritable(reuse); err != nil {
+			returnOpOpt(fo)
+			return
+		}
+
 		if (strict || same) && reuse.Dtype() != expType {
 			returnOpOpt(fo)
 			err = errors.Errorf(typeMismatch, expType, reuse.Dtype())
@@ -121,11 +126,32 @@ func scalarDtypeCheck(a Tensor, b interface{}) error {
 	return nil
 }
 
+// handleReadOnly makes sure that an operation does not write into a read-only a, such as a broadcast view.
+// Unsafe and incremental operations refuse it, and safe ones write their result into a newly allocated reuse tensor
+// instead of a clone of a.
+func handleReadOnly(a Tensor, reuse DenseTensor, safe, toReuse, incr bool) (DenseTensor, bool, error) {
+	if err := checkWritable(a); err == nil {
+		return reuse, toReuse, nil
+	} else if !safe || incr {
+		return nil, false, err
+	}
+	if toReuse {
+		return reuse, toReuse, nil
+	}
+	return New(Of(a.Dtype()), WithShape(a.Shape().Clone()...)), true, nil
+}
+
 // prepDataVV prepares the data given the input and reuse tensors. It also retruns several indicators
 //
 // useIter indicates that the iterator methods should be used.
 // swap indicates that the operands are swapped.
+//
+// The data of lazily conjugated views cannot be read as is, so they are refused.
 func prepDataVV(a, b Tensor, reuse Tensor) (dataA, dataB, dataReuse *storage.Header, ait, bit, iit Iterator, useIter, swap bool, err error) {
+	if err = checkUnconjugated(a, b); err != nil {
+		return
+	}
+
 	// get data
 	dataA = a.hdr()
 	dataB = b.hdr()
@@ -150,6 +176,11 @@ func prepDataVV(a, b Tensor, reuse Tensor) (dataA, dataB, dataReuse *storage.Hea
 	// swap
 	if _, ok := a.(*CS); ok {
 		if _, ok := b.(DenseTensor); ok {
+			if reuse == nil {
+				if err = checkWritable(b); err != nil {
+					return
+				}
+			}
 			swap = true
 			dataA, dataB = dataB, dataA
 			ait, bit = bit, ait
@@ -160,6 +191,10 @@ func prepDataVV(a, b Tensor, reuse Tensor) (dataA, dataB, dataReuse *storage.Hea
 }
 
 func prepDataVS(a Tensor, b interface{}, reuse Tensor) (dataA, dataB, dataReuse *storage.Header, ait, iit Iterator, useIter bool, newAlloc bool, err error) {
+	if err = checkUnconjugated(a); err != nil {
+		return
+	}
+
 	// get data
 	dataA = a.hdr()
 	dataB, newAlloc = scalarToHeader(b)
@@ -183,6 +218,10 @@ func prepDataVS(a Tensor, b interface{}, reuse Tensor) (dataA, dataB, dataReuse
 }
 
 func prepDataSV(a interface{}, b Tensor, reuse Tensor) (dataA, dataB, dataReuse *storage.Header, bit, iit Iterator, useIter bool, newAlloc bool, err error) {
+	if err = checkUnconjugated(b); err != nil {
+		return
+	}
+
 	// get data
 	dataA, newAlloc = scalarToHeader(a)
 	dataB = b.hdr()
@@ -208,6 +247,10 @@ func prepDataSV(a interface{}, b Tensor, reuse Tensor) (dataA, dataB, dataReuse
 }
 
 func prepDataUnary(a Tensor, reuse Tensor) (dataA, dataReuse *storage.Header, ait, rit Iterator, useIter bool, err error) {
+	if err = checkUnconjugated(a); err != nil {
+		return
+	}
+
 	// get data
 	dataA = a.hdr()
 	if reuse != nil {
@@ -0,0 +1,178 @@
+package tensor
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+var _ Quantiler = StdEng{}
+
+// Quantile computes the quantiles of a float tensor along the given axes. See the package level function Quantile.
+func (e StdEng) Quantile(t Tensor, axes []int, method QuantileMethod, q ...float64) (retVal Tensor, err error) {
+	if method > QuantileMidpoint {
+		return nil, errors.Errorf("Unknown quantile method %v", method)
+	}
+	if len(q) == 0 {
+		return nil, errors.New("Expected at least one quantile")
+	}
+	for _, v := range q {
+		if !(v >= 0 && v <= 1) {
+			return nil, errors.Errorf("Quantiles must be in [0, 1]. Got %v", v)
+		}
+	}
+
+	var x DenseTensor
+	var mask []bool
+	var g normGeom
+	if x, mask, g, err = e.nanPrep(t, axes, true, "Quantile"); err != nil {
+		return nil, err
+	}
+
+	// the values of a group are gathered into buf, which the selection then reorders
+	groups := len(g.base)
+	out := make([]float64, len(q)*groups)
+	buf := make([]float64, 0, len(g.rel))
+	for grp, base := range g.base {
+		buf = buf[:0]
+		switch x.Dtype() {
+		case Float64:
+			data := x.Float64s()
+			for _, r := range g.rel {
+				if i := base + r; mask == nil || !mask[i] {
+					buf = append(buf, data[i])
+				}
+			}
+		case Float32:
+			data := x.Float32s()
+			for _, r := range g.rel {
+				if i := base + r; mask == nil || !mask[i] {
+					buf = append(buf, float64(data[i]))
+				}
+			}
+		}
+
+		hasNaN := len(buf) == 0
+		for _, v := range buf {
+			if math.IsNaN(v) {
+				hasNaN = true
+				break
+			}
+		}
+		for j, v := range q {
+			if hasNaN {
+				out[j*groups+grp] = math.NaN()
+				continue
+			}
+			out[j*groups+grp] = quantileOf(buf, v, method)
+		}
+	}
+
+	shape := g.keptShape
+	if len(q) > 1 {
+		shape = append(Shape{len(q)}, shape...)
+	}
+	switch x.Dtype() {
+	case Float64:
+		if len(shape) == 0 {
+			return New(FromScalar(out[0]), WithEngine(e)), nil
+		}
+		return New(WithShape(shape...), WithBacking(out), WithEngine(e)), nil
+	case Float32:
+		out32 := make([]float32, len(out))
+		for i, v := range out {
+			out32[i] = float32(v)
+		}
+		if len(shape) == 0 {
+			return New(FromScalar(out32[0]), WithEngine(e)), nil
+		}
+		return New(WithShape(shape...), WithBacking(out32), WithEngine(e)), nil
+	}
+	return nil, errors.Errorf(unsupportedDtype, x.Dtype(), "Quantile")
+}
+
+// quantileOf returns the q-th quantile of the values in a, which must not be empty nor contain NaNs. a is reordered.
+func quantileOf(a []float64, q float64, method QuantileMethod) float64 {
+	pos := q * float64(len(a)-1)
+	lo := int(math.Floor(pos))
+	hi := MinInt(int(math.Ceil(pos)), len(a)-1)
+
+	if method == QuantileNearest {
+		k := int(math.RoundToEven(pos))
+		selectKth(a, k)
+		return a[k]
+	}
+
+	selectKth(a, lo)
+	vlo, vhi := a[lo], a[lo]
+	if hi > lo {
+		// everything after lo is at least a[lo], so the next value in order is the smallest of them
+		vhi = a[hi]
+		for _, v := range a[hi+1:] {
+			if v < vhi {
+				vhi = v
+			}
+		}
+	}
+
+	switch method {
+	case QuantileLower:
+		return vlo
+	case QuantileHigher:
+		return vhi
+	case QuantileMidpoint:
+		return (vlo + vhi) / 2
+	}
+	// like Numpy, interpolate from the nearest end so that the ends are exact
+	frac := pos - float64(lo)
+	if frac < 0.5 {
+		return vlo + (vhi-vlo)*frac
+	}
+	return vhi - (vhi-vlo)*(1-frac)
+}
+
+// selectKth reorders a such that a[k] is the value that would be there if a were sorted,
+// with no greater values before it and no smaller values after it. It uses quickselect with a median of three pivot.
+func selectKth(a []float64, k int) {
+	lo, hi := 0, len(a)-1
+	for lo < hi {
+		// median of three, which also leaves a[lo] <= pivot <= a[hi]
+		mid := lo + (hi-lo)/2
+		if a[mid] < a[lo] {
+			a[mid], a[lo] = a[lo], a[mid]
+		}
+		if a[hi] < a[lo] {
+			a[hi], a[lo] = a[lo], a[hi]
+		}
+		if a[hi] < a[mid] {
+			a[hi], a[mid] = a[mid], a[hi]
+		}
+		pivot := a[mid]
+
+		// Hoare partition
+		i, j := lo, hi
+		for i <= j {
+			for a[i] < pivot {
+				i++
+			}
+			for a[j] > pivot {
+				j--
+			}
+			if i <= j {
+				a[i], a[j] = a[j], a[i]
+				i++
+				j--
+			}
+		}
+
+		// now a[lo:j+1] <= pivot <= a[i:hi+1], and anything in between is equal to the pivot
+		switch {
+		case k <= j:
+			hi = j
+		case k >= i:
+			lo = i
+		default:
+			return
+		}
+	}
+}
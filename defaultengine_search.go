@@ -0,0 +1,100 @@
+package tensor
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+var _ Searcher = StdEng{}
+
+// SearchSorted finds where values would be inserted into sorted to keep it sorted. See the package level function SearchSorted.
+func (e StdEng) SearchSorted(sorted, values Tensor, side SearchSide) (retVal Tensor, err error) {
+	if side > SearchRight {
+		return nil, errors.Errorf("Unknown search side %v", side)
+	}
+	var x, y DenseTensor
+	if x, y, err = e.orderedPair(sorted, values, "SearchSorted"); err != nil {
+		return nil, err
+	}
+	if x.Dims() == 0 {
+		return nil, errors.Errorf(atleastDims, 1)
+	}
+
+	// rows of m sorted values, each with n values to search for
+	xs, ys := x.Shape(), y.Shape()
+	m, n, rows := xs[len(xs)-1], y.len(), 1
+	if x.Dims() > 1 {
+		if y.Dims() != x.Dims() || !ys[:len(ys)-1].Eq(xs[:len(xs)-1]) {
+			return nil, errors.Errorf("Expected values with the leading dimensions of sorted %v. Got %v", xs, ys)
+		}
+		rows, n = xs[:len(xs)-1].TotalSize(), ys[len(ys)-1]
+	}
+
+	cmp := ordCmp(y, x)
+	ret := New(WithShape(ys.Clone()...), Of(Int), WithEngine(e))
+	if y.IsScalar() {
+		ret = New(FromScalar(0), WithEngine(e))
+	}
+	idx := ret.Ints()
+	for r := 0; r < rows; r++ {
+		for i := r * n; i < (r+1)*n; i++ {
+			idx[i] = sort.Search(m, func(k int) bool {
+				c := cmp(i, r*m+k)
+				return c < 0 || (c == 0 && side == SearchLeft)
+			})
+		}
+	}
+	return ret, nil
+}
+
+// SampleCategorical draws samples from the categorical distributions given by the weights. See the package level function SampleCategorical.
+func (e StdEng) SampleCategorical(weights Tensor, n int, rng *rand.Rand) (retVal Tensor, err error) {
+	var w DenseTensor
+	if w, err = e.contiguousFloat(weights, "SampleCategorical"); err != nil {
+		return nil, err
+	}
+	if w.Dims() == 0 {
+		return nil, errors.Errorf(atleastDims, 1)
+	}
+	if n < 0 {
+		return nil, errors.Errorf("Expected a non negative number of samples. Got %d", n)
+	}
+	uniform := rand.Float64
+	if rng != nil {
+		uniform = rng.Float64
+	}
+
+	shape := w.Shape()
+	k := shape[len(shape)-1]
+	rows := shape[:len(shape)-1].TotalSize()
+	outShape := append(shape[:len(shape)-1].Clone(), n)
+	ret := New(WithShape(outShape...), Of(Int), WithEngine(e))
+	samples := ret.Ints()
+
+	vals := asFloat64s(w)
+	cum := make([]float64, k)
+	for r := 0; r < rows; r++ {
+		var total float64
+		for j, v := range vals[r*k : (r+1)*k] {
+			if !(v >= 0) || math.IsInf(v, 0) {
+				return nil, errors.Errorf("Expected finite non negative weights. Got %v", v)
+			}
+			total += v
+			cum[j] = total
+		}
+		if !(total > 0) {
+			return nil, errors.Errorf("Expected some positive weights in each distribution")
+		}
+
+		// the category of u is the first one whose cumulative weight is above u, which skips the categories with no weight
+		for s := 0; s < n; s++ {
+			u := uniform() * total
+			c := sort.Search(k, func(j int) bool { return cum[j] > u })
+			samples[r*n+s] = MinInt(c, k-1)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,286 @@
+package tensor
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var _ SetOper = StdEng{}
+
+// Unique returns the sorted unique values (or slices along the axis) of t. See the package level function Unique.
+func (e StdEng) Unique(t Tensor, axis int, withCounts, withInverse bool) (unique, counts, inverse Tensor, err error) {
+	var x DenseTensor
+	if x, err = e.ordered(t, "Unique"); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// the slices along the axis are compared lexicographically. Flattened, each slice is a single value
+	shape := x.Shape()
+	n, stride, rel := x.len(), 1, []int{0}
+	if axis != AllAxes {
+		if axis < 0 || axis >= x.Dims() {
+			return nil, nil, nil, errors.Errorf(invalidAxis, axis, x.Dims())
+		}
+		strides := shape.CalcStrides()
+		n, stride = shape[axis], strides[axis]
+		rel = axisOffsets(shape, strides, otherAxes([]int{axis}, x.Dims()))
+	}
+	cmp := ordCmp(x, x)
+	cmpSlices := func(p, q int) int {
+		for _, r := range rel {
+			if c := cmp(r+p*stride, r+q*stride); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+
+	// sorting stably keeps the first occurrence of each slice first
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return cmpSlices(order[i], order[j]) < 0 })
+
+	var firsts, c []int
+	inv := make([]int, n)
+	for k, p := range order {
+		if k == 0 || cmpSlices(order[k-1], p) != 0 {
+			firsts = append(firsts, p)
+			c = append(c, 0)
+		}
+		c[len(c)-1]++
+		inv[p] = len(firsts) - 1
+	}
+
+	if axis == AllAxes {
+		ret := New(WithShape(len(firsts)), Of(x.Dtype()), WithEngine(e))
+		for o, i := range firsts {
+			pickInto(ret, o, x, i)
+		}
+		unique = ret
+	} else {
+		g := newGatherGeom(shape)
+		g.shape[axis] = len(firsts)
+		g.src[axis] = firsts
+		ret := New(WithShape(g.shape.Clone()...), Of(x.Dtype()), WithEngine(e))
+		gatherInto(ret, x, g)
+		unique = ret
+	}
+
+	if withCounts {
+		counts = New(WithShape(len(c)), WithBacking(c), WithEngine(e))
+	}
+	if withInverse {
+		invShape := Shape{n}
+		if axis == AllAxes {
+			invShape = shape.Clone()
+		}
+		inverse = New(WithShape(invShape...), WithBacking(inv), WithEngine(e))
+	}
+	return unique, counts, inverse, nil
+}
+
+// Intersect1D returns the sorted unique values found in both a and b. See the package level function Intersect1D.
+func (e StdEng) Intersect1D(a, b Tensor) (retVal Tensor, err error) {
+	return e.setOp(a, b, true, false, false, "Intersect1D")
+}
+
+// Union1D returns the sorted unique values found in either a or b. See the package level function Union1D.
+func (e StdEng) Union1D(a, b Tensor) (retVal Tensor, err error) {
+	return e.setOp(a, b, true, true, true, "Union1D")
+}
+
+// SetDiff1D returns the sorted unique values of a that are not in b. See the package level function SetDiff1D.
+func (e StdEng) SetDiff1D(a, b Tensor) (retVal Tensor, err error) {
+	return e.setOp(a, b, false, true, false, "SetDiff1D")
+}
+
+// Isin tests whether each value of t is in test. See the package level function Isin.
+func (e StdEng) Isin(t, test Tensor) (retVal Tensor, err error) {
+	var x, y DenseTensor
+	if x, y, err = e.orderedPair(t, test, "Isin"); err != nil {
+		return nil, err
+	}
+	ys := sortedUnique(y)
+	cmp := ordCmp(x, y)
+
+	ret := New(WithShape(x.Shape().Clone()...), Of(Bool), WithEngine(e))
+	found := ret.Bools()
+	for i := range found {
+		k := sort.Search(len(ys), func(k int) bool { return cmp(i, ys[k]) <= 0 })
+		found[i] = k < len(ys) && cmp(i, ys[k]) == 0
+	}
+	return ret, nil
+}
+
+// setOp merges the sorted unique values of a and b, keeping the values found in both, only in a or only in b as asked.
+func (e StdEng) setOp(a, b Tensor, both, onlyA, onlyB bool, op string) (retVal Tensor, err error) {
+	var x, y DenseTensor
+	if x, y, err = e.orderedPair(a, b, op); err != nil {
+		return nil, err
+	}
+	xs, ys := sortedUnique(x), sortedUnique(y)
+	cmp := ordCmp(x, y)
+
+	type pick struct {
+		from DenseTensor
+		i    int
+	}
+	var picks []pick
+	var i, j int
+	for i < len(xs) || j < len(ys) {
+		var c int
+		switch {
+		case i == len(xs):
+			c = 1
+		case j == len(ys):
+			c = -1
+		default:
+			c = cmp(xs[i], ys[j])
+		}
+		switch {
+		case c == 0:
+			if both {
+				picks = append(picks, pick{x, xs[i]})
+			}
+			i++
+			j++
+		case c < 0:
+			if onlyA {
+				picks = append(picks, pick{x, xs[i]})
+			}
+			i++
+		default:
+			if onlyB {
+				picks = append(picks, pick{y, ys[j]})
+			}
+			j++
+		}
+	}
+
+	ret := New(WithShape(len(picks)), Of(x.Dtype()), WithEngine(e))
+	for o, p := range picks {
+		pickInto(ret, o, p.from, p.i)
+	}
+	return ret, nil
+}
+
+// ordered returns the data of t in its natural order, checking that its values can be compared.
+func (e StdEng) ordered(t Tensor, op string) (DenseTensor, error) {
+	x, err := e.contiguous(t, op)
+	if err != nil {
+		return nil, err
+	}
+	if ordCmp(x, x) == nil {
+		return nil, errors.Errorf(unsupportedDtype, x.Dtype(), op)
+	}
+	return x, nil
+}
+
+func (e StdEng) orderedPair(a, b Tensor, op string) (x, y DenseTensor, err error) {
+	if x, err = e.ordered(a, op); err != nil {
+		return nil, nil, err
+	}
+	if y, err = e.ordered(b, op); err != nil {
+		return nil, nil, err
+	}
+	if x.Dtype() != y.Dtype() {
+		return nil, nil, errors.Errorf(dtypeMismatch, x.Dtype(), y.Dtype())
+	}
+	return x, y, nil
+}
+
+// sortedUnique returns the index of the first occurrence of each unique value of x, in the order of the values.
+func sortedUnique(x DenseTensor) []int {
+	cmp := ordCmp(x, x)
+	order := make([]int, x.len())
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return cmp(order[i], order[j]) < 0 })
+
+	retVal := order[:0]
+	for k, i := range order {
+		if k == 0 || cmp(retVal[len(retVal)-1], i) != 0 {
+			retVal = append(retVal, i)
+		}
+	}
+	return retVal
+}
+
+// pickInto copies the i-th value of x into the o-th value of ret. The value is copied as raw bytes, so that all Dtypes are handled alike.
+func pickInto(ret *Dense, o int, x DenseTensor, i int) {
+	size := int(x.Dtype().Size())
+	copy(ret.array.Header.Raw[o*size:(o+1)*size], x.arr().Header.Raw[i*size:(i+1)*size])
+}
+
+// ordCmp returns a function that compares the i-th value of a with the j-th value of b, which have the same ordered Dtype.
+// Like Numpy, NaNs are equal to each other, and greater than any other value. It returns nil for Dtypes that cannot be compared.
+func ordCmp(a, b DenseTensor) func(i, j int) int {
+	ah, bh := a.arr().Header, b.arr().Header
+	switch a.Dtype() {
+	case Int:
+		x, y := ah.Ints(), bh.Ints()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Int8:
+		x, y := ah.Int8s(), bh.Int8s()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Int16:
+		x, y := ah.Int16s(), bh.Int16s()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Int32:
+		x, y := ah.Int32s(), bh.Int32s()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Int64:
+		x, y := ah.Int64s(), bh.Int64s()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Uint:
+		x, y := ah.Uints(), bh.Uints()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Uint8:
+		x, y := ah.Uint8s(), bh.Uint8s()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Uint16:
+		x, y := ah.Uint16s(), bh.Uint16s()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Uint32:
+		x, y := ah.Uint32s(), bh.Uint32s()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Uint64:
+		x, y := ah.Uint64s(), bh.Uint64s()
+		return func(i, j int) int { return ordSign(x[i] < y[j], x[i] > y[j]) }
+	case Float32:
+		x, y := ah.Float32s(), bh.Float32s()
+		return func(i, j int) int { return cmpFloat(float64(x[i]), float64(y[j])) }
+	case Float64:
+		x, y := ah.Float64s(), bh.Float64s()
+		return func(i, j int) int { return cmpFloat(x[i], y[j]) }
+	case String:
+		x, y := ah.Strings(), bh.Strings()
+		return func(i, j int) int { return strings.Compare(x[i], y[j]) }
+	}
+	return nil
+}
+
+func ordSign(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+func cmpFloat(x, y float64) int {
+	xn, yn := math.IsNaN(x), math.IsNaN(y)
+	switch {
+	case xn || yn:
+		return ordSign(!xn, !yn)
+	}
+	return ordSign(x < y, x > y)
+}
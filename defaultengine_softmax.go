@@ -25,6 +25,11 @@ func resolveAxis(axis int, dims int) int {
 // The softmax function is defined as :
 //	σ(x) = e^x_i / Σ(e^x_i)
 func (e StdEng) SoftMax(x Tensor, axis int, opts ...FuncOpt) (retVal Tensor, err error) {
+	var xd DenseTensor
+	if xd, err = e.contiguousFloat(x, "SoftMax"); err != nil {
+		return nil, err
+	}
+	x = xd
 	axis = resolveAxis(axis, x.Dims())
 	expectedShape := x.Shape()
 
@@ -69,6 +74,14 @@ func (e StdEng) SoftMaxB(output, grad Tensor, axis int, opts ...FuncOpt) (retVal
 		return nil, fmt.Errorf("output and grad types don't match")
 	}
 
+	var od, gd DenseTensor
+	if od, err = e.contiguousFloat(output, "SoftMaxB"); err != nil {
+		return nil, err
+	}
+	if gd, err = e.contiguousFloat(grad, "SoftMaxB"); err != nil {
+		return nil, err
+	}
+	output, grad = od, gd
 	axis = resolveAxis(axis, output.Dims())
 	expectedShape := output.Shape()
 
@@ -107,6 +120,11 @@ func (e StdEng) SoftMaxB(output, grad Tensor, axis int, opts ...FuncOpt) (retVal
 // Currently it expects the tensor to be a Dense tensor.
 // Please make a pull request to support sparse tensors.
 func (e StdEng) LogSoftMax(x Tensor, axis int, opts ...FuncOpt) (retVal Tensor, err error) {
+	var xd DenseTensor
+	if xd, err = e.contiguousFloat(x, "LogSoftMax"); err != nil {
+		return nil, err
+	}
+	x = xd
 	axis = resolveAxis(axis, x.Dims())
 	expectedShape := x.Shape()
 
@@ -152,6 +170,14 @@ func (e StdEng) LogSoftMaxB(output, grad Tensor, axis int, opts ...FuncOpt) (ret
 		return nil, fmt.Errorf("output and grad types don't match")
 	}
 
+	var od, gd DenseTensor
+	if od, err = e.contiguousFloat(output, "LogSoftMaxB"); err != nil {
+		return nil, err
+	}
+	if gd, err = e.contiguousFloat(grad, "LogSoftMaxB"); err != nil {
+		return nil, err
+	}
+	output, grad = od, gd
 	axis = resolveAxis(axis, output.Dims())
 	expectedShape := output.Shape()
 
@@ -185,6 +211,27 @@ func (e StdEng) LogSoftMaxB(output, grad Tensor, axis int, opts ...FuncOpt) (ret
 	return reuse, nil
 }
 
+// stridedMaxF64 returns the maximum of n elements of xs, starting at start and spaced stride apart.
+// Subtracting it before exponentiating keeps the softmax from overflowing.
+func stridedMaxF64(xs []float64, start, stride, n int) float64 {
+	max := xs[start]
+	for j := 1; j < n; j++ {
+		if v := xs[start+j*stride]; v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// logSumExpF64 returns the maximum of the strided elements as well as log Σ exp(x - max).
+func logSumExpF64(xs []float64, start, stride, n int) (max, lse float64) {
+	max = stridedMaxF64(xs, start, stride, n)
+	for j := 0; j < n; j++ {
+		lse += math.Exp(xs[start+j*stride] - max)
+	}
+	return max, math.Log(lse)
+}
+
 func (e StdEng) softMaxLastDimF64(output Tensor, x Tensor, axis int, logSoftMax bool) {
 	outputArr := getFloat64s(output)
 	xArr := getFloat64s(x)
@@ -201,14 +248,7 @@ func (e StdEng) softMaxLastDimF64(output Tensor, x Tensor, axis int, logSoftMax
 	for ii := 0; ii < outerSize; ii++ {
 		wg.Add(1)
 		go func(ii int, wg *sync.WaitGroup) {
-			maxInput := xArr[0]
-			for j := 1; j < dimSize; j++ {
-				i := ii*dimSize + j
-
-				if xArr[i] > maxInput {
-					maxInput = xArr[i]
-				}
-			}
+			maxInput := stridedMaxF64(xArr, ii*dimSize, 1, dimSize)
 
 			sumExp := float64(0.0)
 			for j := 0; j < dimSize; j++ {
@@ -333,14 +373,7 @@ func (e StdEng) softMaxInnerDimF64(output Tensor, x Tensor, axis int, logSoftmax
 			inputPart := xArr[outerIndex*outerStride+innerIndex:]
 			outputPart := outputArr[outerIndex*outerStride+innerIndex:]
 
-			maxInput := inputPart[0]
-			for j := 1; j < dimSize; j++ {
-				i := j * dimStride
-
-				if inputPart[i] > maxInput {
-					maxInput = inputPart[i]
-				}
-			}
+			maxInput := stridedMaxF64(inputPart, 0, dimStride, dimSize)
 
 			sumExp := 0.0
 			for j := 0; j < dimSize; j++ {
@@ -433,6 +466,24 @@ func (e StdEng) softMaxBInnerDimF64(inputGrad, output, grad Tensor, axis int, lo
 	wg.Wait()
 }
 
+func stridedMaxF32(xs []float32, start, stride, n int) float32 {
+	max := xs[start]
+	for j := 1; j < n; j++ {
+		if v := xs[start+j*stride]; v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+func logSumExpF32(xs []float32, start, stride, n int) (max, lse float32) {
+	max = stridedMaxF32(xs, start, stride, n)
+	for j := 0; j < n; j++ {
+		lse += math32.Exp(xs[start+j*stride] - max)
+	}
+	return max, math32.Log(lse)
+}
+
 func (e StdEng) softMaxLastDimF32(output Tensor, x Tensor, axis int, logSoftMax bool) {
 	outputArr := getFloat32s(output)
 	xArr := getFloat32s(x)
@@ -448,14 +499,7 @@ func (e StdEng) softMaxLastDimF32(output Tensor, x Tensor, axis int, logSoftMax
 	for ii := 0; ii < outerSize; ii++ {
 		wg.Add(1)
 		go func(ii int, wg *sync.WaitGroup) {
-			maxInput := xArr[0]
-			for j := 1; j < dimSize; j++ {
-				i := ii*dimSize + j
-
-				if xArr[i] > maxInput {
-					maxInput = xArr[i]
-				}
-			}
+			maxInput := stridedMaxF32(xArr, ii*dimSize, 1, dimSize)
 
 			sumExp := float32(0.0)
 			for j := 0; j < dimSize; j++ {
@@ -581,14 +625,7 @@ func (e StdEng) softMaxInnerDimF32(output Tensor, x Tensor, axis int, logSoftmax
 			inputPart := xArr[outerIndex*outerStride+innerIndex:]
 			outputPart := outputArr[outerIndex*outerStride+innerIndex:]
 
-			maxInput := inputPart[0]
-			for j := 1; j < dimSize; j++ {
-				i := j * dimStride
-
-				if inputPart[i] > maxInput {
-					maxInput = inputPart[i]
-				}
-			}
+			maxInput := stridedMaxF32(inputPart, 0, dimStride, dimSize)
 
 			sumExp := float32(0.0)
 			for j := 0; j < dimSize; j++ {
@@ -17,6 +17,9 @@ func (e StdEng) Neg(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Neg")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -85,6 +88,9 @@ func (e StdEng) Inv(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Inv")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -153,6 +159,9 @@ func (e StdEng) Square(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Square")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -221,6 +230,9 @@ func (e StdEng) Cube(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Cube")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -289,6 +301,9 @@ func (e StdEng) Exp(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Exp")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -357,6 +372,9 @@ func (e StdEng) Tanh(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Tanh")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -425,6 +443,9 @@ func (e StdEng) Log(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Log")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -493,6 +514,9 @@ func (e StdEng) Log2(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Log2")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -561,6 +585,9 @@ func (e StdEng) Log10(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Log10")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -629,6 +656,9 @@ func (e StdEng) Sqrt(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Sqrt")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -697,6 +727,9 @@ func (e StdEng) Cbrt(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Cbrt")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -765,6 +798,9 @@ func (e StdEng) InvSqrt(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.InvSqrt")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -822,6 +858,148 @@ func (e StdEng) InvSqrt(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	}
 	return
 
+}
+func (e StdEng) Not(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(a, boolTypes); err != nil {
+		err = errors.Wrapf(err, "Not failed")
+		return
+	}
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Not")
+	}
+
+	typ := a.Dtype().Type
+	var ait, rit Iterator
+	var dataA, dataReuse *storage.Header
+	var useIter bool
+
+	if dataA, dataReuse, ait, rit, useIter, err = prepDataUnary(a, reuse); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Not")
+	}
+
+	if useIter {
+		switch {
+		case incr:
+			cloned := a.Clone().(Tensor)
+			if err = e.E.NotIter(typ, cloned.hdr(), ait); err != nil {
+				return nil, errors.Wrap(err, "Unable to perform Not")
+			}
+			ait.Reset()
+			err = e.E.AddIter(typ, dataReuse, cloned.hdr(), rit, ait)
+			retVal = reuse
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, rit, ait)
+			rit.Reset()
+			err = e.E.NotIter(typ, dataReuse, rit)
+			retVal = reuse
+		case !safe:
+			err = e.E.NotIter(typ, dataA, ait)
+			retVal = a
+		default: // safe by default
+			cloned := a.Clone().(Tensor)
+			err = e.E.NotIter(typ, cloned.hdr(), ait)
+			retVal = cloned
+		}
+		return
+	}
+	switch {
+	case incr:
+		cloned := a.Clone().(Tensor)
+		if err = e.E.Not(typ, cloned.hdr()); err != nil {
+			return nil, errors.Wrap(err, "Unable to perform Not")
+		}
+		err = e.E.Add(typ, dataReuse, cloned.hdr())
+		retVal = reuse
+	case toReuse:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.Not(typ, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.Not(typ, dataA)
+		retVal = a
+	default: // safe by default
+		cloned := a.Clone().(Tensor)
+		err = e.E.Not(typ, cloned.hdr())
+		retVal = cloned
+	}
+	return
+
+}
+func (e StdEng) BitNot(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
+	if err = unaryCheck(a, integerTypes); err != nil {
+		err = errors.Wrapf(err, "BitNot failed")
+		return
+	}
+	var reuse DenseTensor
+	var safe, toReuse, incr bool
+	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
+		return nil, errors.Wrap(err, "Unable to handle funcOpts")
+	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.BitNot")
+	}
+
+	typ := a.Dtype().Type
+	var ait, rit Iterator
+	var dataA, dataReuse *storage.Header
+	var useIter bool
+
+	if dataA, dataReuse, ait, rit, useIter, err = prepDataUnary(a, reuse); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.BitNot")
+	}
+
+	if useIter {
+		switch {
+		case incr:
+			cloned := a.Clone().(Tensor)
+			if err = e.E.BitNotIter(typ, cloned.hdr(), ait); err != nil {
+				return nil, errors.Wrap(err, "Unable to perform BitNot")
+			}
+			ait.Reset()
+			err = e.E.AddIter(typ, dataReuse, cloned.hdr(), rit, ait)
+			retVal = reuse
+		case toReuse:
+			storage.CopyIter(typ, dataReuse, dataA, rit, ait)
+			rit.Reset()
+			err = e.E.BitNotIter(typ, dataReuse, rit)
+			retVal = reuse
+		case !safe:
+			err = e.E.BitNotIter(typ, dataA, ait)
+			retVal = a
+		default: // safe by default
+			cloned := a.Clone().(Tensor)
+			err = e.E.BitNotIter(typ, cloned.hdr(), ait)
+			retVal = cloned
+		}
+		return
+	}
+	switch {
+	case incr:
+		cloned := a.Clone().(Tensor)
+		if err = e.E.BitNot(typ, cloned.hdr()); err != nil {
+			return nil, errors.Wrap(err, "Unable to perform BitNot")
+		}
+		err = e.E.Add(typ, dataReuse, cloned.hdr())
+		retVal = reuse
+	case toReuse:
+		storage.Copy(typ, dataReuse, dataA)
+		err = e.E.BitNot(typ, dataReuse)
+		retVal = reuse
+	case !safe:
+		err = e.E.BitNot(typ, dataA)
+		retVal = a
+	default: // safe by default
+		cloned := a.Clone().(Tensor)
+		err = e.E.BitNot(typ, cloned.hdr())
+		retVal = cloned
+	}
+	return
+
 }
 func (e StdEng) Abs(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if err = unaryCheck(a, signedTypes); err != nil {
@@ -833,6 +1011,9 @@ func (e StdEng) Abs(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Abs")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -901,6 +1082,9 @@ func (e StdEng) Sign(a Tensor, opts ...FuncOpt) (retVal Tensor, err error) {
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.Sign")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
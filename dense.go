@@ -196,15 +196,26 @@ func (t *Dense) IsManuallyManaged() bool { return t.flag.manuallyManaged() }
 // IsNativelyAccessible checks if the pointers are accessible by Go
 func (t *Dense) IsNativelyAccessible() bool { return t.flag.nativelyAccessible() }
 
+// IsReadOnly returns true if the *Dense cannot be written to, as is the case with broadcast views and conjugate transposes
+func (t *Dense) IsReadOnly() bool { return t.flag.readOnly() }
+
+// IsConjugated returns true if the values of the *Dense are the complex conjugates of the values in its memory, as is the case with H()
+func (t *Dense) IsConjugated() bool { return t.flag.conjugated() }
+
 // Clone clones a *Dense. It creates a copy of the data, and the underlying array will be allocated
 func (t *Dense) Clone() interface{} {
+	// read-only views (broadcasts and conjugate transposes) don't hold one value per element, so they're cloned by materializing them
+	if t.IsReadOnly() && t.IsMaterializable() {
+		return t.Materialize()
+	}
 	if t.e != nil {
 		retVal := new(Dense)
 		t.AP.CloneTo(&retVal.AP)
 		retVal.t = t.t
 		retVal.e = t.e
 		retVal.oe = t.oe
-		retVal.flag = t.flag
+		// the copy owns its data, so it may be written to, and the values of a conjugated view are conjugated in it
+		retVal.flag = t.flag &^ (ReadOnly | Conjugated)
 		retVal.makeArray(t.Len())
 
 		if !t.old.IsZero() {
@@ -212,6 +223,9 @@ func (t *Dense) Clone() interface{} {
 			t.old.CloneTo(&retVal.old)
 		}
 		copyDense(retVal, t)
+		if t.IsConjugated() {
+			conjInPlace(retVal)
+		}
 		retVal.lock()
 
 		return retVal
@@ -568,11 +582,22 @@ func (t *Dense) MaskFromSlice(x interface{}) {
 	}
 }
 
+// Set sets the value of the underlying array at the index i. It panics if t is read-only.
+func (t *Dense) Set(i int, x interface{}) {
+	if t.IsReadOnly() {
+		panic(errors.Errorf(readOnlyData, t))
+	}
+	t.array.Set(i, x)
+}
+
 // Memset sets all the values in the *Dense tensor.
 func (t *Dense) Memset(x interface{}) error {
 	if !t.IsNativelyAccessible() {
 		return errors.Errorf(inaccessibleData, t)
 	}
+	if t.IsReadOnly() {
+		return errors.Errorf(readOnlyData, t)
+	}
 	if t.IsMaterializable() {
 		it := newFlatIterator(&t.AP)
 		return t.array.memsetIter(x, it)
@@ -596,6 +621,9 @@ func (t *Dense) Eq(other interface{}) bool {
 }
 
 func (t *Dense) Zero() {
+	if t.IsReadOnly() {
+		panic(errors.Errorf(readOnlyData, t))
+	}
 	if t.IsMaterializable() {
 		it := newFlatIterator(&t.AP)
 		if err := t.zeroIter(it); err != nil {
@@ -623,7 +651,8 @@ func (t *Dense) slice(start, end int) {
 
 // RequiresIterator indicates if an iterator is required to read the data in *Dense in the correct fashion
 func (t *Dense) RequiresIterator() bool {
-	if t.len() == 1 {
+	// a single element that is not broadcast
+	if t.len() == 1 && t.Shape().TotalSize() <= 1 {
 		return false
 	}
 	// non continuous slice, transpose, or masked. If it's a slice and contiguous, then iterator is not required
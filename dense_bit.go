@@ -0,0 +1,429 @@
+// Code generated by genlib2. DO NOT EDIT.
+
+package tensor
+
+import "github.com/pkg/errors"
+
+// And performs t ∧ other elementwise. Both t and other must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) And(other *Dense, opts ...FuncOpt) (retVal *Dense, err error) {
+
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.And(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do And()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "And")
+		}
+		return
+	}
+
+	if ander, ok := t.e.(Ander); ok {
+		if ret, err = ander.And(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do And()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "And")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support And()")
+}
+
+// Or performs t ∨ other elementwise. Both t and other must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) Or(other *Dense, opts ...FuncOpt) (retVal *Dense, err error) {
+
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.Or(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do Or()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Or")
+		}
+		return
+	}
+
+	if orer, ok := t.e.(Orer); ok {
+		if ret, err = orer.Or(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do Or()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Or")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support Or()")
+}
+
+// Xor performs t ⊕ other elementwise. Both t and other must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) Xor(other *Dense, opts ...FuncOpt) (retVal *Dense, err error) {
+
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.Xor(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do Xor()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Xor")
+		}
+		return
+	}
+
+	if xorer, ok := t.e.(Xorer); ok {
+		if ret, err = xorer.Xor(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do Xor()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Xor")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support Xor()")
+}
+
+// BitAnd performs t & other elementwise. Both t and other must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) BitAnd(other *Dense, opts ...FuncOpt) (retVal *Dense, err error) {
+
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.BitAnd(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitAnd()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitAnd")
+		}
+		return
+	}
+
+	if bitander, ok := t.e.(BitAnder); ok {
+		if ret, err = bitander.BitAnd(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitAnd()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitAnd")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support BitAnd()")
+}
+
+// BitOr performs t | other elementwise. Both t and other must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) BitOr(other *Dense, opts ...FuncOpt) (retVal *Dense, err error) {
+
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.BitOr(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitOr()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitOr")
+		}
+		return
+	}
+
+	if bitorer, ok := t.e.(BitOrer); ok {
+		if ret, err = bitorer.BitOr(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitOr()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitOr")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support BitOr()")
+}
+
+// BitXor performs t ^ other elementwise. Both t and other must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) BitXor(other *Dense, opts ...FuncOpt) (retVal *Dense, err error) {
+
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.BitXor(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitXor()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitXor")
+		}
+		return
+	}
+
+	if bitxorer, ok := t.e.(BitXorer); ok {
+		if ret, err = bitxorer.BitXor(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitXor()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitXor")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support BitXor()")
+}
+
+// Shl performs t << other elementwise. Both t and other must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) Shl(other *Dense, opts ...FuncOpt) (retVal *Dense, err error) {
+
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.Shl(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do Shl()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Shl")
+		}
+		return
+	}
+
+	if shler, ok := t.e.(Shler); ok {
+		if ret, err = shler.Shl(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do Shl()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Shl")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support Shl()")
+}
+
+// Shr performs t >> other elementwise. Both t and other must have the same shape.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) Shr(other *Dense, opts ...FuncOpt) (retVal *Dense, err error) {
+
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.Shr(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do Shr()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Shr")
+		}
+		return
+	}
+
+	if shrer, ok := t.e.(Shrer); ok {
+		if ret, err = shrer.Shr(t, other, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do Shr()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Shr")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support Shr()")
+}
+
+// AndScalar performs t ∧ other elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in other.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) AndScalar(other interface{}, leftTensor bool, opts ...FuncOpt) (retVal *Dense, err error) {
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.AndScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do AndScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "AndScalar")
+		}
+		return
+	}
+
+	if ander, ok := t.e.(Ander); ok {
+		if ret, err = ander.AndScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do AndScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "AndScalar")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support AndScalar()")
+}
+
+// OrScalar performs t ∨ other elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in other.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) OrScalar(other interface{}, leftTensor bool, opts ...FuncOpt) (retVal *Dense, err error) {
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.OrScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do OrScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "OrScalar")
+		}
+		return
+	}
+
+	if orer, ok := t.e.(Orer); ok {
+		if ret, err = orer.OrScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do OrScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "OrScalar")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support OrScalar()")
+}
+
+// XorScalar performs t ⊕ other elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in other.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) XorScalar(other interface{}, leftTensor bool, opts ...FuncOpt) (retVal *Dense, err error) {
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.XorScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do XorScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "XorScalar")
+		}
+		return
+	}
+
+	if xorer, ok := t.e.(Xorer); ok {
+		if ret, err = xorer.XorScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do XorScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "XorScalar")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support XorScalar()")
+}
+
+// BitAndScalar performs t & other elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in other.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) BitAndScalar(other interface{}, leftTensor bool, opts ...FuncOpt) (retVal *Dense, err error) {
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.BitAndScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitAndScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitAndScalar")
+		}
+		return
+	}
+
+	if bitander, ok := t.e.(BitAnder); ok {
+		if ret, err = bitander.BitAndScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitAndScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitAndScalar")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support BitAndScalar()")
+}
+
+// BitOrScalar performs t | other elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in other.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) BitOrScalar(other interface{}, leftTensor bool, opts ...FuncOpt) (retVal *Dense, err error) {
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.BitOrScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitOrScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitOrScalar")
+		}
+		return
+	}
+
+	if bitorer, ok := t.e.(BitOrer); ok {
+		if ret, err = bitorer.BitOrScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitOrScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitOrScalar")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support BitOrScalar()")
+}
+
+// BitXorScalar performs t ^ other elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in other.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) BitXorScalar(other interface{}, leftTensor bool, opts ...FuncOpt) (retVal *Dense, err error) {
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.BitXorScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitXorScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitXorScalar")
+		}
+		return
+	}
+
+	if bitxorer, ok := t.e.(BitXorer); ok {
+		if ret, err = bitxorer.BitXorScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do BitXorScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "BitXorScalar")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support BitXorScalar()")
+}
+
+// ShlScalar performs t << other elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in other.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) ShlScalar(other interface{}, leftTensor bool, opts ...FuncOpt) (retVal *Dense, err error) {
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.ShlScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do ShlScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "ShlScalar")
+		}
+		return
+	}
+
+	if shler, ok := t.e.(Shler); ok {
+		if ret, err = shler.ShlScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do ShlScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "ShlScalar")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support ShlScalar()")
+}
+
+// ShrScalar performs t >> other elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in other.
+// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)
+func (t *Dense) ShrScalar(other interface{}, leftTensor bool, opts ...FuncOpt) (retVal *Dense, err error) {
+	var ret Tensor
+	if t.oe != nil {
+		if ret, err = t.oe.ShrScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do ShrScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "ShrScalar")
+		}
+		return
+	}
+
+	if shrer, ok := t.e.(Shrer); ok {
+		if ret, err = shrer.ShrScalar(t, other, leftTensor, opts...); err != nil {
+			return nil, errors.Wrapf(err, "Unable to do ShrScalar()")
+		}
+		if retVal, err = assertDense(ret); err != nil {
+			return nil, errors.Wrapf(err, opFail, "ShrScalar")
+		}
+		return
+	}
+	return nil, errors.Errorf("Engine does not support ShrScalar()")
+}
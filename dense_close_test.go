@@ -0,0 +1,105 @@
+package tensor
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsClose(t *testing.T) {
+	assert := assert.New(t)
+	nan, inf := math.NaN(), math.Inf(1)
+
+	// numpy.isclose([1, 1e10, 1e-8, nan, inf, inf, 0], [1.00001, 1.00001e10, 1e-9, nan, inf, -inf, 1e-9])
+	a := New(WithShape(7), WithBacking([]float64{1, 1e10, 1e-8, nan, inf, inf, 0}))
+	b := New(WithShape(7), WithBacking([]float64{1.00001, 1.00001e10, 1e-9, nan, inf, -inf, 1e-9}))
+	r, err := IsClose(a, b, 1e-5, 1e-8, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, true, true, false, true, false, true}, r.Data())
+	r, err = IsClose(a, b, 1e-5, 1e-8, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, true, true, true, true, false, true}, r.Data())
+	r, err = IsClose(a, b, 0, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{false, false, false, false, true, false, false}, r.Data())
+
+	// complex numbers are compared by the modulus of their difference
+	c := New(WithShape(3), WithBacking([]complex64{1 + 1i, 1i, complex(float32(nan), 0)}))
+	d := New(WithShape(3), WithBacking([]complex64{1 + 1.1i, 0, complex(float32(nan), 1)}))
+	r, err = IsClose(c, d, 0, 0.15, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, false, true}, r.Data())
+
+	// views
+	m := New(WithShape(2, 2), WithBacking([]float32{1, 2, 3, 4}))
+	col, err := m.Slice(nil, S(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = IsClose(col, New(WithShape(2), WithBacking([]float32{2.01, 4})), 0, 0.001, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{false, true}, r.Data())
+
+	_, err = IsClose(a, New(WithShape(6), Of(Float64)), 0, 0, false)
+	assert.NotNil(err)
+	_, err = IsClose(a, New(WithShape(7), Of(Float32)), 0, 0, false)
+	assert.NotNil(err)
+	_, err = IsClose(New(WithShape(2), Of(Int)), New(WithShape(2), Of(Int)), 0, 0, false)
+	assert.NotNil(err)
+	_, err = IsClose(a, b, -1, 0, false)
+	assert.NotNil(err)
+}
+
+func TestAllClose(t *testing.T) {
+	assert := assert.New(t)
+	a := New(WithShape(2, 3), WithBacking([]float64{1, 2, 3, 4, 5, 6}))
+	b := New(WithShape(2, 3), WithBacking([]float64{1, 2.5, 3, 4, 7, 6}))
+
+	ok, err := AllClose(a, a.Clone().(*Dense), 0, 0, false)
+	assert.True(ok)
+	assert.Nil(err)
+
+	ok, err = AllClose(a, b, 0, 0.1, false)
+	assert.False(ok)
+	if assert.NotNil(err) {
+		assert.Equal("2 of 6 values are not close. The worst is at [1 1]: 5 and 7 are 2 apart, with a tolerance of 0.1", err.Error())
+		me, isMathErr := err.(MathError)
+		assert.True(isMathErr)
+		assert.Equal([]int{1, 4}, me.Indices())
+	}
+	ok, err = AllClose(a, b, 0.5, 0, false)
+	assert.True(ok)
+	assert.Nil(err)
+
+	// a mismatch that can't be measured is the worst
+	c := New(WithShape(3), WithBacking([]complex128{1, cmplx.NaN(), 10}))
+	d := New(WithShape(3), WithBacking([]complex128{2, 1, 1}))
+	ok, err = AllClose(c, d, 0, 0, false)
+	assert.False(ok)
+	if me, isMathErr := err.(MathError); assert.True(isMathErr) {
+		assert.Equal([]int{0, 1, 2}, me.Indices())
+		assert.Contains(err.Error(), "at [1]")
+	}
+
+	// scalars
+	ok, err = AllClose(New(FromScalar(1.0)), New(FromScalar(1.0+1e-12)), 1e-9, 0, false)
+	assert.True(ok)
+	assert.Nil(err)
+
+	ok, err = AllClose(a, New(WithShape(3, 2), Of(Float64)), 0, 0, false)
+	assert.False(ok)
+	_, isMathErr := err.(MathError)
+	assert.False(isMathErr)
+}
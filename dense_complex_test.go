@@ -0,0 +1,256 @@
+package tensor
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplexParts(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 2), WithBacking([]complex128{3 + 4i, -1, 1i, 1 - 1i}))
+
+	re, err := Real(T)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Float64, re.Dtype())
+	assert.Equal(Shape{2, 2}, re.Shape())
+	assert.Equal([]float64{3, -1, 0, 1}, re.Data())
+
+	im, err := Imag(T)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{4, 0, 1, -1}, im.Data())
+
+	abs, err := Magnitude(T)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(allClose([]float64{5, 1, 1, math.Sqrt2}, abs.Data()), "%v", abs.Data())
+
+	angle, err := Angle(T)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(allClose([]float64{math.Atan2(4, 3), math.Pi, math.Pi / 2, -math.Pi / 4}, angle.Data()), "%v", angle.Data())
+
+	conj, err := Conj(T)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]complex128{3 - 4i, -1, -1i, 1 + 1i}, conj.Data())
+
+	back, err := Complex(re, im)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(T.Data(), back.Data())
+
+	// complex64 gives float32, and masks are kept
+	T64 := New(WithShape(3), WithBacking([]complex64{1 + 2i, 3, 4i}, []bool{false, true, false}))
+	re, err = Real(T64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{1, 3, 0}, re.Data())
+	assert.Equal([]bool{false, true, false}, re.(*Dense).Mask())
+
+	im = New(WithShape(3), WithBacking([]float32{2, 0, 4}, []bool{true, false, false}))
+	c, err := Complex(re, im)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Complex64, c.Dtype())
+	assert.Equal([]complex64{1 + 2i, 3, 4i}, c.Data())
+	assert.Equal([]bool{true, true, false}, c.(*Dense).Mask())
+
+	_, err = Real(New(WithShape(2), Of(Float64)))
+	assert.NotNil(err)
+	_, err = Conj(New(WithShape(2), Of(Float64)))
+	assert.NotNil(err)
+	_, err = Complex(New(WithShape(2), Of(Float64)), New(WithShape(2), Of(Float32)))
+	assert.NotNil(err)
+	_, err = Complex(New(WithShape(2), Of(Float64)), New(WithShape(3), Of(Float64)))
+	assert.NotNil(err)
+	_, err = Complex(New(WithShape(2), Of(Int)), New(WithShape(2), Of(Int)))
+	assert.NotNil(err)
+}
+
+func TestDense_H(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking([]complex128{1 + 1i, 2, 3 - 2i, 4i, 5 + 5i, 6}))
+	H, err := T.H()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, H.Shape())
+	assert.True(H.IsConjugated())
+	assert.True(H.IsReadOnly())
+
+	v, err := H.At(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3+2i, v)
+
+	correct := []complex128{1 - 1i, -4i, 2, 5 - 5i, 3 + 2i, 6}
+	assert.Equal(correct, H.Materialize().Data())
+
+	// the data of the view is not modified, and its values cannot be read as they are in memory
+	assert.NotNil(H.SetAt(1+0i, 0, 0))
+	assert.NotNil(H.Transpose())
+	_, err = H.Add(H)
+	assert.NotNil(err)
+	_, err = H.MulScalar(2+0i, true)
+	assert.NotNil(err)
+
+	// the other ops read H's values
+	re, err := Real(H)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{1, 0, 2, 5, 3, 6}, re.Data())
+	im, err := Imag(H)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{-1, -4, 0, -5, 2, 0}, im.Data())
+	HT := New(WithShape(3, 2), WithBacking(correct))
+	cat, err := Concat(0, H, HT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(append(append([]complex128{}, correct...), correct...), cat.Data())
+	stacked, err := Stack(0, HT, H)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(cat.Data(), stacked.Data())
+	rows, err := Index(H, []int{0, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]complex128{1 - 1i, -4i, 3 + 2i, 6}, rows.Data())
+
+	// printing conjugates the values, also those of a slice of the view
+	assert.Equal("⎡(1-1i)  (0-4i)⎤\n⎢(2-0i)  (5-5i)⎥\n⎣(3+2i)  (6-0i)⎦\n", fmt.Sprintf("%v", H))
+	row, err := H.Slice(S(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("[(1-1i)  (0-4i)]", fmt.Sprintf("%v", row))
+	col, err := H.Slice(nil, S(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("[(1-1i)  (2-0i)  (3+2i)]", fmt.Sprintf("%v", col))
+
+	// for real matrices, H is a transpose
+	R := New(WithShape(2, 3), WithBacking([]float64{1, 2, 3, 4, 5, 6}))
+	RH, err := R.H()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(RH.IsConjugated())
+	assert.Equal([]float64{1, 4, 2, 5, 3, 6}, RH.Materialize().Data())
+
+	_, err = New(WithShape(2), Of(Complex128)).H()
+	assert.NotNil(err)
+}
+
+func TestDense_H_copies(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking([]complex128{1 + 1i, 2, 3 - 2i, 4i, 5 + 5i, 6}))
+	H, err := T.H()
+	if err != nil {
+		t.Fatal(err)
+	}
+	correct := []complex128{1 - 1i, -4i, 2, 5 - 5i, 3 + 2i, 6}
+
+	// Copy writes the conjugated values
+	dst := New(WithShape(3, 2), Of(Complex128))
+	if err = Copy(dst, H); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(correct, dst.Data())
+
+	// SafeT of the conjugate transpose is the conjugate of T
+	ST, err := H.SafeT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, ST.Shape())
+	assert.False(ST.IsConjugated())
+	assert.Equal([]complex128{1 - 1i, 2, 3 + 2i, -4i, 5 - 5i, 6}, ST.Materialize().Data())
+
+	// a clone owns its values, which are conjugated, and may be written to
+	C := H.Clone().(*Dense)
+	assert.False(C.IsConjugated())
+	assert.False(C.IsReadOnly())
+	assert.Equal(correct, C.Materialize().Data())
+	v, err := C.At(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3+2i, v)
+	assert.Nil(C.SetAt(7+0i, 0, 0))
+	assert.Equal(1+1i, T.Complex128s()[0])
+}
+
+func TestDense_H_MatMul(t *testing.T) {
+	assert := assert.New(t)
+	A := New(WithShape(2, 3), WithBacking([]complex128{1 + 1i, 2, 3 - 2i, 4i, 5 + 5i, 6}))
+	B := New(WithShape(2, 2), WithBacking([]complex128{1, 1i, -1i, 2 + 1i}))
+
+	AH, err := A.H()
+	if err != nil {
+		t.Fatal(err)
+	}
+	materialized := AH.Materialize().(*Dense)
+
+	// (3, 2) × (2, 2), with the conjugate transpose on the left
+	got, err := AH.MatMul(B)
+	if err != nil {
+		t.Fatal(err)
+	}
+	correct, err := materialized.MatMul(B)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(correct.Data(), got.Data())
+
+	// (2, 3) × (3, 2), with the conjugate transpose on the right
+	got, err = A.MatMul(AH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	correct, err = A.MatMul(materialized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(correct.Data(), got.Data())
+	// A Aᴴ is Hermitian, with real values on its diagonal
+	assert.Equal(0.0, imag(got.Complex128s()[0]))
+	assert.Equal(0.0, imag(got.Complex128s()[3]))
+
+	// complex64, matrix × vector
+	A64 := New(WithShape(2, 2), WithBacking([]complex64{1 + 1i, 2, 3i, 4 - 1i}))
+	AH64, err := A64.H()
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := New(WithShape(2), WithBacking([]complex64{1, 1i}))
+	gotv, err := AH64.MatVecMul(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	correctv, err := AH64.Materialize().(*Dense).MatVecMul(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(correctv.Data(), gotv.Data())
+}
@@ -0,0 +1,291 @@
+package tensor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// naiveConv2D is a direct (loop based) convolution of NCHW float64 data, used as a reference.
+func naiveConv2D(x, f []float64, xs, fs Shape, w Window2D) (out []float64, os Shape) {
+	w = w.norm()
+	n, c, h, wd := xs[0], xs[1], xs[2], xs[3]
+	oc, kh, kw := fs[0], fs[2], fs[3]
+	oh := (h+2*w.PadH-w.DilationH*(kh-1)-1)/w.StrideH + 1
+	ow := (wd+2*w.PadW-w.DilationW*(kw-1)-1)/w.StrideW + 1
+	os = Shape{n, oc, oh, ow}
+	out = make([]float64, os.TotalSize())
+	for b := 0; b < n; b++ {
+		for o := 0; o < oc; o++ {
+			for oy := 0; oy < oh; oy++ {
+				for ox := 0; ox < ow; ox++ {
+					var sum float64
+					for ch := 0; ch < c; ch++ {
+						for i := 0; i < kh; i++ {
+							for j := 0; j < kw; j++ {
+								y := oy*w.StrideH - w.PadH + i*w.DilationH
+								x0 := ox*w.StrideW - w.PadW + j*w.DilationW
+								if y < 0 || y >= h || x0 < 0 || x0 >= wd {
+									continue
+								}
+								sum += x[((b*c+ch)*h+y)*wd+x0] * f[((o*c+ch)*kh+i)*kw+j]
+							}
+						}
+					}
+					out[((b*oc+o)*oh+oy)*ow+ox] = sum
+				}
+			}
+		}
+	}
+	return
+}
+
+func TestIm2Col(t *testing.T) {
+	assert := assert.New(t)
+	im := New(WithShape(1, 1, 3, 3), WithBacking([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9}))
+
+	col, err := Im2Col(im, Window2D{KernelH: 2, KernelW: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 4, 4}, col.Shape())
+	assert.Equal([]float64{
+		1, 2, 4, 5,
+		2, 3, 5, 6,
+		4, 5, 7, 8,
+		5, 6, 8, 9,
+	}, col.Data())
+
+	// padding and NHWC
+	im = New(WithShape(1, 2, 2, 1), WithBacking([]float32{1, 2, 3, 4}))
+	col, err = Im2Col(im, Window2D{KernelH: 2, KernelW: 2, StrideH: 2, StrideW: 2, PadH: 1, PadW: 1, Layout: NHWC})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 4, 4}, col.Shape())
+	assert.Equal([]float32{
+		0, 0, 0, 1,
+		0, 0, 2, 0,
+		0, 3, 0, 0,
+		4, 0, 0, 0,
+	}, col.Data())
+
+	// window too large
+	_, err = Im2Col(New(WithShape(1, 1, 2, 2), Of(Float64)), Window2D{KernelH: 3, KernelW: 3})
+	assert.NotNil(err)
+	_, err = Im2Col(New(WithShape(1, 1, 2, 2), Of(Float64)), Window2D{KernelH: 3, KernelW: 3, StrideH: 2, StrideW: 2})
+	assert.NotNil(err)
+
+	// not floats
+	_, err = Im2Col(New(WithShape(1, 1, 2, 2), Of(Int)), Window2D{KernelH: 1, KernelW: 1})
+	assert.NotNil(err)
+}
+
+// Col2Im is the adjoint of Im2Col: <Im2Col(x), y> == <x, Col2Im(y)>
+func TestCol2Im(t *testing.T) {
+	windows := []Window2D{
+		{KernelH: 3, KernelW: 2},
+		{KernelH: 3, KernelW: 3, StrideH: 2, StrideW: 2, PadH: 1, PadW: 1},
+		{KernelH: 2, KernelW: 2, DilationH: 2, DilationW: 2, PadH: 1, Layout: NHWC},
+	}
+	for i, w := range windows {
+		xShape := Shape{2, 3, 5, 6}
+		if w.Layout == NHWC {
+			xShape = Shape{2, 5, 6, 3}
+		}
+		x := New(WithShape(xShape...), WithBacking(Random(Float64, xShape.TotalSize())))
+		col, err := Im2Col(x, w)
+		if err != nil {
+			t.Errorf("Test %d: %v", i, err)
+			continue
+		}
+		y := New(WithShape(col.Shape().Clone()...), WithBacking(Random(Float64, col.Shape().TotalSize())))
+		im, err := Col2Im(y, xShape, w)
+		if err != nil {
+			t.Errorf("Test %d: %v", i, err)
+			continue
+		}
+		assert.Equal(t, xShape, im.Shape())
+
+		var lhs, rhs float64
+		for j, v := range col.Data().([]float64) {
+			lhs += v * y.Float64s()[j]
+		}
+		for j, v := range im.Data().([]float64) {
+			rhs += v * x.Float64s()[j]
+		}
+		assert.InDelta(t, lhs, rhs, 1e-9, "Test %d", i)
+	}
+}
+
+var conv2DTests = []Window2D{
+	{},
+	{PadH: 1, PadW: 1},
+	{StrideH: 2, StrideW: 1, PadH: 1},
+	{DilationH: 2, DilationW: 2, PadW: 2},
+}
+
+func TestConv2D(t *testing.T) {
+	xs := Shape{2, 3, 6, 5}
+	fs := Shape{4, 3, 3, 2}
+	xBack := Random(Float64, xs.TotalSize()).([]float64)
+	fBack := Random(Float64, fs.TotalSize()).([]float64)
+
+	for i, w := range conv2DTests {
+		t.Run(fmt.Sprintf("%+v", w), func(t *testing.T) {
+			expected, expShape := naiveConv2D(xBack, fBack, xs, fs, w)
+
+			x := New(WithShape(xs...), WithBacking(xBack))
+			f := New(WithShape(fs...), WithBacking(fBack))
+			out, err := Conv2D(x, f, w)
+			if err != nil {
+				t.Fatalf("Test %d: %v", i, err)
+			}
+			assert.Equal(t, expShape, out.Shape())
+			assert.InDeltaSlice(t, expected, out.Data(), 1e-9)
+
+			// float32
+			x32 := New(WithShape(xs...), Of(Float32))
+			f32 := New(WithShape(fs...), Of(Float32))
+			for j, v := range xBack {
+				x32.Float32s()[j] = float32(v)
+			}
+			for j, v := range fBack {
+				f32.Float32s()[j] = float32(v)
+			}
+			out32, err := Conv2D(x32, f32, w)
+			if err != nil {
+				t.Fatalf("Test %d: %v", i, err)
+			}
+			assert.InDeltaSlice(t, expected, out32.Data(), 1e-4)
+
+			// NHWC should give the same results as NCHW, transposed
+			xT, _ := Transpose(x, 0, 2, 3, 1)
+			fT, _ := Transpose(f, 0, 2, 3, 1)
+			w.Layout = NHWC
+			outT, err := Conv2D(xT, fT, w)
+			if err != nil {
+				t.Fatalf("Test %d: %v", i, err)
+			}
+			back, _ := Transpose(outT, 0, 3, 1, 2)
+			assert.Equal(t, expShape, back.Shape())
+			assert.InDeltaSlice(t, expected, back.Data(), 1e-9)
+		})
+	}
+
+	// channel mismatch
+	_, err := Conv2D(New(WithShape(1, 2, 4, 4), Of(Float64)), New(WithShape(1, 3, 2, 2), Of(Float64)), Window2D{})
+	assert.NotNil(t, err)
+
+	// kernel mismatch
+	_, err = Conv2D(New(WithShape(1, 2, 4, 4), Of(Float64)), New(WithShape(1, 2, 2, 2), Of(Float64)), Window2D{KernelH: 3})
+	assert.NotNil(t, err)
+
+	// dtype mismatch
+	_, err = Conv2D(New(WithShape(1, 2, 4, 4), Of(Float64)), New(WithShape(1, 2, 2, 2), Of(Float32)), Window2D{})
+	assert.NotNil(t, err)
+}
+
+// numerical gradients of sum(Conv2D(x, f) * grad)
+func TestConv2DB(t *testing.T) {
+	xs := Shape{2, 2, 5, 4}
+	fs := Shape{3, 2, 2, 3}
+	xBack := Random(Float64, xs.TotalSize()).([]float64)
+	fBack := Random(Float64, fs.TotalSize()).([]float64)
+
+	for _, w := range conv2DTests {
+		_, os := naiveConv2D(xBack, fBack, xs, fs, w)
+		grad := Random(Float64, os.TotalSize()).([]float64)
+		loss := func(x, f []float64) (retVal float64) {
+			out, _ := naiveConv2D(x, f, xs, fs, w)
+			for i := range out {
+				retVal += out[i] * grad[i]
+			}
+			return
+		}
+
+		x := New(WithShape(xs...), WithBacking(xBack))
+		f := New(WithShape(fs...), WithBacking(fBack))
+		g := New(WithShape(os...), WithBacking(grad))
+		dx, df, err := Conv2DB(x, f, g, w)
+		if err != nil {
+			t.Errorf("%+v: %v", w, err)
+			continue
+		}
+		assert.Equal(t, xs, dx.Shape())
+		assert.Equal(t, fs, df.Shape())
+
+		// the loss is linear in both x and f, so the finite differences are exact up to rounding
+		const h = 1e-3
+		for i := range xBack {
+			xp := append([]float64(nil), xBack...)
+			xp[i] += h
+			num := (loss(xp, fBack) - loss(xBack, fBack)) / h
+			assert.InDelta(t, num, dx.Data().([]float64)[i], 1e-6, "%+v: dx[%d]", w, i)
+		}
+		for i := range fBack {
+			fp := append([]float64(nil), fBack...)
+			fp[i] += h
+			num := (loss(xBack, fp) - loss(xBack, fBack)) / h
+			assert.InDelta(t, num, df.Data().([]float64)[i], 1e-6, "%+v: df[%d]", w, i)
+		}
+
+		// NHWC
+		xT, _ := Transpose(x, 0, 2, 3, 1)
+		fT, _ := Transpose(f, 0, 2, 3, 1)
+		gT, _ := Transpose(g, 0, 2, 3, 1)
+		wT := w
+		wT.Layout = NHWC
+		dxT, dfT, err := Conv2DB(xT, fT, gT, wT)
+		if err != nil {
+			t.Errorf("%+v: %v", wT, err)
+			continue
+		}
+		dxBack, _ := Transpose(dxT, 0, 3, 1, 2)
+		dfBack, _ := Transpose(dfT, 0, 3, 1, 2)
+		assert.InDeltaSlice(t, dx.Data(), dxBack.Data(), 1e-9)
+		assert.InDeltaSlice(t, df.Data(), dfBack.Data(), 1e-9)
+	}
+}
+
+func TestConv1D(t *testing.T) {
+	assert := assert.New(t)
+	// a moving sum over a window of 2
+	x := New(WithShape(1, 1, 5), WithBacking([]float64{1, 2, 3, 4, 5}))
+	f := New(WithShape(1, 1, 2), WithBacking([]float64{1, 1}))
+
+	out, err := Conv1D(x, f, Window1D{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 1, 4}, out.Shape())
+	assert.Equal([]float64{3, 5, 7, 9}, out.Data())
+	assert.Equal(Shape{1, 1, 5}, x.Shape(), "input should not be reshaped")
+
+	out, err = Conv1D(x, f, Window1D{Stride: 2, Pad: 1, Dilation: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{2, 6, 4}, out.Data())
+
+	// NHWC with two channels
+	x = New(WithShape(1, 3, 2), WithBacking([]float64{1, 10, 2, 20, 3, 30}))
+	f = New(WithShape(1, 2, 2), WithBacking([]float64{1, 0, 0, 1}))
+	out, err = Conv1D(x, f, Window1D{Layout: NHWC})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 2, 1}, out.Shape())
+	assert.Equal([]float64{21, 32}, out.Data())
+
+	g := New(WithShape(1, 2, 1), WithBacking([]float64{1, 1}))
+	dx, df, err := Conv1DB(x, f, g, Window1D{Layout: NHWC})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 3, 2}, dx.Shape())
+	assert.Equal(Shape{1, 2, 2}, df.Shape())
+	assert.Equal([]float64{1, 0, 1, 1, 0, 1}, dx.Data())
+	assert.Equal([]float64{3, 30, 5, 50}, df.Data())
+}
@@ -0,0 +1,200 @@
+package tensor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriuTril(t *testing.T) {
+	assert := assert.New(t)
+	// 1 2 3
+	// 4 5 6
+	// 7 8 9
+	T := New(WithShape(3, 3), WithBacking(Range(Float64, 1, 10)))
+
+	testCases := []struct {
+		k            int
+		triu, tril   []float64
+		triuΔ, trilΔ Triangle
+	}{
+		{0, []float64{1, 2, 3, 0, 5, 6, 0, 0, 9}, []float64{1, 0, 0, 4, 5, 0, 7, 8, 9}, Upper, Lower},
+		{1, []float64{0, 2, 3, 0, 0, 6, 0, 0, 0}, []float64{1, 2, 0, 4, 5, 6, 7, 8, 9}, Upper, NotTriangle},
+		{-1, []float64{1, 2, 3, 4, 5, 6, 0, 8, 9}, []float64{0, 0, 0, 4, 0, 0, 7, 8, 0}, NotTriangle, Lower},
+		{5, []float64{0, 0, 0, 0, 0, 0, 0, 0, 0}, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}, Upper, NotTriangle},
+		{-5, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}, []float64{0, 0, 0, 0, 0, 0, 0, 0, 0}, NotTriangle, Lower},
+	}
+	for _, tc := range testCases {
+		u, err := Triu(T, tc.k)
+		if err != nil {
+			t.Errorf("Triu %d: %v", tc.k, err)
+			continue
+		}
+		assert.Equal(tc.triu, u.Data(), "Triu %d", tc.k)
+		assert.Equal(tc.triuΔ, u.(*Dense).Δ, "Triu %d", tc.k)
+
+		l, err := Tril(T, tc.k)
+		if err != nil {
+			t.Errorf("Tril %d: %v", tc.k, err)
+			continue
+		}
+		assert.Equal(tc.tril, l.Data(), "Tril %d", tc.k)
+		assert.Equal(tc.trilΔ, l.(*Dense).Δ, "Tril %d", tc.k)
+	}
+
+	// batched, non square, from a view, of any Dtype
+	B := New(WithShape(2, 3, 2), WithBacking([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}))
+	V, err := B.Slice(nil, S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := Triu(V, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3, 2}, u.Shape())
+	assert.Equal([]string{"e", "f", "", "d", "", "", "k", "l", "", "j", "", ""}, u.Data())
+	assert.Equal(NotTriangle, u.(*Dense).Δ)
+
+	// masks follow the values
+	M := New(WithShape(2, 2), WithBacking([]float64{1, 2, 3, 4}, []bool{true, true, false, false}))
+	l, err := Tril(M, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, false, false, false}, l.(*Dense).Mask())
+
+	_, err = Triu(New(WithShape(3), Of(Float64)), 0)
+	assert.NotNil(err)
+}
+
+func TestDense_Diagonal(t *testing.T) {
+	assert := assert.New(t)
+	// 0  1  2  3
+	// 4  5  6  7
+	// 8  9 10 11
+	T := New(WithShape(3, 4), WithBacking(Range(Float64, 0, 12)))
+
+	testCases := []struct {
+		offset  int
+		correct []float64
+	}{
+		{0, []float64{0, 5, 10}},
+		{1, []float64{1, 6, 11}},
+		{2, []float64{2, 7}},
+		{-1, []float64{4, 9}},
+		{-2, []float64{8}},
+	}
+	for _, tc := range testCases {
+		D, err := T.Diagonal(tc.offset, 0, 1)
+		if err != nil {
+			t.Errorf("offset %d: %v", tc.offset, err)
+			continue
+		}
+		assert.True(D.IsView())
+		assert.Equal(tc.correct, D.Materialize().Data(), "offset %d", tc.offset)
+	}
+
+	// the axes may be swapped
+	D, err := Diagonal(T, 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{4, 9}, D.(*Dense).Materialize().Data())
+
+	// writes go through
+	D, err = Diagonal(T, 0, -2, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = D.(*Dense).Memset(-1.0); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{-1, 1, 2, 3, 4, -1, 6, 7, 8, 9, -1, 11}, T.Data())
+
+	// batches: the remaining axes come first
+	B := New(WithShape(2, 2, 3), WithBacking(Range(Int, 0, 12)))
+	D, err = Diagonal(B, 0, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, D.Shape())
+	assert.Equal([]int{0, 7, 3, 10}, D.(*Dense).Materialize().Data())
+
+	// diagonal of a reversed view
+	V, err := B.Slice(ss(0), S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	D, err = Diagonal(V, 0, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{3, 1}, D.(*Dense).Materialize().Data())
+
+	_, err = T.Diagonal(4, 0, 1)
+	assert.NotNil(err)
+	_, err = T.Diagonal(0, 1, -1)
+	assert.NotNil(err)
+	_, err = T.Diagonal(0, 0, 2)
+	assert.NotNil(err)
+}
+
+func TestDiagEmbed(t *testing.T) {
+	assert := assert.New(t)
+	v := New(WithShape(2), WithBacking([]float64{1, 2}))
+
+	D, err := DiagEmbed(v, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, D.Shape())
+	assert.Equal([]float64{1, 0, 0, 2}, D.Data())
+	assert.Equal(Symmetric, D.(*Dense).Δ)
+
+	D, err = DiagEmbed(v, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 3}, D.Shape())
+	assert.Equal([]float64{0, 1, 0, 0, 0, 2, 0, 0, 0}, D.Data())
+	assert.Equal(Upper, D.(*Dense).Δ)
+
+	D, err = DiagEmbed(v, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0, 0, 0, 1, 0, 0, 0, 2, 0}, D.Data())
+	assert.Equal(Lower, D.(*Dense).Δ)
+
+	// batched, and the inverse of Diagonal
+	B := New(WithShape(2, 3), WithBacking(Range(Int, 1, 7)))
+	D, err = DiagEmbed(B, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3, 3}, D.Shape())
+	back, err := Diagonal(D, 0, -2, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(B.Data(), back.(*Dense).Materialize().Data())
+
+	_, err = DiagEmbed(New(FromScalar(1.0)), 0)
+	assert.NotNil(err)
+}
+
+func TestEye(t *testing.T) {
+	assert := assert.New(t)
+	E := Eye(Float32, 3, 0)
+	assert.Equal([]float32{1, 0, 0, 0, 1, 0, 0, 0, 1}, E.Data())
+	assert.Equal(Symmetric, E.Δ)
+
+	E = Eye(Int, 3, 1)
+	assert.Equal([]int{0, 1, 0, 0, 0, 1, 0, 0, 0}, E.Data())
+	assert.Equal(Upper, E.Δ)
+
+	E = Eye(Int, 3, -2)
+	assert.Equal([]int{0, 0, 0, 0, 0, 0, 1, 0, 0}, E.Data())
+	assert.Equal(Lower, E.Δ)
+}
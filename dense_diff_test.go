@@ -0,0 +1,149 @@
+package tensor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	assert := assert.New(t)
+	v := New(WithShape(5), WithBacking([]int{1, 2, 4, 7, 0}))
+
+	d, err := Diff(v, 1, 0, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 2, 3, -7}, d.Data())
+	d, err = Diff(v, 2, -1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 1, -10}, d.Data())
+	d, err = Diff(v, 0, 0, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(d == v)
+
+	// numpy.diff([1, 2, 4, 7, 0], prepend=0, append=10)
+	d, err = Diff(v, 1, 0, New(FromScalar(0)), New(FromScalar(10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 1, 2, 3, -7, 10}, d.Data())
+
+	m := New(WithShape(2, 4), WithBacking([]float64{1, 3, 6, 10, 0, 5, 6, 8}))
+	d, err = Diff(m, 1, 1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, d.Shape())
+	assert.Equal([]float64{2, 3, 4, 5, 1, 2}, d.Data())
+	d, err = Diff(m, 1, 0, New(WithShape(1, 4), WithBacking([]float64{1, 1, 1, 1})), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 4}, d.Shape())
+	assert.Equal([]float64{0, 2, 5, 9, -1, 2, 0, -2}, d.Data())
+
+	// views
+	view, err := m.Slice(nil, S(1, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = Diff(view, 2, 1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 1}, d.Shape())
+	assert.Equal([]float64{1, 1}, d.Data())
+
+	// thunked transposes: numpy.diff(numpy.array([[1, 2, 4], [7, 11, 16]]).T, axis=0)
+	x := New(WithShape(2, 3), WithBacking([]float64{1, 2, 4, 7, 11, 16}))
+	if err = x.T(); err != nil {
+		t.Fatal(err)
+	}
+	d, err = Diff(x, 1, 0, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, d.Shape())
+	assert.Equal([]float64{1, 4, 2, 5}, d.Data())
+	d, err = Diff(x, 1, 1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 1}, d.Shape())
+	assert.Equal([]float64{6, 9, 12}, d.Data())
+
+	// booleans are differenced with Xor
+	b := New(WithShape(4), WithBacking([]bool{true, false, false, true}))
+	d, err = Diff(b, 1, 0, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, false, true}, d.Data())
+
+	_, err = Diff(v, 5, 0, nil, nil)
+	assert.NotNil(err)
+	_, err = Diff(v, -1, 0, nil, nil)
+	assert.NotNil(err)
+	_, err = Diff(v, 1, 1, nil, nil)
+	assert.NotNil(err)
+	_, err = Diff(v, 1, 0, New(FromScalar(0.0)), nil)
+	assert.NotNil(err)
+	_, err = Diff(New(WithShape(2), Of(String)), 1, 0, nil, nil)
+	assert.NotNil(err)
+}
+
+func TestGradient(t *testing.T) {
+	assert := assert.New(t)
+
+	// numpy.gradient([1, 2, 4, 7, 11, 16], 2.0)
+	v := New(WithShape(6), WithBacking([]float64{1, 2, 4, 7, 11, 16}))
+	g, err := Gradient(v, []float64{2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(g, 1)
+	assert.Equal([]float64{0.5, 0.75, 1.25, 1.75, 2.25, 2.5}, g[0].Data())
+
+	// numpy.gradient([[1, 2, 6], [3, 4, 5]])
+	m := New(WithShape(2, 3), WithBacking([]float64{1, 2, 6, 3, 4, 5}))
+	g, err = Gradient(m, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(g, 2)
+	assert.Equal([]float64{2, 2, -1, 2, 2, -1}, g[0].Data())
+	assert.Equal([]float64{1, 2.5, 4, 1, 1, 1}, g[1].Data())
+
+	g, err = Gradient(m, []float64{1, 2}, -1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{1, 2.5, 4, 1, 1, 1}, g[0].Data())
+	assert.Equal([]float64{1, 1, -0.5, 1, 1, -0.5}, g[1].Data())
+
+	// views, in float32
+	m32 := New(WithShape(2, 3), WithBacking([]float32{1, 2, 6, 3, 4, 5}))
+	if err = m32.T(); err != nil {
+		t.Fatal(err)
+	}
+	g, err = Gradient(m32, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, g[0].Shape())
+	assert.Equal([]float32{1, 1, 2.5, 1, 4, 1}, g[0].Data())
+
+	_, err = Gradient(New(WithShape(1, 3), Of(Float64)), nil, 0)
+	assert.NotNil(err)
+	_, err = Gradient(m, []float64{1, 2, 3})
+	assert.NotNil(err)
+	_, err = Gradient(m, nil, 2)
+	assert.NotNil(err)
+	_, err = Gradient(New(WithShape(3), Of(Int)), nil)
+	assert.NotNil(err)
+}
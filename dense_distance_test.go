@@ -0,0 +1,141 @@
+package tensor
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCdist(t *testing.T) {
+	assert := assert.New(t)
+	a := New(WithShape(2, 2), WithBacking([]float64{0, 0, 1, 1}))
+	b := New(WithShape(3, 2), WithBacking([]float64{3, 4, 1, 0, -1, 1}))
+
+	testCases := []struct {
+		metric  DistanceMetric
+		correct []float64
+	}{
+		{Euclidean(), []float64{5, 1, math.Sqrt2, math.Sqrt(13), 1, 2}},
+		{SqEuclidean(), []float64{25, 1, 2, 13, 1, 4}},
+		{Manhattan(), []float64{7, 1, 2, 5, 1, 2}},
+		{Chebyshev(), []float64{4, 1, 1, 3, 1, 2}},
+		{Minkowski(3), []float64{math.Cbrt(91), 1, math.Cbrt(2), math.Cbrt(35), 1, 2}},
+		{Minkowski(math.Inf(1)), []float64{4, 1, 1, 3, 1, 2}},
+	}
+	for _, tc := range testCases {
+		D, err := Cdist(a, b, tc.metric)
+		if err != nil {
+			t.Errorf("%v: %v", tc.metric, err)
+			continue
+		}
+		assert.Equal(Shape{2, 3}, D.Shape(), "%v", tc.metric)
+		assert.True(allClose(tc.correct, D.Data()), "%v: %v", tc.metric, D.Data())
+	}
+
+	// batched float32, with a transposed view
+	bt := New(WithShape(2, 2, 1), WithBacking([]float32{0, 1, 2, 4}))
+	at := New(WithShape(2, 1, 2), WithBacking([]float32{0, 3, 1, 5}))
+	if err := at.T(0, 2, 1); err != nil {
+		t.Fatal(err)
+	}
+	D, err := Cdist(at, bt, Manhattan())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2, 2}, D.Shape())
+	assert.Equal([]float32{0, 1, 3, 2, 1, 3, 3, 1}, D.Data())
+
+	_, err = Cdist(a, New(WithShape(2, 3), Of(Float64)), Euclidean())
+	assert.NotNil(err)
+	_, err = Cdist(a, b, Minkowski(-1))
+	assert.NotNil(err)
+	_, err = Cdist(a, New(WithShape(2, 2), Of(Float32)), Euclidean())
+	assert.NotNil(err)
+}
+
+func TestCdist_gemm(t *testing.T) {
+	// the expansion is used for larger inputs, and has to agree with the direct computation
+	r := rand.New(rand.NewSource(1337))
+	for _, dt := range []Dtype{Float64, Float32} {
+		a := New(WithShape(40, 5), Of(dt))
+		b := New(WithShape(30, 5), Of(dt))
+		for _, x := range []*Dense{a, b} {
+			for i := 0; i < x.Size(); i++ {
+				switch dt {
+				case Float64:
+					x.Float64s()[i] = r.Float64()
+				case Float32:
+					x.Float32s()[i] = r.Float32()
+				}
+			}
+		}
+		for _, metric := range []DistanceMetric{Euclidean(), SqEuclidean()} {
+			D, err := Cdist(a, b, metric)
+			if err != nil {
+				t.Fatal(err)
+			}
+			correct := New(WithShape(40, 30), Of(dt))
+			switch dt {
+			case Float64:
+				cdistF64(a.Float64s(), b.Float64s(), correct.Float64s(), 40, 30, 5, metric)
+				assert.True(t, allClose(correct.Data(), D.Data(), func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }), "%v %v", dt, metric)
+			case Float32:
+				cdistF32(a.Float32s(), b.Float32s(), correct.Float32s(), 40, 30, 5, metric)
+				assert.True(t, allClose(correct.Data(), D.Data(), func(a, b float32) bool { return math.Abs(float64(a-b)) < 1e-3 }), "%v %v", dt, metric)
+			}
+		}
+	}
+
+	// identical points are exactly 0 apart, and not NaN
+	a := New(WithShape(30, 3), WithBacking(Range(Float32, 0, 90)))
+	D, err := Cdist(a, a, Euclidean())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 30; i++ {
+		v := D.(*Dense).Float32s()[i*30+i]
+		assert.False(t, v != v, "NaN at %d", i)
+	}
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	assert := assert.New(t)
+	a := New(WithShape(2, 2), WithBacking([]float64{1, 0, 1, 1}))
+	b := New(WithShape(2, 2), WithBacking([]float64{0, 1, 2, 2}))
+
+	S, err := CosineSimilarity(a, b, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2}, S.Shape())
+	assert.True(allClose([]float64{0, 1}, S.Data()), "%v", S.Data())
+
+	S, err = CosineSimilarity(a, b, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(allClose([]float64{1 / math.Sqrt2, 2 / math.Sqrt(5)}, S.Data()), "%v", S.Data())
+
+	// the matrix of the similarities between all rows
+	a3 := New(WithShape(2, 1, 2), WithBacking([]float64{1, 0, 1, 1}))
+	S, err = CosineSimilarity(a3, b, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, S.Shape())
+	assert.True(allClose([]float64{0, 1 / math.Sqrt2, 1 / math.Sqrt2, 1}, S.Data()), "%v", S.Data())
+
+	// zero vectors have a similarity of 0
+	S, err = CosineSimilarity(New(WithShape(2), Of(Float64)), New(WithShape(2), WithBacking([]float64{1, 1})), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(0.0, S.Data())
+
+	_, err = CosineSimilarity(a, New(WithShape(3), Of(Float64)), 0)
+	assert.NotNil(err)
+	_, err = CosineSimilarity(a, b, 2)
+	assert.NotNil(err)
+}
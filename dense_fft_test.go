@@ -0,0 +1,222 @@
+package tensor
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// naiveDFT is the O(n²) definition of the discrete Fourier transform
+func naiveDFT(x []complex128, inverse bool) []complex128 {
+	n := len(x)
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	retVal := make([]complex128, n)
+	for k := range retVal {
+		for j, v := range x {
+			retVal[k] += v * cmplx.Exp(complex(0, sign*2*math.Pi*float64(j*k)/float64(n)))
+		}
+	}
+	return retVal
+}
+
+func closeC128s(a, b []complex128, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if cmplx.Abs(a[i]-b[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFFT(t *testing.T) {
+	assert := assert.New(t)
+
+	// numpy.fft.fft([1, 2, 3, 4])
+	T := New(WithShape(4), WithBacking([]complex128{1, 2, 3, 4}))
+	F, err := FFT(T, -1, 0, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(closeC128s([]complex128{10, -2 + 2i, -2, -2 - 2i}, F.Data().([]complex128), 1e-12), "%v", F.Data())
+
+	// powers of two and Bluestein lengths agree with the definition
+	r := rand.New(rand.NewSource(1337))
+	for _, n := range []int{1, 2, 3, 5, 6, 7, 8, 12, 16, 17, 31, 64, 100} {
+		x := make([]complex128, n)
+		for i := range x {
+			x[i] = complex(r.Float64()-0.5, r.Float64()-0.5)
+		}
+		T := New(WithShape(n), WithBacking(append([]complex128(nil), x...)))
+		F, err := FFT(T, -1, 0, FFTNormBackward)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(closeC128s(naiveDFT(x, false), F.Data().([]complex128), 1e-9), "n = %d", n)
+
+		back, err := IFFT(F, -1, 0, FFTNormBackward)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(closeC128s(x, back.Data().([]complex128), 1e-12), "round trip n = %d", n)
+	}
+
+	// normalization modes
+	x := []complex128{1, 2i, -1, 3}
+	T = New(WithShape(4), WithBacking(x))
+	correct := naiveDFT(x, false)
+	for _, tc := range []struct {
+		norm  FFTNorm
+		scale float64
+	}{{FFTNormBackward, 1}, {FFTNormOrtho, 0.5}, {FFTNormForward, 0.25}} {
+		F, err := FFT(T, -1, 0, tc.norm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scaled := make([]complex128, 4)
+		for i, v := range correct {
+			scaled[i] = v * complex(tc.scale, 0)
+		}
+		assert.True(closeC128s(scaled, F.Data().([]complex128), 1e-12), "%v", tc.norm)
+
+		back, err := IFFT(F, -1, 0, tc.norm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(closeC128s(x, back.Data().([]complex128), 1e-12), "%v round trip", tc.norm)
+	}
+
+	// along the first axis of a float32 matrix, padded to 3 values
+	M := New(WithShape(2, 2), WithBacking([]float32{1, 2, 3, 4}))
+	F, err = FFT(M, 3, 0, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, F.Shape())
+	assert.Equal(Complex64, F.Dtype())
+	col0 := naiveDFT([]complex128{1, 3, 0}, false)
+	col1 := naiveDFT([]complex128{2, 4, 0}, false)
+	got := F.Data().([]complex64)
+	for i := 0; i < 3; i++ {
+		assert.InDelta(0, cmplx.Abs(complex128(got[2*i])-col0[i]), 1e-5)
+		assert.InDelta(0, cmplx.Abs(complex128(got[2*i+1])-col1[i]), 1e-5)
+	}
+
+	// cropped, from a reversed view
+	V, err := T.Slice(S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	F, err = FFT(V, 2, -1, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(closeC128s([]complex128{2, 4}, F.Data().([]complex128), 1e-12), "%v", F.Data())
+
+	_, err = FFT(New(WithShape(2), WithBacking([]int{1, 2})), -1, 0, FFTNormBackward)
+	assert.NotNil(err)
+	_, err = FFT(T, -1, 1, FFTNormBackward)
+	assert.NotNil(err)
+	_, err = FFT(T, -1, 0, FFTNorm(5))
+	assert.NotNil(err)
+}
+
+func TestRFFT(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{1, 2, 5, 8, 9} {
+		x := make([]float64, n)
+		cx := make([]complex128, n)
+		for i := range x {
+			x[i] = math.Sin(float64(i)) + 0.5
+			cx[i] = complex(x[i], 0)
+		}
+		T := New(WithShape(n), WithBacking(x))
+		F, err := RFFT(T, -1, 0, FFTNormOrtho)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(Shape{n/2 + 1}, F.Shape())
+		full := naiveDFT(cx, false)
+		for i := range full {
+			full[i] /= complex(math.Sqrt(float64(n)), 0)
+		}
+		assert.True(closeC128s(full[:n/2+1], F.Data().([]complex128), 1e-9), "n = %d", n)
+
+		back, err := IRFFT(F, n, 0, FFTNormOrtho)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(Float64, back.Dtype())
+		assert.True(allClose(x, back.Data(), func(a, b float64) bool { return math.Abs(a-b) < 1e-12 }), "round trip n = %d: %v", n, back.Data())
+	}
+
+	// the default length of IRFFT is 2*(m-1)
+	F := New(WithShape(3), WithBacking([]complex64{4, 0, 0}))
+	back, err := IRFFT(F, -1, 0, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{1, 1, 1, 1}, back.Data())
+
+	// batched, along the last axis
+	B := New(WithShape(2, 4), WithBacking([]float32{1, 1, 1, 1, 1, 0, -1, 0}))
+	F2, err := RFFT(B, -1, 1, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, F2.Shape())
+	assert.Equal([]complex64{4, 0, 0, 0, 2, 0}, F2.Data())
+
+	_, err = RFFT(New(WithShape(2), Of(Complex128)), -1, 0, FFTNormBackward)
+	assert.NotNil(err)
+	_, err = IRFFT(New(WithShape(2), Of(Float64)), -1, 0, FFTNormBackward)
+	assert.NotNil(err)
+	_, err = IRFFT(New(WithShape(1), Of(Complex128)), -1, 0, FFTNormBackward)
+	assert.NotNil(err)
+}
+
+func TestFFTN(t *testing.T) {
+	assert := assert.New(t)
+	// numpy.fft.fft2([[1, 2, 3], [4, 5, 6]])
+	T := New(WithShape(2, 3), WithBacking([]float64{1, 2, 3, 4, 5, 6}))
+	F, err := FFT2(T, nil, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s3 := math.Sqrt(3)
+	correct := []complex128{21, complex(-3, s3), complex(-3, -s3), -9, 0, 0}
+	assert.True(closeC128s(correct, F.Data().([]complex128), 1e-12), "%v", F.Data())
+
+	// FFTN over all the axes is FFT2 for matrices
+	F2, err := FFTN(T, nil, nil, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(closeC128s(correct, F2.Data().([]complex128), 1e-12))
+
+	back, err := IFFT2(F, nil, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(closeC128s([]complex128{1, 2, 3, 4, 5, 6}, back.Data().([]complex128), 1e-12))
+
+	// lengths without axes apply to the last axes
+	F3, err := FFTN(T, []int{4}, nil, FFTNormBackward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 4}, F3.Shape())
+
+	_, err = FFTN(T, []int{2, 2}, []int{0}, FFTNormBackward)
+	assert.NotNil(err)
+	_, err = FFTN(T, nil, []int{1, 1}, FFTNormBackward)
+	assert.NotNil(err)
+}
@@ -219,7 +219,7 @@ func (f *fmtState) calcWidth(d *Dense) {
 		}
 	}
 	for i := 0; i < d.len(); i++ {
-		w, _ := fmt.Fprintf(f.buf, format, d.Get(i))
+		w, _ := fmt.Fprintf(f.buf, format, d.getValue(i))
 		if masked {
 			if d.mask[i] {
 				w, _ = fmt.Fprintf(f.buf, "%s", hInvalid)
@@ -256,6 +256,10 @@ func (f *fmtState) writeVElision() {
 //
 // Special care also needs be taken for the verb 's' - it prints a super compressed version of the tensor, only printing 4 cols and 4 rows.
 func (t *Dense) Format(s fmt.State, c rune) {
+	if t.origin() > 0 {
+		// the printing below expects the indices to only grow, which they do not with negative strides
+		t = t.Materialize().(*Dense)
+	}
 	if c == 'i' {
 		fmt.Fprintf(s, "INFO:\n\tAP:  %v\n\tOLD: %v\n\tTRANS %v\n\tENGINE: %T\n", t.AP, t.old, t.transposeWith, t.e)
 		return
@@ -264,7 +268,7 @@ func (t *Dense) Format(s fmt.State, c rune) {
 	f := newFmtState(s, c)
 	if t.IsScalar() {
 		o := f.originalFmt()
-		fmt.Fprintf(f, o, t.Get(0))
+		fmt.Fprintf(f, o, t.getValue(0))
 		return
 	}
 
@@ -303,12 +307,12 @@ func (t *Dense) Format(s fmt.State, c rune) {
 		case f.ext:
 			for i := 0; i < t.len(); i++ {
 				if !t.IsMasked() {
-					fmt.Fprintf(f, format, t.Get(i))
+					fmt.Fprintf(f, format, t.getValue(i))
 				} else {
 					if t.mask[i] {
 						fmt.Fprintf(f, "%s", hInvalid)
 					} else {
-						fmt.Fprintf(f, format, t.Get(i))
+						fmt.Fprintf(f, format, t.getValue(i))
 					}
 				}
 				if i < t.len()-1 {
@@ -321,12 +325,12 @@ func (t *Dense) Format(s fmt.State, c rune) {
 			var err error
 			for i, err = it.Next(); err == nil; i, err = it.Next() {
 				if !t.IsMasked() {
-					fmt.Fprintf(f, format, t.Get(i))
+					fmt.Fprintf(f, format, t.getValue(i))
 				} else {
 					if t.mask[i] {
 						fmt.Fprintf(f, "%s", hInvalid)
 					} else {
-						fmt.Fprintf(f, format, t.Get(i))
+						fmt.Fprintf(f, format, t.getValue(i))
 					}
 				}
 				f.Write(f.pad[:1])
@@ -346,12 +350,12 @@ func (t *Dense) Format(s fmt.State, c rune) {
 		default:
 			for i := 0; i < f.pc; i++ {
 				if !t.IsMasked() {
-					fmt.Fprintf(f, format, t.Get(i))
+					fmt.Fprintf(f, format, t.getValue(i))
 				} else {
 					if t.mask[i] {
 						fmt.Fprintf(f, "%s", hInvalid)
 					} else {
-						fmt.Fprintf(f, format, t.Get(i))
+						fmt.Fprintf(f, format, t.getValue(i))
 					}
 				}
 				f.Write(f.pad[:1])
@@ -423,10 +427,10 @@ func (t *Dense) Format(s fmt.State, c rune) {
 				if t.mask[next] {
 					w, _ = fmt.Fprintf(f.buf, "%s", hInvalid)
 				} else {
-					w, _ = fmt.Fprintf(f.buf, format, t.Get(next))
+					w, _ = fmt.Fprintf(f.buf, format, t.getValue(next))
 				}
 			} else {
-				w, _ = fmt.Fprintf(f.buf, format, t.Get(next))
+				w, _ = fmt.Fprintf(f.buf, format, t.getValue(next))
 			}
 			f.Write(f.pad[:f.w-w]) // prepad
 			f.Write(f.buf.Bytes()) // write
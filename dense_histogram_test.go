@@ -0,0 +1,158 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram(t *testing.T) {
+	assert := assert.New(t)
+	// numpy.histogram([1, 2, 1, 4, 2.5, 3], bins=3)
+	T := New(WithShape(2, 3), WithBacking([]float64{1, 2, 1, 4, 2.5, 3}))
+	counts, edges, err := Histogram(T, 3, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{2, 2, 2}, counts.Data())
+	assert.Equal([]float64{1, 2, 3, 4}, edges.Data())
+
+	// with a range, the values out of it are not counted, and the last bin includes its right edge
+	counts, edges, err = Histogram(T, 2, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{2, 3}, counts.Data())
+	assert.Equal([]float64{1, 2, 3}, edges.Data())
+
+	// views, masks and NaNs
+	M := New(WithShape(2, 3), WithBacking([]float32{0, 1, 2, 3, float32(math.NaN()), 5}, []bool{false, false, true, false, false, false}))
+	V, err := M.Slice(nil, S(1, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts, edges, err = Histogram(V, 4, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 0, 0, 1}, counts.Data())
+	assert.Equal([]float32{1, 2, 3, 4, 5}, edges.Data())
+
+	// all the values are equal
+	counts, edges, err = Histogram(New(WithShape(2), WithBacking([]float64{3, 3})), 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{2}, counts.Data())
+	assert.Equal([]float64{2.5, 3.5}, edges.Data())
+
+	_, _, err = Histogram(T, 0, 0, 0)
+	assert.NotNil(err)
+	_, _, err = Histogram(T, 2, 3, 1)
+	assert.NotNil(err)
+	_, _, err = Histogram(New(WithShape(2), Of(Int)), 2, 0, 0)
+	assert.NotNil(err)
+}
+
+func TestHistogramDD(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(4, 2), WithBacking([]float64{0, 0, 1, 1, 1, 0, 0.25, 2}))
+	counts, edges, err := HistogramDD(T, []int{2, 2}, [][2]float64{{0, 1}, {0, 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, counts.Shape())
+	// (0.25, 2) is out of range
+	assert.Equal([]int{1, 0, 1, 1}, counts.Data())
+	assert.Equal([]float64{0, 0.5, 1}, edges[0].Data())
+
+	// with the ranges of the values, and a masked point
+	T.SetMask([]bool{false, false, false, false, false, false, false, true})
+	counts, edges, err = HistogramDD(T, []int{1, 3}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{2, 0, 1}, counts.Data())
+	assert.Equal([]float64{0, 1}, edges[0].Data())
+	assert.Equal(4, edges[1].Shape().TotalSize())
+
+	_, _, err = HistogramDD(T, []int{2}, nil)
+	assert.NotNil(err)
+	_, _, err = HistogramDD(New(WithShape(4), Of(Float64)), []int{2}, nil)
+	assert.NotNil(err)
+}
+
+func TestBincount(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(5), WithBacking([]int{0, 1, 1, 3, 2}))
+	c, err := Bincount(T, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 2, 1, 1}, c.Data())
+
+	c, err = Bincount(T, nil, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 2, 1, 1, 0, 0}, c.Data())
+
+	w := New(WithShape(5), WithBacking([]float64{0.5, 1, 1.5, 2, -1}))
+	c, err = Bincount(T, w, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0.5, 2.5, -1, 2}, c.Data())
+
+	// a strided view, with a masked value
+	M := New(WithShape(2, 3), WithBacking([]int{4, 0, 1, 7, 2, 2}, []bool{false, false, false, true, false, false}))
+	V, err := M.Slice(nil, S(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err = Bincount(V, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{0, 0, 0, 0, 1}, c.Data())
+
+	_, err = Bincount(New(WithShape(2), WithBacking([]int{1, -1})), nil, 0)
+	assert.NotNil(err)
+	_, err = Bincount(T, New(WithShape(4), Of(Float64)), 0)
+	assert.NotNil(err)
+	_, err = Bincount(New(WithShape(2), Of(Float64)), nil, 0)
+	assert.NotNil(err)
+}
+
+func TestDigitize(t *testing.T) {
+	assert := assert.New(t)
+	// numpy.digitize([0.2, 6.4, 3.0, 1.6, 1], [0, 1, 2.5, 4, 10], right=...)
+	T := New(WithShape(5), WithBacking([]float64{0.2, 6.4, 3.0, 1.6, 1}))
+	edges := New(WithShape(5), WithBacking([]float64{0, 1, 2.5, 4, 10}))
+	idx, err := Digitize(T, edges, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 4, 3, 2, 2}, idx.Data())
+	idx, err = Digitize(T, edges, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 4, 3, 2, 1}, idx.Data())
+
+	// the shape and mask are kept, and NaNs go past the last edge
+	M := New(WithShape(2, 2), WithBacking([]float32{-1, 11, float32(math.NaN()), 2}, []bool{false, false, false, true}))
+	idx, err = Digitize(M, New(WithShape(2), WithBacking([]float32{0, 10})), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, idx.Shape())
+	assert.Equal([]int{0, 2, 2, 1}, idx.Data())
+	assert.Equal([]bool{false, false, false, true}, idx.(*Dense).Mask())
+
+	_, err = Digitize(T, New(WithShape(2), WithBacking([]float64{1, 0})), false)
+	assert.NotNil(err)
+	_, err = Digitize(T, New(WithShape(1, 2), Of(Float64)), false)
+	assert.NotNil(err)
+}
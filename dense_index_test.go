@@ -0,0 +1,154 @@
+package tensor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex(t *testing.T) {
+	assert := assert.New(t)
+	// 0  1  2  3
+	// 4  5  6  7
+	// 8  9 10 11
+	T := New(WithShape(3, 4), WithBacking(Range(Float64, 0, 12)))
+
+	testCases := []struct {
+		name    string
+		indices []interface{}
+		shape   Shape
+		correct interface{}
+	}{
+		{"rows", []interface{}{[]int{2, 0, 2}}, Shape{3, 4}, []float64{8, 9, 10, 11, 0, 1, 2, 3, 8, 9, 10, 11}},
+		{"pairs", []interface{}{[]int{0, 2}, []int{1, 3}}, Shape{2}, []float64{1, 11}},
+		{"negative", []interface{}{-1, []int{-1, 0}}, Shape{2}, []float64{11, 8}},
+		{"cols", []interface{}{nil, []int{3, 0}}, Shape{3, 2}, []float64{3, 0, 7, 4, 11, 8}},
+		{"slice and cols", []interface{}{S(1, Open), []int{1}}, Shape{2, 1}, []float64{5, 9}},
+		{"reversed rows", []interface{}{S(Open, Open, -1), 0}, Shape{3}, []float64{8, 4, 0}},
+		{"broadcast", []interface{}{New(WithShape(2, 1), WithBacking([]int{0, 2})), []int{1, 2, 3}}, Shape{2, 3}, []float64{1, 2, 3, 9, 10, 11}},
+		{"ellipsis", []interface{}{Ellipsis, []int{1}}, Shape{3, 1}, []float64{1, 5, 9}},
+		{"new axis", []interface{}{NewAxis, 1, S(0, 2)}, Shape{1, 2}, []float64{4, 5}},
+		{"ints", []interface{}{1, 2}, ScalarShape(), 6.0},
+	}
+	for _, tc := range testCases {
+		r, err := Index(T, tc.indices...)
+		if err != nil {
+			t.Errorf("%v: %v", tc.name, err)
+			continue
+		}
+		assert.True(tc.shape.Eq(r.Shape()), "%v: expected %v. Got %v", tc.name, tc.shape, r.Shape())
+		assert.Equal(tc.correct, r.Data(), tc.name)
+	}
+
+	// the axes of the index tensors go first when they are not next to each other
+	T3 := New(WithShape(2, 3, 4), WithBacking(Range(Int, 0, 24)))
+	r, err := Index(T3, []int{0, 1}, nil, []int{3, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, r.Shape())
+	assert.Equal([]int{3, 7, 11, 12, 16, 20}, r.Data())
+
+	r, err = Index(T3, nil, []int{2, 0}, New(WithShape(2, 1), WithBacking([]int{1, 2})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2, 2}, r.Shape())
+	assert.Equal([]int{9, 1, 10, 2, 21, 13, 22, 14}, r.Data())
+
+	// from a view, with an index tensor that is a view too, and a mask
+	M := New(WithShape(3, 4), WithBacking(Range(Float64, 0, 12), []bool{false, false, false, false, false, true, false, false, false, false, false, false}))
+	V, err := M.Slice(nil, S(1, Open, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	I := New(WithShape(2, 2), WithBacking([]int{2, 0, 1, 0}))
+	IV, err := I.Slice(nil, ss(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = Index(V, IV, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{9, 5}, r.Data())
+	assert.Equal([]bool{false, true}, r.(*Dense).Mask())
+
+	// errors
+	_, err = Index(T, []int{3})
+	assert.NotNil(err)
+	_, err = Index(T, 0, 0, 0)
+	assert.NotNil(err)
+	_, err = Index(T, []int{0, 1}, []int{0, 1, 2})
+	assert.NotNil(err)
+	_, err = Index(T, New(WithShape(2), WithBacking([]float64{0, 1})))
+	assert.NotNil(err)
+	_, err = Index(T, Ellipsis, 0, Ellipsis)
+	assert.NotNil(err)
+	_, err = Index(T, "a")
+	assert.NotNil(err)
+}
+
+func TestIndexPut(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), Of(Float64))
+
+	if err := IndexPut(T, 1.0, []int{0, 1, 1}, []int{2, 0, 0}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0, 0, 1, 1, 0, 0}, T.Data())
+
+	// broadcast values, with the last value winning on repeated indices
+	if err := IndexPut(T, New(WithShape(2, 1), WithBacking([]float64{5, 6})), []int{1, 1}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0, 0, 1, 6, 6, 6}, T.Data())
+
+	// through a view
+	V, err := T.Slice(nil, S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = IndexPut(V, New(WithShape(2), WithBacking([]float64{7, 8})), nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0, 0, 7, 6, 6, 8}, T.Data())
+
+	S := New(WithShape(3), WithBacking([]string{"a", "b", "c"}))
+	if err = IndexPut(S, "z", []int{0, 2}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]string{"z", "b", "z"}, S.Data())
+
+	// errors
+	assert.NotNil(IndexPut(T, 1, 0))
+	assert.NotNil(IndexPut(T, New(WithShape(2), Of(Float64)), 0))
+	assert.NotNil(IndexPut(T, 1.0, 2))
+}
+
+func TestIndexAdd(t *testing.T) {
+	assert := assert.New(t)
+
+	// repeated indices accumulate
+	T := New(WithShape(4), Of(Float64))
+	if err := IndexAdd(T, New(WithShape(4), WithBacking([]float64{1, 2, 3, 4})), []int{0, 2, 0, 0}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{8, 0, 2, 0}, T.Data())
+
+	I := New(WithShape(2, 3), WithBacking([]int{1, 1, 1, 2, 2, 2}))
+	if err := IndexAdd(I, 10, nil, []int{0, 0}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{21, 1, 1, 22, 2, 2}, I.Data())
+
+	// IndexAdd scatters the gradient of Index back
+	x := New(WithShape(3, 2), Of(Float32))
+	if err := IndexAdd(x, Ones(Float32, 3, 2), []int{2, 0, 2}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{1, 1, 0, 0, 2, 2}, x.Data())
+
+	assert.NotNil(IndexAdd(New(WithShape(2), WithBacking([]string{"a", "b"})), "c", 0))
+	assert.NotNil(IndexAdd(T, 1.0, 4))
+}
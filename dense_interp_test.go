@@ -0,0 +1,195 @@
+package tensor
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterp(t *testing.T) {
+	assert := assert.New(t)
+	xp := New(WithShape(3), WithBacking([]float64{1, 2, 3}))
+	fp := New(WithShape(3), WithBacking([]float64{3, 2, 0}))
+
+	// numpy.interp([[0, 1, 1.5], [2.72, 3.14, nan]], [1, 2, 3], [3, 2, 0])
+	x := New(WithShape(2, 3), WithBacking([]float64{0, 1, 1.5, 2.72, 3.14, math.NaN()}))
+	r, err := Interp(x, xp, fp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, r.Shape())
+	got := r.Data().([]float64)
+	for i, want := range []float64{3, 3, 2.5, 0.56, 0} {
+		assert.True(closeenoughf64(want, got[i]), "%d: want %v. Got %v", i, want, got[i])
+	}
+	assert.True(math.IsNaN(got[5]))
+
+	// a view, in float32
+	m := New(WithShape(2, 2), WithBacking([]float32{0.5, 9, 2.5, 9}))
+	col, err := m.Slice(nil, S(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = Interp(col, New(WithShape(2), WithBacking([]float32{0, 4})), New(WithShape(2), WithBacking([]float32{0, 8})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{1, 5}, r.Data())
+
+	r, err = Interp(New(FromScalar(2.5)), xp, fp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(1.0, r.Data())
+
+	_, err = Interp(x, New(WithShape(3), WithBacking([]float64{1, 3, 2})), fp)
+	assert.NotNil(err)
+	_, err = Interp(x, xp, New(WithShape(2), WithBacking([]float64{1, 2})))
+	assert.NotNil(err)
+	_, err = Interp(x, xp, New(WithShape(3), Of(Float32)))
+	assert.NotNil(err)
+	_, err = Interp(New(WithShape(2), Of(Int)), xp, fp)
+	assert.NotNil(err)
+	empty := New(WithShape(0), Of(Float64))
+	_, err = Interp(x, empty, empty)
+	assert.NotNil(err)
+}
+
+func TestResize(t *testing.T) {
+	assert := assert.New(t)
+	x := New(WithShape(1, 1, 2, 2), WithBacking([]float64{1, 2, 3, 4}))
+
+	r, err := Resize(x, 4, 4, Resampling{Method: ResizeNearest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 1, 4, 4}, r.Shape())
+	assert.Equal([]float64{1, 1, 2, 2, 1, 1, 2, 2, 3, 3, 4, 4, 3, 3, 4, 4}, r.Data())
+
+	// torch.nn.functional.interpolate(x, size=(4, 4), mode="bilinear", align_corners=False)
+	r, err = Resize(x, 4, 4, Resampling{Method: ResizeBilinear})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{
+		1, 1.25, 1.75, 2,
+		1.5, 1.75, 2.25, 2.5,
+		2.5, 2.75, 3.25, 3.5,
+		3, 3.25, 3.75, 4,
+	}, r.Data())
+
+	r, err = Resize(x, 3, 3, Resampling{Method: ResizeBilinear, AlignCorners: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{1, 1.5, 2, 2, 2.5, 3, 3, 3.5, 4}, r.Data())
+
+	// torch.nn.functional.interpolate(torch.arange(4.).view(1, 1, 1, 4), size=(1, 7), mode="bicubic", align_corners=True)
+	ramp := New(WithShape(1, 1, 1, 4), WithBacking([]float32{0, 1, 2, 3}))
+	r, err = Resize(ramp, 1, 7, Resampling{Method: ResizeBicubic, AlignCorners: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{0, 0.40625, 1, 1.5, 2, 2.59375, 3}, r.Data())
+
+	// NHWC is the same as NCHW with the channels moved to the end. The input is a view
+	backing := New(WithShape(1, 2, 3, 3), WithBacking(Range(Float64, 0, 18)))
+	nchw, err := backing.Slice(nil, nil, nil, S(0, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	nhwc := nchw.(*Dense).Materialize().(*Dense)
+	if err = nhwc.T(0, 2, 3, 1); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []ResizeMethod{ResizeNearest, ResizeBilinear, ResizeBicubic} {
+		want, err := Resize(nchw, 5, 3, Resampling{Method: m})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Resize(nhwc, 5, 3, Resampling{Method: m, Layout: NHWC})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(Shape{1, 5, 3, 2}, got.Shape())
+		for c := 0; c < 2; c++ {
+			for y := 0; y < 5; y++ {
+				for x := 0; x < 3; x++ {
+					w, _ := want.At(0, c, y, x)
+					g, _ := got.At(0, y, x, c)
+					assert.True(closeenoughf64(w.(float64), g.(float64)), "%v %d,%d,%d: want %v. Got %v", m, c, y, x, w, g)
+				}
+			}
+		}
+	}
+
+	_, err = Resize(New(WithShape(1, 2, 2), Of(Float64)), 4, 4, Resampling{})
+	assert.NotNil(err)
+	_, err = Resize(x, 0, 4, Resampling{})
+	assert.NotNil(err)
+	_, err = Resize(New(WithShape(1, 1, 0, 2), Of(Float64)), 4, 4, Resampling{})
+	assert.NotNil(err)
+	_, err = Resize(x, 4, 4, Resampling{Method: ResizeMethod(7)})
+	assert.NotNil(err)
+	_, err = Resize(New(WithShape(1, 1, 2, 2), Of(Int)), 4, 4, Resampling{})
+	assert.NotNil(err)
+}
+
+func TestResizeB(t *testing.T) {
+	assert := assert.New(t)
+	x := New(WithShape(1, 1, 2, 2), WithBacking([]float64{1, 2, 3, 4}))
+
+	grad := Ones(Float64, 1, 1, 4, 4)
+	dx, err := ResizeB(x, grad, Resampling{Method: ResizeNearest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{4, 4, 4, 4}, dx.Data())
+
+	// Resize is linear, so its gradient is its adjoint: <Resize(x), g> = <x, ResizeB(g)>
+	r := rand.New(rand.NewSource(1337))
+	randn := func(shape ...int) *Dense {
+		data := make([]float64, Shape(shape).TotalSize())
+		for i := range data {
+			data[i] = r.NormFloat64()
+		}
+		return New(WithShape(shape...), WithBacking(data))
+	}
+	dot := func(a, b Tensor) (s float64) {
+		bs := b.Data().([]float64)
+		for i, v := range a.Data().([]float64) {
+			s += v * bs[i]
+		}
+		return s
+	}
+	for _, rs := range []Resampling{
+		{Method: ResizeNearest},
+		{Method: ResizeNearest, AlignCorners: true},
+		{Method: ResizeBilinear},
+		{Method: ResizeBilinear, AlignCorners: true, Layout: NHWC},
+		{Method: ResizeBicubic},
+		{Method: ResizeBicubic, AlignCorners: true},
+	} {
+		in := randn(2, 3, 5, 4)
+		g := randn(2, 3, 3, 7)
+		if rs.Layout == NHWC {
+			g = randn(2, 3, 7, 4)
+		}
+		y, err := Resize(in, 3, 7, rs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dx, err := ResizeB(in, g, rs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(closeenoughf64(dot(y, g), dot(in, dx)), "%+v: %v vs %v", rs, dot(y, g), dot(in, dx))
+	}
+
+	_, err = ResizeB(x, New(WithShape(1, 2, 4, 4), Of(Float64)), Resampling{})
+	assert.NotNil(err)
+	_, err = ResizeB(x, New(WithShape(1, 1, 4, 4), Of(Float32)), Resampling{})
+	assert.NotNil(err)
+}
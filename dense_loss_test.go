@@ -0,0 +1,156 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSoftmaxCrossEntropy(t *testing.T) {
+	assert := assert.New(t)
+	logits := New(WithShape(2, 3), WithBacking([]float64{1, 2, 3, 1000, 0, -1000}))
+	targets := New(WithShape(2), WithBacking([]int{2, 0}))
+
+	lse := math.Log(math.Exp(-2) + math.Exp(-1) + 1)
+	l0 := lse // -log softmax([1,2,3])[2]
+
+	loss, err := SoftmaxCrossEntropy(logits, targets, -1, WithReduction(ReduceNone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2}, loss.Shape())
+	assert.InDeltaSlice([]float64{l0, 0}, loss.Data(), 1e-12)
+
+	loss, err = SoftmaxCrossEntropy(logits, targets, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(loss.Shape().IsScalar())
+	assert.InDelta(l0/2, loss.Data(), 1e-12)
+
+	loss, err = SoftmaxCrossEntropy(logits, targets, -1, WithReduction(ReduceSum))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDelta(l0, loss.Data(), 1e-12)
+
+	// it agrees with LogSoftMax followed by NLLLoss
+	logProbs, err := LogSoftMax(logits, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nll, err := NLLLoss(logProbs, targets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDelta(l0/2, nll.Data(), 1e-12)
+
+	// ignored samples count neither towards the loss, nor the mean
+	loss, err = SoftmaxCrossEntropy(logits, New(WithShape(2), WithBacking([]int{2, -100})), -1, WithIgnoreIndex(-100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDelta(l0, loss.Data(), 1e-12)
+
+	// label smoothing: -(1-ε)·log p_t - ε/C·Σ log p
+	const eps = 0.3
+	loss, err = SoftmaxCrossEntropy(logits, targets, 1, WithLabelSmoothing(eps), WithReduction(ReduceNone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	smoothed := (1-eps)*l0 - eps/3*((1-3-lse)+(2-3-lse)+(-lse))
+	assert.InDelta(smoothed, loss.Data().([]float64)[0], 1e-12)
+	assert.InDelta(eps/3*(1000+2000), loss.Data().([]float64)[1], 1e-9)
+
+	// classes along an inner axis, in float32
+	logits32 := New(WithShape(2, 2, 1), WithBacking([]float32{0, 0, 3, 1}))
+	loss, err = SoftmaxCrossEntropy(logits32, New(WithShape(2, 1), WithBacking([]int{1, 0})), 1, WithReduction(ReduceNone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 1}, loss.Shape())
+	assert.InDeltaSlice([]float32{float32(math.Ln2), float32(math.Log(1 + math.Exp(-2)))}, loss.Data(), 1e-6)
+
+	// errors
+	_, err = SoftmaxCrossEntropy(logits, New(WithShape(2), WithBacking([]int{3, 0})), -1)
+	assert.NotNil(err)
+	_, err = SoftmaxCrossEntropy(logits, New(WithShape(3), Of(Int)), -1)
+	assert.NotNil(err)
+	_, err = SoftmaxCrossEntropy(logits, New(WithShape(2), Of(Float64)), -1)
+	assert.NotNil(err)
+	_, err = SoftmaxCrossEntropy(logits, targets, -1, WithLabelSmoothing(2))
+	assert.NotNil(err)
+}
+
+// numerical gradients of the loss with regards to the logits
+func TestSoftmaxCrossEntropyB(t *testing.T) {
+	xs := Shape{3, 4, 2}
+	back := Random(Float64, xs.TotalSize()).([]float64)
+	targets := New(WithShape(3, 2), WithBacking([]int{0, 3, 1, -1, 2, 2}))
+
+	optss := [][]LossOpt{
+		{WithIgnoreIndex(-1)},
+		{WithIgnoreIndex(-1), WithReduction(ReduceSum), WithLabelSmoothing(0.1)},
+	}
+	for i, opts := range optss {
+		loss := func(x []float64) float64 {
+			l, err := SoftmaxCrossEntropy(New(WithShape(xs...), WithBacking(x)), targets, 1, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return l.Data().(float64)
+		}
+		dx, err := SoftmaxCrossEntropyB(New(WithShape(xs...), WithBacking(back)), targets, New(FromScalar(2.0)), 1, opts...)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i, err)
+		}
+		assert.Equal(t, xs, dx.Shape())
+
+		const h = 1e-6
+		for j := range back {
+			xp := append([]float64(nil), back...)
+			xm := append([]float64(nil), back...)
+			xp[j] += h
+			xm[j] -= h
+			num := 2 * (loss(xp) - loss(xm)) / (2 * h)
+			assert.InDelta(t, num, dx.Data().([]float64)[j], 1e-6, "Test %d: dx[%d]", i, j)
+		}
+	}
+
+	// per-sample gradients
+	logits := New(WithShape(2, 2), WithBacking([]float64{0, 0, 0, 0}))
+	dx, err := SoftmaxCrossEntropyB(logits, New(WithShape(2), WithBacking([]int{0, 1})), New(WithShape(2), WithBacking([]float64{1, 2})), -1, WithReduction(ReduceNone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []float64{-0.5, 0.5, 1, -1}, dx.Data())
+
+	// the gradient has to match the reduction
+	_, err = SoftmaxCrossEntropyB(logits, New(WithShape(2), WithBacking([]int{0, 1})), New(FromScalar(1.0)), -1, WithReduction(ReduceNone))
+	assert.NotNil(t, err)
+}
+
+func TestNLLLossB(t *testing.T) {
+	assert := assert.New(t)
+	logProbs := New(WithShape(2, 3), WithBacking([]float32{-1, -2, -3, -4, -5, -6}))
+	targets := New(WithShape(2), WithBacking([]int{1, 2}))
+
+	loss, err := NLLLoss(logProbs, targets, WithReduction(ReduceSum))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(float32(8), loss.Data())
+
+	dx, err := NLLLossB(logProbs, targets, New(FromScalar(float32(1))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{0, -0.5, 0, 0, 0, -0.5}, dx.Data())
+
+	dx, err = NLLLossB(logProbs, targets, New(FromScalar(float32(1))), WithLabelSmoothing(0.3), WithReduction(ReduceSum))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice([]float32{-0.1, -0.8, -0.1, -0.1, -0.1, -0.8}, dx.Data(), 1e-6)
+}
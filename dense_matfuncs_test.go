@@ -0,0 +1,196 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinv(t *testing.T) {
+	assert := assert.New(t)
+
+	// invertible: the pseudo-inverse is the inverse
+	A := New(WithShape(2, 2), WithBacking([]float64{4, 7, 2, 6}))
+	P, err := Pinv(A, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(allClose([]float64{0.6, -0.7, -0.2, 0.4}, P.Data().([]float64)), "%v", P.Data())
+
+	// rank deficient and not square
+	R := New(WithShape(3, 2), WithBacking([]float32{1, 2, 2, 4, 3, 6}))
+	P, err = Pinv(R, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, P.Shape())
+	assert.True(allClose([]float32{1.0 / 70, 2.0 / 70, 3.0 / 70, 2.0 / 70, 4.0 / 70, 6.0 / 70}, P.Data().([]float32)), "%v", P.Data())
+
+	// from a view
+	V, err := R.Slice(S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	P, err = Pinv(V, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(allClose([]float32{3.0 / 70, 2.0 / 70, 1.0 / 70, 6.0 / 70, 4.0 / 70, 2.0 / 70}, P.Data().([]float32)), "%v", P.Data())
+
+	_, err = Pinv(New(WithShape(2, 2), WithBacking([]int{1, 2, 3, 4})), -1)
+	assert.NotNil(err)
+	_, err = Pinv(New(WithShape(2, 2, 2), Of(Float64)), -1)
+	assert.NotNil(err)
+}
+
+func TestMatrixRank(t *testing.T) {
+	assert := assert.New(t)
+	r, err := MatrixRank(New(WithShape(3, 2), WithBacking([]float64{1, 2, 2, 4, 3, 6})), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(1, r)
+
+	r, err = MatrixRank(Eye(Float32, 4, 0), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(4, r)
+
+	// an explicit tolerance
+	D := New(WithShape(2, 2), WithBacking([]float64{1, 0, 0, 1e-3}))
+	r, err = MatrixRank(D, 1e-2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(1, r)
+
+	r, err = MatrixRank(New(WithShape(2, 3), Of(Float64)), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(0, r)
+}
+
+func TestMatrixPower(t *testing.T) {
+	assert := assert.New(t)
+	A := New(WithShape(2, 2), WithBacking([]float64{1, 1, 1, 0}))
+
+	testCases := []struct {
+		n       int
+		correct []float64
+	}{
+		{0, []float64{1, 0, 0, 1}},
+		{1, []float64{1, 1, 1, 0}},
+		{2, []float64{2, 1, 1, 1}},
+		{5, []float64{8, 5, 5, 3}},
+		{10, []float64{89, 55, 55, 34}},
+		{-1, []float64{0, 1, 1, -1}},
+		{-3, []float64{-1, 2, 2, -3}},
+	}
+	for _, tc := range testCases {
+		P, err := MatrixPower(A, tc.n)
+		if err != nil {
+			t.Errorf("n %d: %v", tc.n, err)
+			continue
+		}
+		assert.True(allClose(tc.correct, P.Data().([]float64)), "n %d: %v", tc.n, P.Data())
+	}
+
+	// the first power is a copy
+	P, err := MatrixPower(A, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	P.(*Dense).SetAt(100.0, 0, 0)
+	assert.Equal(1.0, A.Data().([]float64)[0])
+
+	// from a transposed view
+	B := New(WithShape(2, 2), WithBacking([]float32{1, 2, 0, 1}))
+	if err = B.T(); err != nil {
+		t.Fatal(err)
+	}
+	P, err = MatrixPower(B, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{1, 0, 6, 1}, P.Data())
+
+	_, err = MatrixPower(New(WithShape(2, 3), Of(Float64)), 2)
+	assert.NotNil(err)
+	_, err = MatrixPower(New(WithShape(2, 2), Of(Float64)), -1)
+	assert.NotNil(err)
+}
+
+func TestKron(t *testing.T) {
+	assert := assert.New(t)
+	a := New(WithShape(2, 2), WithBacking([]int{1, 2, 3, 4}))
+	b := New(WithShape(2, 2), WithBacking([]int{0, 5, 6, 7}))
+	K, err := Kron(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{4, 4}, K.Shape())
+	assert.Equal([]int{
+		0, 5, 0, 10,
+		6, 7, 12, 14,
+		0, 15, 0, 20,
+		18, 21, 24, 28,
+	}, K.Data())
+
+	// different number of dimensions
+	v := New(WithShape(2), WithBacking([]float64{1, 10}))
+	m := New(WithShape(2, 1, 3), WithBacking(Range(Float64, 1, 7)))
+	K, err = Kron(v, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 1, 6}, K.Shape())
+	assert.Equal([]float64{1, 2, 3, 10, 20, 30, 4, 5, 6, 40, 50, 60}, K.Data())
+
+	_, err = Kron(a, v)
+	assert.NotNil(err)
+}
+
+func TestCond(t *testing.T) {
+	assert := assert.New(t)
+	A := New(WithShape(3, 3), WithBacking([]float64{1, 0, -1, 0, 1, 0, 1, 0, 1}))
+
+	// the results of numpy.linalg.cond
+	testCases := []struct {
+		ord     NormOrder
+		correct float64
+	}{
+		{Norm(2), 1.4142135623730951},
+		{Norm(-2), 0.7071067811865475},
+		{FrobeniusNorm(), 3.1622776601683795},
+		{NuclearNorm(), 5 + 3*math.Sqrt2},
+		{InfNorm(), 2},
+		{NegInfNorm(), 1},
+		{Norm(1), 2},
+		{Norm(-1), 1},
+	}
+	for _, tc := range testCases {
+		c, err := Cond(A, tc.ord)
+		if err != nil {
+			t.Errorf("%v: %v", tc.ord, err)
+			continue
+		}
+		assert.InDelta(tc.correct, c, 1e-10, "%v", tc.ord)
+	}
+
+	c, err := Cond(New(WithShape(2, 2), WithBacking([]float32{1, 2, 2, 4})), InfNorm())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(math.IsInf(c, 1))
+
+	// non square matrices only have 2-norm condition numbers
+	_, err = Cond(New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6))), Norm(2))
+	assert.Nil(err)
+	_, err = Cond(New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6))), Norm(1))
+	assert.NotNil(err)
+	_, err = Cond(A, Norm(0))
+	assert.NotNil(err)
+}
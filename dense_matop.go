@@ -1,6 +1,9 @@
 package tensor
 
 import (
+	"math/cmplx"
+	"sort"
+
 	"github.com/pkg/errors"
 )
 
@@ -68,6 +71,11 @@ func (t *Dense) UT() {
 
 // SafeT is exactly like T(), except it returns a new *Dense. The data is also copied over, unmoved.
 func (t *Dense) SafeT(axes ...int) (retVal *Dense, err error) {
+	// the copy reads the data as it is in memory, so the values of a conjugated view are conjugated first
+	if t.IsConjugated() {
+		t = t.Materialize().(*Dense)
+	}
+
 	var transform AP
 	if transform, axes, err = t.AP.T(axes...); err != nil {
 		if err = handleNoOp(err); err != nil {
@@ -87,6 +95,66 @@ func (t *Dense) SafeT(axes ...int) (retVal *Dense, err error) {
 	return
 }
 
+// H returns the conjugate transpose of a matrix as a view that shares the data of t.
+// Like T(), no data is moved. The values are conjugated lazily: At and Materialize conjugate them, and MatMul passes the conjugation on to BLAS.
+// The view is read-only. Concat, Stack and Index materialize it, while the elementwise operations refuse it, so it should be materialized to be used with them.
+// For real valued matrices, H is just a transpose.
+func (t *Dense) H() (retVal *Dense, err error) {
+	if t.Dims() != 2 {
+		return nil, errors.Errorf(dimMismatch, 2, t.Dims())
+	}
+	if t.IsMaterializable() {
+		t = t.Materialize().(*Dense)
+	}
+
+	transform, axes, err := t.AP.T()
+	noop := err != nil
+	if err = handleNoOp(err); err != nil {
+		return nil, err
+	}
+
+	retVal = borrowDense()
+	retVal.t = t.t
+	retVal.e = t.e
+	retVal.oe = t.oe
+	retVal.flag = t.flag
+	retVal.setParentTensor(t)
+	t.sliceInto(0, t.len(), &retVal.array)
+	if t.IsMasked() {
+		retVal.mask = t.mask
+	}
+
+	if noop {
+		t.AP.CloneTo(&retVal.AP)
+	} else {
+		retVal.AP = transform
+		t.AP.CloneTo(&retVal.old)
+		retVal.transposeWith = axes
+	}
+
+	retVal.flag = MakeMemoryFlag(retVal.flag, ReadOnly)
+	if t.t == Complex64 || t.t == Complex128 {
+		retVal.flag = MakeMemoryFlag(retVal.flag, Conjugated)
+	}
+	return retVal, nil
+}
+
+// conjInPlace conjugates the values of a complex tensor that owns its data.
+func conjInPlace(t *Dense) {
+	switch t.t {
+	case Complex64:
+		data := t.Complex64s()
+		for i, v := range data {
+			data[i] = complex(real(v), -imag(v))
+		}
+	case Complex128:
+		data := t.Complex128s()
+		for i, v := range data {
+			data[i] = cmplx.Conj(v)
+		}
+	}
+}
+
 // At returns the value at the given coordinate
 func (t *Dense) At(coords ...int) (interface{}, error) {
 	if !t.IsNativelyAccessible() {
@@ -101,7 +169,20 @@ func (t *Dense) At(coords ...int) (interface{}, error) {
 		return nil, errors.Wrap(err, "At()")
 	}
 
-	return t.Get(at), nil
+	return t.getValue(at), nil
+}
+
+// getValue returns the value at index i of the underlying array as seen through t, which is conjugated for conjugated views.
+func (t *Dense) getValue(i int) interface{} {
+	if t.IsConjugated() {
+		switch v := t.Get(i).(type) {
+		case complex64:
+			return complex(real(v), -imag(v))
+		case complex128:
+			return cmplx.Conj(v)
+		}
+	}
+	return t.Get(i)
 }
 
 // MaskAt returns the value of the mask at a given coordinate
@@ -130,6 +211,9 @@ func (t *Dense) SetAt(v interface{}, coords ...int) error {
 	if !t.IsNativelyAccessible() {
 		return errors.Errorf(inaccessibleData, t)
 	}
+	if t.IsReadOnly() {
+		return errors.Errorf(readOnlyData, t)
+	}
 
 	if len(coords) != t.Dims() {
 		return errors.Errorf(dimMismatch, t.Dims(), len(coords))
@@ -187,6 +271,9 @@ func (t *Dense) CopyTo(other *Dense) error {
 	if other.Size() != t.Size() {
 		return errors.Errorf(sizeMismatch, t.Size(), other.Size())
 	}
+	if other.IsReadOnly() {
+		return errors.Errorf(readOnlyData, other)
+	}
 
 	// easy peasy lemon squeezy
 	if t.viewOf == 0 && other.viewOf == 0 {
@@ -315,8 +402,270 @@ func (t *Dense) RollAxis(axis, start int, safe bool) (retVal *Dense, err error)
 	return
 }
 
+// Squeeze returns a view of the tensor with the given axes of size 1 removed. If no axes are given, all the axes of size 1 are removed.
+// Negative axes count from the last axis.
+func (t *Dense) Squeeze(axes ...int) (retVal *Dense, err error) {
+	dims := t.Dims()
+	if len(axes) == 0 {
+		for i, s := range t.Shape() {
+			if s == 1 {
+				axes = append(axes, i)
+			}
+		}
+	} else if axes, err = resolveAxes(axes, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Squeeze")
+	}
+
+	shape := make(Shape, 0, dims-len(axes))
+	strides := make([]int, 0, dims-len(axes))
+	for i, s := range t.Shape() {
+		if j := sort.SearchInts(axes, i); j < len(axes) && axes[j] == i {
+			if s != 1 {
+				return nil, errors.Errorf("Cannot squeeze axis %d of %v which is not of size 1", i, t.Shape())
+			}
+			continue
+		}
+		shape = append(shape, s)
+		strides = append(strides, t.strides[i])
+	}
+	return t.viewWithAP(shape, strides, t.o), nil
+}
+
+// ExpandDims returns a view of the tensor with a new axis of size 1 inserted at the given position.
+// A negative axis counts from the end of the resulting shape, so ExpandDims(-1) appends a new axis.
+func (t *Dense) ExpandDims(axis int) (retVal *Dense, err error) {
+	dims := t.Dims()
+	if axis > dims || axis < -dims-1 {
+		return nil, errors.Errorf(invalidAxis, axis, dims+1)
+	}
+	axis = resolveAxis(axis, dims+1)
+
+	// the stride of an axis of size 1 is never used to move about, but it keeps contiguous tensors looking contiguous
+	stride := 1
+	if axis < dims {
+		stride = t.strides[axis] * t.Shape()[axis]
+	}
+
+	shape := make(Shape, 0, dims+1)
+	shape = append(shape, t.Shape()[:axis]...)
+	shape = append(shape, 1)
+	shape = append(shape, t.Shape()[axis:]...)
+	strides := make([]int, 0, dims+1)
+	strides = append(strides, t.strides[:axis]...)
+	strides = append(strides, stride)
+	strides = append(strides, t.strides[axis:]...)
+	return t.viewWithAP(shape, strides, t.o), nil
+}
+
+// Flatten merges the axes from start to end (both inclusive) into a single axis. Negative axes count from the last axis.
+//
+// If the strides allow, the result is a view. Otherwise the data is copied.
+func (t *Dense) Flatten(start, end int) (retVal *Dense, err error) {
+	dims := t.Dims()
+	if dims == 0 {
+		return t.ExpandDims(0)
+	}
+	var axes []int
+	if axes, err = resolveAxes([]int{start}, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Flatten")
+	}
+	start = axes[0]
+	if axes, err = resolveAxes([]int{end}, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Flatten")
+	}
+	end = axes[0]
+	if start > end {
+		return nil, errors.Errorf("Cannot flatten from axis %d to axis %d", start, end)
+	}
+
+	s := t.Shape()
+	shape := make(Shape, 0, dims-(end-start))
+	shape = append(shape, s[:start]...)
+	shape = append(shape, ProdInts([]int(s[start:end+1])))
+	shape = append(shape, s[end+1:]...)
+
+	// the axes can be merged if each one steps over exactly one run of the axes inside it. Axes of size 1 do not matter.
+	mergeable := t.o.IsRowMajor()
+	merged, run := 1, 1
+	for i := end; i >= start && mergeable; i-- {
+		if s[i] == 1 {
+			continue
+		}
+		if run == 1 {
+			merged = t.strides[i]
+		} else if t.strides[i] != merged*run {
+			mergeable = false
+		}
+		run *= s[i]
+	}
+
+	if mergeable {
+		strides := make([]int, 0, len(shape))
+		strides = append(strides, t.strides[:start]...)
+		strides = append(strides, merged)
+		strides = append(strides, t.strides[end+1:]...)
+		return t.viewWithAP(shape, strides, t.o), nil
+	}
+
+	retVal = recycledDense(t.t, s.Clone(), WithEngine(t.e))
+	if _, err = copyDenseIter(retVal, t, nil, nil); err != nil {
+		return nil, errors.Wrapf(err, opFail, "Flatten")
+	}
+	return retVal, retVal.Reshape(shape...)
+}
+
+// MoveAxis returns a view of the tensor with the axis src moved to the position dst. The other axes keep their order.
+// Negative axes count from the last axis.
+func (t *Dense) MoveAxis(src, dst int) (retVal *Dense, err error) {
+	dims := t.Dims()
+	var axes []int
+	if axes, err = resolveAxes([]int{src}, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, "MoveAxis")
+	}
+	src = axes[0]
+	if axes, err = resolveAxes([]int{dst}, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, "MoveAxis")
+	}
+	dst = axes[0]
+
+	pattern := make([]int, 0, dims)
+	for i := 0; i < dims; i++ {
+		if i != src {
+			pattern = append(pattern, i)
+		}
+	}
+	pattern = append(pattern, 0)
+	copy(pattern[dst+1:], pattern[dst:])
+	pattern[dst] = src
+	return t.permuteView(pattern), nil
+}
+
+// SwapAxes returns a view of the tensor with the two axes swapped. Negative axes count from the last axis.
+func (t *Dense) SwapAxes(a, b int) (retVal *Dense, err error) {
+	dims := t.Dims()
+	var axes []int
+	if axes, err = resolveAxes([]int{a}, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, "SwapAxes")
+	}
+	a = axes[0]
+	if axes, err = resolveAxes([]int{b}, dims); err != nil {
+		return nil, errors.Wrapf(err, opFail, "SwapAxes")
+	}
+	b = axes[0]
+
+	pattern := make([]int, dims)
+	for i := range pattern {
+		pattern[i] = i
+	}
+	pattern[a], pattern[b] = b, a
+	return t.permuteView(pattern), nil
+}
+
+// Expand returns a view of t broadcast to the given shape, without copying any data. Like in Numpy, the axes of t are aligned
+// with the last axes of shape, and the axes of size 1 are repeated by giving them a stride of 0. A size of -1 keeps the size of the aligned axis.
+//
+// Because several of its elements share memory, a view that broadcasts is read-only: writing to it returns an error.
+// Materialize it to get a copy that can be written to.
+func (t *Dense) Expand(shape ...int) (retVal *Dense, err error) {
+	dims := t.Dims()
+	if len(shape) < dims {
+		return nil, errors.Errorf("Cannot expand %v to %v, which has fewer dimensions", t.Shape(), Shape(shape))
+	}
+	target := make(Shape, len(shape))
+	strides := make([]int, len(shape))
+	off := len(shape) - dims
+	var broadcast bool
+	for i, n := range shape {
+		if i < off {
+			if n < 1 {
+				return nil, errors.Errorf("Cannot expand %v to %v", t.Shape(), Shape(shape))
+			}
+			target[i] = n
+			broadcast = broadcast || n > 1
+			continue
+		}
+		size := t.Shape()[i-off]
+		switch {
+		case n == -1 || n == size:
+			target[i] = size
+			if i-off < len(t.strides) {
+				strides[i] = t.strides[i-off]
+			}
+		case size == 1 && n > 1:
+			target[i] = n
+			broadcast = true
+		default:
+			return nil, errors.Errorf("Cannot expand %v to %v", t.Shape(), Shape(shape))
+		}
+	}
+	if !broadcast {
+		return t.viewWithAP(target, strides, t.o), nil
+	}
+	retVal = t.viewWithAP(target, strides, MakeDataOrder(t.o, NonContiguous))
+	retVal.flag = MakeMemoryFlag(retVal.flag, ReadOnly)
+	return retVal, nil
+}
+
+// Diagonal returns a view of the offset-th diagonals of the planes of t spanned by axis1 and axis2. Like in Numpy,
+// both axes are removed, and an axis that runs along the diagonals is appended. A positive offset picks a diagonal
+// above the main one, and a negative offset one below it. Negative axes count from the last axis.
+func (t *Dense) Diagonal(offset, axis1, axis2 int) (retVal *Dense, err error) {
+	dims := t.Dims()
+	if dims < 2 {
+		return nil, errors.Errorf(atleastDims, 2)
+	}
+	for _, a := range []int{axis1, axis2} {
+		if a >= dims || a < -dims {
+			return nil, errors.Errorf(invalidAxis, a, dims)
+		}
+	}
+	axis1, axis2 = resolveAxis(axis1, dims), resolveAxis(axis2, dims)
+	if axis1 == axis2 {
+		return nil, errors.Errorf(repeatedAxis, axis1)
+	}
+
+	r, c := t.Shape()[axis1], t.Shape()[axis2]
+	rs, cs := t.strides[axis1], t.strides[axis2]
+	var n, start int
+	if offset >= 0 {
+		n, start = MinInt(r, c-offset), offset*cs
+	} else {
+		n, start = MinInt(r+offset, c), -offset*rs
+	}
+	if n < 1 {
+		return nil, errors.Errorf("Offset %d is out of range for the diagonals of (%d, %d) planes", offset, r, c)
+	}
+
+	shape := make(Shape, 0, dims-1)
+	strides := make([]int, 0, dims-1)
+	for a, size := range t.Shape() {
+		if a != axis1 && a != axis2 {
+			shape = append(shape, size)
+			strides = append(strides, t.strides[a])
+		}
+	}
+	shape = append(shape, n)
+	strides = append(strides, rs+cs)
+	return t.viewAt(start, shape, strides, packedOrder(shape, strides, t.o)), nil
+}
+
 /* Private Methods */
 
+// permuteView returns a view whose i-th axis is the pattern[i]-th axis of t.
+func (t *Dense) permuteView(pattern []int) *Dense {
+	shape := make(Shape, len(pattern))
+	strides := make([]int, len(pattern))
+	o := t.o
+	for i, p := range pattern {
+		shape[i] = t.Shape()[p]
+		strides[i] = t.strides[p]
+		if p != i && shape[i] != 1 {
+			o = MakeDataOrder(o, NonContiguous)
+		}
+	}
+	return t.viewWithAP(shape, strides, o)
+}
+
 // returns the new index given the old index
 func (t *Dense) transposeIndex(i int, transposePat, strides []int) int {
 	oldCoord, err := Itol(i, t.oshape(), t.ostrides())
@@ -347,7 +696,10 @@ func (t *Dense) transposeIndex(i int, transposePat, strides []int) int {
 //		at = ndarray.strides[0]*i + ndarray.strides[1]*j
 // This is of course, extensible to any number of dimensions.
 func (t *Dense) at(coords ...int) (at int, err error) {
-	return Ltoi(t.Shape(), t.Strides(), coords...)
+	if at, err = Ltoi(t.Shape(), t.Strides(), coords...); err != nil {
+		return
+	}
+	return at + t.origin(), nil
 }
 
 // maskat returns the mask index at which the coordinate is referring to.
@@ -17,6 +17,11 @@ func (t *Dense) Transpose() error {
 		return nil // cannot transpose scalars - no data movement
 	}
 
+	// the data of read only views, such as conjugate transposes, belongs to another tensor
+	if err := checkWritable(t); err != nil {
+		return err
+	}
+
 	defer func() {
 		t.old.zero()
 		t.transposeWith = nil
@@ -79,6 +84,57 @@ func (t *Dense) Concat(axis int, Ts ...*Dense) (retVal *Dense, err error) {
 	return nil, errors.New("Engine does not support Concat")
 }
 
+// Flip reverses the order of the values along the given axes, or along all the axes if none are given.
+// With the default engine, the result is a view with negative strides, like a slice with a negative step.
+func (t *Dense) Flip(axes ...int) (retVal *Dense, err error) {
+	if f, ok := t.Engine().(Flipper); ok {
+		var ret Tensor
+		if ret, err = f.Flip(t, axes...); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Flip")
+		}
+		return ret.(*Dense), nil
+	}
+	return nil, errors.New("Engine does not support Flip")
+}
+
+// Rot90 rotates the tensor by 90 degrees k times, in the plane of the given axes. Like Numpy's rot90(),
+// the rotation is in the direction from the first axis towards the second. A negative k rotates the other way.
+func (t *Dense) Rot90(k, axis1, axis2 int) (retVal *Dense, err error) {
+	if f, ok := t.Engine().(Flipper); ok {
+		var ret Tensor
+		if ret, err = f.Rot90(t, k, axis1, axis2); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Rot90")
+		}
+		return ret.(*Dense), nil
+	}
+	return nil, errors.New("Engine does not support Rot90")
+}
+
+// Roll shifts the values of the tensor by shift places along the given axes, wrapping around at the end.
+// If no axes are given, the tensor is rolled as if it were flattened. Not to be confused with RollAxis, which moves an axis.
+func (t *Dense) Roll(shift int, axes ...int) (retVal *Dense, err error) {
+	if r, ok := t.Engine().(Roller); ok {
+		var ret Tensor
+		if ret, err = r.Roll(t, shift, axes...); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Roll")
+		}
+		return ret.(*Dense), nil
+	}
+	return nil, errors.New("Engine does not support Roll")
+}
+
+// Tile repeats the whole tensor reps[i] times along the i-th axis. It is like Numpy's tile() function.
+func (t *Dense) Tile(reps ...int) (retVal *Dense, err error) {
+	if tl, ok := t.Engine().(Tiler); ok {
+		var ret Tensor
+		if ret, err = tl.Tile(t, reps...); err != nil {
+			return nil, errors.Wrapf(err, opFail, "Tile")
+		}
+		return ret.(*Dense), nil
+	}
+	return nil, errors.New("Engine does not support Tile")
+}
+
 // Hstack stacks other tensors columnwise (horizontal stacking)
 func (t *Dense) Hstack(others ...*Dense) (*Dense, error) {
 	// check that everything is at least 1D
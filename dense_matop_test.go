@@ -545,7 +545,7 @@ var denseSliceTests = []struct {
 	// colvec
 	{"c[0]", Range(Int64, 0, 5), Shape{5, 1}, []Slice{ss(0)}, ScalarShape(), nil, int64(0)},
 	{"c[0:2]", Range(Float32, 0, 5), Shape{5, 1}, []Slice{makeRS(0, 2)}, Shape{2, 1}, []int{1, 1}, []float32{0, 1}},
-	{"c[1:5:2]", Range(Float64, 0, 5), Shape{5, 1}, []Slice{makeRS(0, 5, 2)}, Shape{2, 1}, []int{2, 1}, []float64{0, 1, 2, 3, 4}},
+	{"c[1:5:2]", Range(Float64, 0, 5), Shape{5, 1}, []Slice{makeRS(0, 5, 2)}, Shape{3, 1}, []int{2, 1}, []float64{0, 1, 2, 3, 4}},
 
 	// // rowvec
 	{"r[0]", Range(Float64, 0, 5), Shape{1, 5}, []Slice{ss(0)}, Shape{1, 5}, []int{1}, []float64{0, 1, 2, 3, 4}},
@@ -640,6 +640,155 @@ func TestDense_Slice(t *testing.T) {
 	}
 }
 
+func TestDense_Slice_negative(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(6), WithBacking(Range(Float64, 0, 6)))
+
+	V, err := T.Slice(S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{6}, V.Shape())
+	assert.Equal([]int{-1}, V.Strides())
+	assert.Equal([]float64{5, 4, 3, 2, 1, 0}, V.(*Dense).Materialize().Data())
+
+	V, err = T.Slice(S(-3, Open))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{3, 4, 5}, V.Data())
+
+	V, err = T.Slice(S(4, Open, -2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{-2}, V.Strides())
+	assert.Equal([]float64{4, 2, 0}, V.(*Dense).Materialize().Data())
+
+	V, err = T.Slice(S(-1, 1, -2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{5, 3}, V.(*Dense).Materialize().Data())
+
+	// 2D
+	M := New(WithShape(3, 4), WithBacking(Range(Float64, 0, 12)))
+	V, err = M.Slice(S(Open, Open, -1), S(1, Open, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, V.Shape())
+	assert.Equal([]int{-4, 2}, V.Strides())
+	assert.Equal([]float64{9, 11, 5, 7, 1, 3}, V.(*Dense).Materialize().Data())
+	at, err := V.At(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(5.0, at)
+
+	// slicing a reversed view again
+	W, err := V.Slice(S(1, Open), S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{7, 5, 3, 1}, W.(*Dense).Materialize().Data())
+	W, err = V.Slice(nil, ss(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{11, 7, 3}, W.(*Dense).Materialize().Data())
+
+	// writes go through to the parent
+	if err = V.SetAt(100.0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(100.0, M.Float64s()[9])
+	M.Float64s()[9] = 9
+
+	// iteration by the engine
+	R, err := Sum(V, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{15, 21}, R.Data())
+
+	// masks follow along
+	MT := New(WithShape(4), WithBacking([]float64{1, 2, 3, 4}, []bool{true, false, false, true}))
+	V, err = MT.Slice(S(2, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	C := V.(*Dense).Materialize().(*Dense)
+	assert.Equal([]float64{3, 2, 1}, C.Data())
+	assert.Equal([]bool{false, false, true}, C.Mask())
+
+	// positive steps that do not divide the length keep their last element, along the first axis too
+	vec := New(WithShape(3), WithBacking([]float64{1, 2, 4}))
+	V, err = vec.Slice(S(0, 3, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2}, V.Shape())
+	assert.Equal([]float64{1, 4}, V.(*Dense).Materialize().Data())
+	D, err := Diff(V, 1, 0, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{3}, D.(*Dense).Float64s())
+
+	// errors
+	_, err = T.Slice(S(1, 3, -1))
+	assert.NotNil(err)
+	_, err = T.Slice(S(-7, Open))
+	assert.NotNil(err)
+}
+
+func TestDense_Slice_NewAxisEllipsis(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3, 4), WithBacking(Range(Float64, 0, 24)))
+
+	V, err := T.Slice(Ellipsis, ss(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, V.Shape())
+	assert.Equal([]float64{1, 5, 9, 13, 17, 21}, V.(*Dense).Materialize().Data())
+
+	V, err = T.Slice(ss(1), Ellipsis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 4}, V.Shape())
+	assert.Equal(Range(Float64, 12, 24), V.Data())
+
+	V, err = T.Slice(NewAxis, ss(0), Ellipsis, NewAxis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 3, 4, 1}, V.Shape())
+	assert.Equal(Range(Float64, 0, 12), V.(*Dense).Materialize().Data())
+
+	M := New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6)))
+	V, err = M.Slice(Ellipsis, NewAxis, S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 1, 3}, V.Shape())
+	assert.Equal([]float64{2, 1, 0, 5, 4, 3}, V.(*Dense).Materialize().Data())
+
+	V, err = M.Slice(ss(1), ss(2), NewAxis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1}, V.Shape())
+	assert.Equal([]float64{5}, V.(*Dense).Materialize().Data())
+
+	_, err = T.Slice(Ellipsis, ss(0), Ellipsis)
+	assert.NotNil(err)
+	_, err = M.Slice(ss(0), ss(0), ss(0), Ellipsis)
+	assert.NotNil(err)
+}
+
 func TestDense_Narrow(t *testing.T) {
 	testCases := []struct {
 		x                  *Dense
@@ -1177,3 +1326,691 @@ func TestDense_Stack(t *testing.T) {
 	assert.True(correctShape.Eq(T2.Shape()))
 	assert.Equal(correctData, T2.Data(), "%q failed", "arbitrary view slice")
 }
+
+func TestDense_Squeeze(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(1, 3, 1, 2), WithBacking(Range(Float64, 0, 6)))
+
+	V, err := T.Squeeze()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, V.Shape())
+	assert.Equal([]int{2, 1}, V.Strides())
+	V.SetAt(100.0, 2, 1)
+	assert.Equal(100.0, T.Data().([]float64)[5], "Squeeze should return a view")
+
+	V, err = T.Squeeze(-2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 3, 2}, V.Shape())
+
+	_, err = T.Squeeze(1)
+	assert.NotNil(err)
+	_, err = T.Squeeze(4)
+	assert.NotNil(err)
+
+	// package level
+	V2, err := Squeeze(T, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, V2.Shape())
+}
+
+func TestDense_ExpandDims(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6)))
+
+	shapes := []struct {
+		axis  int
+		shape Shape
+	}{
+		{0, Shape{1, 2, 3}},
+		{1, Shape{2, 1, 3}},
+		{2, Shape{2, 3, 1}},
+		{-1, Shape{2, 3, 1}},
+		{-3, Shape{1, 2, 3}},
+	}
+	for _, s := range shapes {
+		V, err := T.ExpandDims(s.axis)
+		if err != nil {
+			t.Errorf("axis %d: %v", s.axis, err)
+			continue
+		}
+		assert.Equal(s.shape, V.Shape(), "axis %d", s.axis)
+		assert.True(V.DataOrder().IsContiguous(), "axis %d", s.axis)
+		assert.Equal(T.Data(), V.Materialize().Data(), "axis %d", s.axis)
+	}
+
+	_, err := T.ExpandDims(3)
+	assert.NotNil(err)
+	_, err = T.ExpandDims(-4)
+	assert.NotNil(err)
+
+	V, err := Unsqueeze(T, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	V.(*Dense).SetAt(100.0, 1, 0, 0)
+	assert.Equal(100.0, T.Data().([]float64)[3], "ExpandDims should return a view")
+}
+
+func TestDense_Flatten(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3, 4), WithBacking(Range(Float64, 0, 24)))
+
+	V, err := T.Flatten(1, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 12}, V.Shape())
+	assert.Equal([]int{12, 1}, V.Strides())
+	V.SetAt(100.0, 1, 0)
+	assert.Equal(100.0, T.Data().([]float64)[12], "Flatten should return a view")
+
+	V, err = T.Flatten(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{6, 4}, V.Shape())
+
+	// a slice along the last axis can still have its outer axes merged
+	T = New(WithShape(2, 3, 4), WithBacking(Range(Float64, 0, 24)))
+	S, err := T.Slice(nil, nil, makeRS(0, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	V, err = S.(*Dense).Flatten(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{6, 2}, V.Shape())
+	assert.True(V.IsView())
+	assert.Equal([]float64{0, 1, 4, 5, 8, 9, 12, 13, 16, 17, 20, 21}, V.Materialize().Data())
+
+	// ... but not its inner axes, so the data is copied
+	V, err = S.(*Dense).Flatten(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 6}, V.Shape())
+	assert.False(V.IsView())
+	assert.Equal([]float64{0, 1, 4, 5, 8, 9, 12, 13, 16, 17, 20, 21}, V.Data())
+
+	// transposed
+	T = New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6)))
+	T.T()
+	V, err = T.Flatten(0, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{6}, V.Shape())
+	assert.Equal([]float64{0, 3, 1, 4, 2, 5}, V.Data())
+
+	// errors
+	_, err = T.Flatten(1, 0)
+	assert.NotNil(err)
+	_, err = T.Flatten(0, 2)
+	assert.NotNil(err)
+
+	F, err := Flatten(New(WithShape(2, 1, 3), Of(Int)), 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{6}, F.Shape())
+}
+
+func TestDense_MoveAxis(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3, 4), WithBacking(Range(Float64, 0, 24)))
+
+	V, err := T.MoveAxis(0, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 4, 2}, V.Shape())
+	assert.Equal([]int{4, 1, 12}, V.Strides())
+	assert.False(V.DataOrder().IsContiguous())
+	T2, _ := T.SafeT(1, 2, 0)
+	T2.Transpose()
+	assert.Equal(T2.Data(), V.Materialize().Data())
+
+	V, err = T.MoveAxis(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{4, 2, 3}, V.Shape())
+	assert.Equal([]int{1, 12, 4}, V.Strides())
+
+	V, err = T.MoveAxis(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(V.DataOrder().IsContiguous())
+
+	_, err = T.MoveAxis(3, 0)
+	assert.NotNil(err)
+
+	V2, err := MoveAxis(T, -1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{4, 2, 3}, V2.Shape())
+}
+
+func TestDense_SwapAxes(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6)))
+
+	V, err := T.SwapAxes(0, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, V.Shape())
+	assert.Equal([]float64{0, 3, 1, 4, 2, 5}, V.Materialize().Data())
+	V.SetAt(100.0, 2, 1)
+	assert.Equal(100.0, T.Data().([]float64)[5], "SwapAxes should return a view")
+
+	_, err = T.SwapAxes(0, 2)
+	assert.NotNil(err)
+
+	V2, err := SwapAxes(T, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, V2.Shape())
+}
+
+func TestDense_Expand(t *testing.T) {
+	assert := assert.New(t)
+	R := New(WithShape(1, 3), WithBacking([]float64{1, 2, 3}))
+
+	B, err := R.Expand(2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, B.Shape())
+	assert.Equal([]int{0, 1}, B.Strides())
+	assert.True(B.IsReadOnly())
+	assert.Equal([]float64{1, 2, 3, 1, 2, 3}, B.Materialize().Data())
+	assert.False(B.Materialize().(*Dense).IsReadOnly(), "a materialized broadcast view should be writable")
+	cl := B.Clone().(*Dense)
+	assert.Equal([]float64{1, 2, 3, 1, 2, 3}, cl.Data())
+	assert.Equal([]int{3, 1}, cl.Strides())
+	assert.False(cl.IsReadOnly(), "a clone of a broadcast view should be writable")
+	assert.Nil(cl.SetAt(10.0, 1, 0))
+	assert.Equal([]float64{1, 2, 3}, R.Data())
+	at, err := B.At(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3.0, at)
+
+	// the view shares its data
+	R.Float64s()[0] = 10
+	assert.Equal([]float64{10, 2, 3, 10, 2, 3}, B.Materialize().Data())
+	R.Float64s()[0] = 1
+
+	// new leading axes, with -1 keeping a size
+	B, err = R.Expand(2, 2, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2, 3}, B.Shape())
+	assert.Equal([]float64{1, 2, 3, 1, 2, 3, 1, 2, 3, 1, 2, 3}, B.Materialize().Data())
+
+	// columns, from a reversed view
+	C := New(WithShape(3), WithBacking([]int{1, 2, 3}))
+	V, err := C.Slice(S(Open, Open, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	V, err = V.(*Dense).ExpandDims(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	BT, err := BroadcastTo(V, 3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{3, 3, 2, 2, 1, 1}, BT.(*Dense).Materialize().Data())
+
+	// reading from broadcast views works, as does writing into a reuse tensor
+	x := New(WithShape(2, 3), WithBacking([]float64{10, 20, 30, 40, 50, 60}))
+	B, _ = R.Expand(2, 3)
+	sum, err := Add(x, B)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{11, 22, 33, 41, 52, 63}, sum.Data())
+	sum, err = Add(B, x, WithReuse(New(WithShape(2, 3), Of(Float64))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{11, 22, 33, 41, 52, 63}, sum.Data())
+	s, err := Sum(B, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{2, 4, 6}, s.Data())
+
+	// safe operations allocate their result, whichever the operand
+	sum, err = Add(B, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{11, 22, 33, 41, 52, 63}, sum.Data())
+	prod, err := Mul(B, 2.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{2, 4, 6, 2, 4, 6}, prod.Data())
+	diff, err := Sub(10.0, B)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{9, 8, 7, 9, 8, 7}, diff.Data())
+	neg, err := Neg(B)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{-1, -2, -3, -1, -2, -3}, neg.Data())
+	gt, err := Gt(B, New(WithShape(2, 3), WithBacking([]float64{0, 5, 0, 5, 0, 5})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, false, true, false, true, false}, gt.Data())
+	yT := New(WithShape(3, 2), WithBacking([]float64{1, 0, 0, 1, 1, 1}))
+	mm, err := MatMul(B, yT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{4, 5, 4, 5}, mm.Data())
+	mv, err := MatVecMul(B, New(WithShape(3), WithBacking([]float64{1, 1, 1})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{6, 6}, mv.Data())
+	assert.Equal([]float64{1, 2, 3}, R.Data())
+	one, err := New(WithShape(1), WithBacking([]float64{2})).Expand(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err = Add(one, New(WithShape(3), WithBacking([]float64{1, 2, 3})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{3, 4, 5}, sum.Data())
+
+	// writes fail
+	assert.NotNil(B.SetAt(5.0, 0, 0))
+	assert.NotNil(B.Memset(5.0))
+	assert.NotNil(Copy(B, x))
+	assert.NotNil(IndexPut(B, 5.0, 0))
+	assert.Panics(func() { B.Set(0, 5.0) })
+	assert.Panics(func() { B.Zero() })
+	_, err = Add(B, 1.0, UseUnsafe())
+	assert.NotNil(err)
+	_, err = Neg(B, UseUnsafe())
+	assert.NotNil(err)
+	_, err = Gt(B, x, UseUnsafe())
+	assert.NotNil(err)
+	_, err = Add(B, x, WithIncr(New(WithShape(2, 3), Of(Float64))))
+	assert.NotNil(err)
+	_, err = Add(x, x, WithReuse(B))
+	assert.NotNil(err)
+	assert.Equal([]float64{1, 2, 3}, R.Data())
+
+	// a view that does not broadcast can be written to
+	same, err := R.Expand(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(same.IsReadOnly())
+
+	_, err = R.Expand(3)
+	assert.NotNil(err)
+	_, err = R.Expand(2, 4)
+	assert.NotNil(err)
+	_, err = R.Expand(-1, 1, 3)
+	assert.NotNil(err)
+}
+
+func TestSplit(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(4, 3), WithBacking(Range(Float64, 0, 12)))
+
+	pieces, err := Split(T, 0, []int{1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2, len(pieces))
+	assert.Equal(Shape{1, 3}, pieces[0].Shape())
+	assert.Equal(Shape{3, 3}, pieces[1].Shape())
+	assert.True(pieces[1].DataOrder().IsContiguous())
+	assert.Equal([]float64{3, 4, 5, 6, 7, 8, 9, 10, 11}, pieces[1].Data())
+	pieces[0].SetAt(100.0, 0, 2)
+	assert.Equal(100.0, T.Data().([]float64)[2], "Split should return views")
+
+	// along the last axis, with a single size
+	T = New(WithShape(4, 3), WithBacking(Range(Float64, 0, 12)))
+	pieces, err = Split(T, -1, []int{2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2, len(pieces))
+	assert.Equal(Shape{4, 2}, pieces[0].Shape())
+	assert.Equal(Shape{4, 1}, pieces[1].Shape())
+	assert.False(pieces[0].DataOrder().IsContiguous())
+	assert.Equal([]float64{0, 1, 3, 4, 6, 7, 9, 10}, pieces[0].(*Dense).Materialize().Data())
+	assert.Equal([]float64{2, 5, 8, 11}, pieces[1].(*Dense).Materialize().Data())
+
+	// materialized pieces do not share data
+	pieces, err = Split(T, 1, []int{1, 2}, WithMaterialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(pieces[1].(*Dense).IsView())
+	assert.Equal([]float64{1, 2, 4, 5, 7, 8, 10, 11}, pieces[1].Data())
+	pieces[0].SetAt(100.0, 0, 0)
+	assert.Equal(0.0, T.Data().([]float64)[0])
+
+	// round trip through Concat
+	C, err := Concat(1, pieces[0], pieces[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{100, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, C.Data())
+
+	// masked
+	M := New(WithShape(4), WithBacking([]float64{1, 2, 3, 4}, []bool{false, true, false, true}))
+	pieces, err = Split(M, 0, []int{3, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true}, pieces[1].(*Dense).Mask()[:1])
+
+	// errors
+	_, err = Split(T, 0, []int{1, 2})
+	assert.NotNil(err)
+	_, err = Split(T, 0, []int{0, 4})
+	assert.NotNil(err)
+	_, err = Split(T, 2, []int{4})
+	assert.NotNil(err)
+}
+
+func TestChunk(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(7, 2), WithBacking(Range(Int, 0, 14)))
+	pieces, err := Chunk(T, 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3, len(pieces))
+	assert.Equal(Shape{3, 2}, pieces[0].Shape())
+	assert.Equal(Shape{2, 2}, pieces[1].Shape())
+	assert.Equal(Shape{2, 2}, pieces[2].Shape())
+	assert.Equal([]int{10, 11, 12, 13}, pieces[2].Data())
+
+	_, err = Chunk(T, 8, 0)
+	assert.NotNil(err)
+	_, err = Chunk(T, 2, 2)
+	assert.NotNil(err)
+}
+
+func TestUnstack(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6)))
+
+	pieces, err := Unstack(T, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3, len(pieces))
+	for i, p := range pieces {
+		assert.Equal(Shape{2}, p.Shape())
+		assert.Equal([]float64{float64(i), float64(i + 3)}, p.(*Dense).Materialize().Data())
+	}
+	pieces[2].SetAt(100.0, 1)
+	assert.Equal(100.0, T.Data().([]float64)[5], "Unstack should return views")
+
+	// round trip through Stack
+	pieces, err = Unstack(T, 0, WithMaterialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3}, pieces[0].Shape())
+	assert.False(pieces[0].(*Dense).IsView())
+	S, err := Stack(0, pieces[0], pieces[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(T.Data(), S.Data())
+
+	// vectors unstack into scalars
+	V := New(WithShape(3), WithBacking([]float64{1, 2, 3}))
+	pieces, err = Unstack(V, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(pieces[1].Shape().IsScalar())
+	assert.Equal(2.0, pieces[1].(*Dense).Materialize().Data())
+}
+
+func TestHsplitVsplit(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 4), WithBacking(Range(Float64, 0, 8)))
+
+	pieces, err := Hsplit(T, []int{2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2, len(pieces))
+	assert.Equal([]float64{2, 3, 6, 7}, pieces[1].(*Dense).Materialize().Data())
+
+	pieces, err = Vsplit(T, []int{1, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 4}, pieces[1].Shape())
+	assert.Equal([]float64{4, 5, 6, 7}, pieces[1].Data())
+
+	V := New(WithShape(4), WithBacking(Range(Float64, 0, 4)))
+	pieces, err = Hsplit(V, []int{1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3}, pieces[1].Shape())
+	_, err = Vsplit(V, []int{2})
+	assert.NotNil(err)
+}
+
+func TestDense_Flip(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6)))
+
+	F, err := T.Flip()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(F.IsView(), "flipping a *Dense should not copy")
+	assert.Equal([]int{-3, -1}, F.Strides())
+	assert.Equal([]float64{5, 4, 3, 2, 1, 0}, F.Materialize().Data())
+
+	F, err = T.Flip(-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, F.Shape())
+	assert.Equal([]float64{2, 1, 0, 5, 4, 3}, F.Materialize().Data())
+
+	F, err = T.Flip(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{3, 4, 5, 0, 1, 2}, F.Materialize().Data())
+
+	// writes go through to the flipped tensor
+	if err = F.SetAt(100.0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(100.0, T.Float64s()[3])
+	T.Float64s()[3] = 3
+
+	// flipping a flipped tensor gives it back
+	F, err = F.Flip(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(T.Data(), F.Materialize().Data())
+
+	// any dtype, from a view
+	S := New(WithShape(3, 2), WithBacking([]string{"a", "b", "c", "d", "e", "f"}))
+	V, err := S.Slice(nil, ss(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	F2, err := Flip(V)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]string{"e", "c", "a"}, F2.(*Dense).Materialize().Data())
+
+	// masks are flipped along
+	M := New(WithShape(3), WithBacking([]float64{1, 2, 3}, []bool{true, false, false}))
+	F2, err = Flip(M)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{false, false, true}, F2.(*Dense).Materialize().(*Dense).Mask())
+
+	_, err = T.Flip(2)
+	assert.NotNil(err)
+	_, err = T.Flip(0, -2)
+	assert.NotNil(err)
+}
+
+func TestDense_Rot90(t *testing.T) {
+	assert := assert.New(t)
+	// 1 2 3
+	// 4 5 6
+	T := New(WithShape(2, 3), WithBacking([]int{1, 2, 3, 4, 5, 6}))
+
+	rots := []struct {
+		k       int
+		shape   Shape
+		correct []int
+	}{
+		{0, Shape{2, 3}, []int{1, 2, 3, 4, 5, 6}},
+		{1, Shape{3, 2}, []int{3, 6, 2, 5, 1, 4}},
+		{2, Shape{2, 3}, []int{6, 5, 4, 3, 2, 1}},
+		{3, Shape{3, 2}, []int{4, 1, 5, 2, 6, 3}},
+		{-1, Shape{3, 2}, []int{4, 1, 5, 2, 6, 3}},
+		{5, Shape{3, 2}, []int{3, 6, 2, 5, 1, 4}},
+	}
+	for _, r := range rots {
+		R, err := T.Rot90(r.k, 0, 1)
+		if err != nil {
+			t.Errorf("k=%d: %v", r.k, err)
+			continue
+		}
+		assert.Equal(r.shape, R.Shape(), "k=%d", r.k)
+		assert.Equal(r.correct, R.Data(), "k=%d", r.k)
+	}
+
+	// reversing the axes reverses the direction
+	R, err := Rot90(T, 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{4, 1, 5, 2, 6, 3}, R.Data())
+
+	// planes of a 3D tensor
+	T3 := New(WithShape(2, 2, 2), WithBacking(Range(Int, 0, 8)))
+	R, err = Rot90(T3, 1, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 3, 0, 2, 5, 7, 4, 6}, R.Data())
+
+	_, err = T.Rot90(1, 0, 0)
+	assert.NotNil(err)
+	_, err = New(WithShape(3), Of(Int)).Rot90(1, 0, 1)
+	assert.NotNil(err)
+}
+
+func TestDense_Roll(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking(Range(Float64, 0, 6)))
+
+	R, err := T.Roll(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, R.Shape())
+	assert.Equal([]float64{5, 0, 1, 2, 3, 4}, R.Data())
+
+	R, err = T.Roll(1, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{2, 0, 1, 5, 3, 4}, R.Data())
+
+	R, err = T.Roll(-4, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{1, 2, 0, 4, 5, 3}, R.Data())
+
+	R2, err := Roll(T, 1, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{5, 3, 4, 2, 0, 1}, R2.Data())
+
+	// from a transposed tensor
+	T.T()
+	R, err = T.Roll(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, R.Shape())
+	assert.Equal([]float64{2, 5, 0, 3, 1, 4}, R.Data())
+
+	_, err = T.Roll(1, 2)
+	assert.NotNil(err)
+}
+
+func TestDense_Tile(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 2), WithBacking([]int{1, 2, 3, 4}))
+
+	R, err := T.Tile(2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{4, 2}, R.Shape())
+	assert.Equal([]int{1, 2, 3, 4, 1, 2, 3, 4}, R.Data())
+
+	// fewer reps than axes
+	R, err = T.Tile(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 4}, R.Shape())
+	assert.Equal([]int{1, 2, 1, 2, 3, 4, 3, 4}, R.Data())
+
+	// more reps than axes
+	V := New(WithShape(2), WithBacking([]bool{true, false}))
+	R2, err := Tile(V, 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 4}, R2.Shape())
+	assert.Equal([]bool{true, false, true, false, true, false, true, false}, R2.Data())
+
+	_, err = T.Tile(0, 1)
+	assert.NotNil(err)
+}
@@ -0,0 +1,160 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chewxy/math32"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNanReductions(t *testing.T) {
+	assert := assert.New(t)
+	nan := math.NaN()
+	T := New(WithShape(2, 3), WithBacking([]float64{1, nan, 3, nan, nan, -2}))
+
+	s, err := NanSum(T, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2.0, s.Data())
+
+	s, err = NanSum(T, false, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{1, 0, 1}, s.Data())
+
+	m, err := NanMean(T, false, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{2, -2}, m.Data())
+
+	// the columns with only NaNs have a NaN mean, max and min
+	m, err = NanMean(T, false, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(1.0, m.Data().([]float64)[0])
+	assert.True(math.IsNaN(m.Data().([]float64)[1]))
+	assert.Equal(0.5, m.Data().([]float64)[2])
+
+	max, err := NanMax(T, false, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{3, -2}, max.Data())
+	min, err := NanMin(T, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(-2.0, min.Data())
+	max, err = NanMax(T, false, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(math.IsNaN(max.Data().([]float64)[1]))
+
+	// several axes, like Sum
+	T3 := New(WithShape(2, 2, 2), WithBacking([]float32{1, 2, math32.NaN(), 4, 5, 6, 7, math32.NaN()}))
+	s, err = NanSum(T3, false, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2}, s.Shape())
+	assert.Equal([]float32{14, 11}, s.Data())
+	m, err = NanMean(T3, false, 0, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(float32(25)/6, m.Data())
+
+	// views
+	V, err := T.Slice(nil, S(1, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err = NanSum(V, false, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{3, -2}, s.Data())
+
+	_, err = NanSum(T, false, 2)
+	assert.NotNil(err)
+	_, err = NanSum(T, false, 1, 1)
+	assert.NotNil(err)
+	_, err = NanSum(New(WithShape(2), Of(Int)), false)
+	assert.NotNil(err)
+}
+
+func TestNanArgmethods(t *testing.T) {
+	assert := assert.New(t)
+	nan := math.NaN()
+	T := New(WithShape(2, 3), WithBacking([]float64{nan, 5, 3, 2, nan, 2}))
+
+	am, err := NanArgmax(T, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 0}, am.Data())
+	am, err = NanArgmin(T, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{2, 0}, am.Data())
+	am, err = NanArgmax(T, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 0, 0}, am.Data())
+	am, err = NanArgmin(T, AllAxes, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3, am.Data())
+
+	_, err = NanArgmax(New(WithShape(2), WithBacking([]float64{nan, nan})), 0, false)
+	assert.NotNil(err)
+	_, err = NanArgmax(T, 2, false)
+	assert.NotNil(err)
+}
+
+func TestNanReductions_masked(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 2), WithBacking([]float64{1, 100, math.NaN(), 4}, []bool{false, true, false, false}))
+
+	// the masked values are only skipped when asked to
+	s, err := NanSum(T, true, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{1, 4}, s.Data())
+	s, err = NanSum(T, false, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{101, 4}, s.Data())
+
+	m, err := NanMean(T, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2.5, m.Data())
+
+	am, err := NanArgmax(T, AllAxes, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3, am.Data())
+
+	M := New(WithShape(2), WithBacking([]float64{1, math.NaN()}, []bool{true, false}))
+	max, err := NanMax(M, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(math.IsNaN(max.Data().(float64)))
+	_, err = NanArgmin(M, 0, true)
+	assert.NotNil(err)
+}
@@ -0,0 +1,229 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// numGrad computes the numerical gradient of f at x by central differences.
+func numGrad(f func([]float64) float64, x []float64) []float64 {
+	const h = 1e-6
+	retVal := make([]float64, len(x))
+	for i := range x {
+		xp := append([]float64(nil), x...)
+		xm := append([]float64(nil), x...)
+		xp[i] += h
+		xm[i] -= h
+		retVal[i] = (f(xp) - f(xm)) / (2 * h)
+	}
+	return retVal
+}
+
+func dot(a, b []float64) (retVal float64) {
+	for i := range a {
+		retVal += a[i] * b[i]
+	}
+	return
+}
+
+func TestLayerNorm(t *testing.T) {
+	assert := assert.New(t)
+	x := New(WithShape(2, 4), WithBacking([]float64{1, 2, 3, 4, -1, -1, 1, 1}))
+	y, mean, invStd, err := LayerNorm(x, nil, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := 1 / math.Sqrt(1.25)
+	assert.InDeltaSlice([]float64{-1.5 * s, -0.5 * s, 0.5 * s, 1.5 * s, -1, -1, 1, 1}, y.Data(), 1e-12)
+	assert.Equal(Shape{2}, mean.Shape())
+	assert.Equal([]float64{2.5, 0}, mean.Data())
+	assert.InDeltaSlice([]float64{s, 1}, invStd.Data(), 1e-12)
+
+	scale := New(WithShape(4), WithBacking([]float64{1, 2, 3, 4}))
+	bias := New(WithShape(4), WithBacking([]float64{0, 0, 0, 10}))
+	y, _, _, err = LayerNorm(x, scale, bias, 0, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice([]float64{-1, -2, 3, 14}, y.Data().([]float64)[4:], 1e-12)
+
+	// float32
+	x32 := New(WithShape(2, 2), WithBacking([]float32{1, 3, 5, 5}))
+	y, _, _, err = LayerNorm(x32, nil, nil, 1e-5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice([]float32{-1, 1, 0, 0}, y.Data(), 1e-4)
+
+	// errors
+	_, _, _, err = LayerNorm(x, scale, nil, 0, 0)
+	assert.NotNil(err)
+	_, _, _, err = LayerNorm(x, nil, nil, 0, 2)
+	assert.NotNil(err)
+	_, _, _, err = LayerNorm(x, nil, nil, 0, 1, -1)
+	assert.NotNil(err)
+}
+
+func TestLayerNormB(t *testing.T) {
+	xs := Shape{2, 3, 4}
+	xBack := Random(Float64, xs.TotalSize()).([]float64)
+	sBack := Random(Float64, 8).([]float64)
+	bBack := Random(Float64, 8).([]float64)
+	grad := Random(Float64, xs.TotalSize()).([]float64)
+
+	// normalizing over the first and last axes exercises non-contiguous groups
+	axes := []int{0, 2}
+	ps := Shape{2, 4}
+	loss := func(x, s, b []float64) float64 {
+		y, _, _, err := LayerNorm(New(WithShape(xs...), WithBacking(x)), New(WithShape(ps...), WithBacking(s)), New(WithShape(ps...), WithBacking(b)), 1e-3, axes...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dot(y.Data().([]float64), grad)
+	}
+
+	x := New(WithShape(xs...), WithBacking(xBack))
+	scale := New(WithShape(ps...), WithBacking(sBack))
+	_, mean, invStd, err := LayerNorm(x, scale, nil, 1e-3, axes...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, Shape{3}, mean.Shape())
+	dx, ds, db, err := LayerNormB(x, scale, mean, invStd, New(WithShape(xs...), WithBacking(grad)), axes...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice(t, numGrad(func(v []float64) float64 { return loss(v, sBack, bBack) }, xBack), dx.Data(), 1e-6)
+	assert.InDeltaSlice(t, numGrad(func(v []float64) float64 { return loss(xBack, v, bBack) }, sBack), ds.Data(), 1e-6)
+	assert.InDeltaSlice(t, numGrad(func(v []float64) float64 { return loss(xBack, sBack, v) }, bBack), db.Data(), 1e-6)
+}
+
+func TestRMSNorm(t *testing.T) {
+	assert := assert.New(t)
+	x := New(WithShape(1, 2), WithBacking([]float64{3, 4}))
+	y, invRMS, err := RMSNorm(x, New(WithShape(2), WithBacking([]float64{1, 2})), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := math.Sqrt(12.5)
+	assert.InDeltaSlice([]float64{3 / r, 8 / r}, y.Data(), 1e-12)
+	assert.InDeltaSlice([]float64{1 / r}, invRMS.Data(), 1e-12)
+
+	xs := Shape{3, 5}
+	xBack := Random(Float64, xs.TotalSize()).([]float64)
+	sBack := Random(Float64, 5).([]float64)
+	grad := Random(Float64, xs.TotalSize()).([]float64)
+	loss := func(x, s []float64) float64 {
+		y, _, err := RMSNorm(New(WithShape(xs...), WithBacking(x)), New(WithShape(5), WithBacking(s)), 1e-3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dot(y.Data().([]float64), grad)
+	}
+	x = New(WithShape(xs...), WithBacking(xBack))
+	scale := New(WithShape(5), WithBacking(sBack))
+	_, invRMS, err = RMSNorm(x, scale, 1e-3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dx, ds, err := RMSNormB(x, scale, invRMS, New(WithShape(xs...), WithBacking(grad)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice(numGrad(func(v []float64) float64 { return loss(v, sBack) }, xBack), dx.Data(), 1e-6)
+	assert.InDeltaSlice(numGrad(func(v []float64) float64 { return loss(xBack, v) }, sBack), ds.Data(), 1e-6)
+}
+
+func TestBatchNorm(t *testing.T) {
+	assert := assert.New(t)
+	// (N, C, L) = (2, 2, 2)
+	x := New(WithShape(2, 2, 2), WithBacking([]float64{
+		1, 2, 10, 10,
+		3, 4, 20, 20,
+	}))
+	rm := New(WithShape(2), WithBacking([]float64{0, 0}))
+	rv := New(WithShape(2), WithBacking([]float64{1, 1}))
+	y, mean, invStd, err := BatchNorm(x, nil, nil, rm, rv, 1, 0.5, 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := 1 / math.Sqrt(1.25)
+	assert.InDeltaSlice([]float64{-1.5 * s, -0.5 * s, -1, -1, 0.5 * s, 1.5 * s, 1, 1}, y.Data(), 1e-12)
+	assert.Equal([]float64{2.5, 15}, mean.Data())
+	assert.InDeltaSlice([]float64{s, 0.2}, invStd.Data(), 1e-12)
+	assert.InDeltaSlice([]float64{1.25, 7.5}, rm.Data(), 1e-12)
+	assert.InDeltaSlice([]float64{0.5 + 0.5*1.25*4/3, 0.5 + 0.5*25*4/3}, rv.Data(), 1e-9)
+
+	// inference uses the running statistics
+	scale := New(WithShape(2), WithBacking([]float64{2, 1}))
+	bias := New(WithShape(2), WithBacking([]float64{0, 1}))
+	rm = New(WithShape(2), WithBacking([]float64{1, 10}))
+	rv = New(WithShape(2), WithBacking([]float64{4, 100}))
+	y, _, invStd, err = BatchNorm(x, scale, bias, rm, rv, 1, 0.1, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice([]float64{0, 1, 1, 1, 2, 3, 2, 2}, y.Data(), 1e-12)
+	assert.Equal([]float64{1, 10}, rm.Data(), "inference should not update the running statistics")
+
+	dx, ds, db, err := BatchNormB(x, scale, rm, invStd, Ones(Float64, 2, 2, 2), 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice([]float64{1, 1, 0.1, 0.1, 1, 1, 0.1, 0.1}, dx.Data(), 1e-12)
+	assert.InDeltaSlice([]float64{3, 2}, ds.Data(), 1e-12)
+	assert.Equal([]float64{4, 4}, db.Data())
+
+	// NHWC style channels-last in float32
+	x32 := New(WithShape(2, 2), WithBacking([]float32{1, 5, 3, 7}))
+	y, _, _, err = BatchNorm(x32, nil, nil, nil, nil, -1, 0.1, 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{-1, -1, 1, 1}, y.Data())
+
+	// a variance much smaller than eps still reaches the running variance
+	tiny := New(WithShape(2, 1), WithBacking([]float32{1, 1.0002}))
+	rv32 := New(WithShape(1), WithBacking([]float32{0}))
+	if _, _, _, err = BatchNorm(tiny, nil, nil, nil, rv32, 1, 1, 1e-2, true); err != nil {
+		t.Fatal(err)
+	}
+	assert.InDelta(2e-8, float64(rv32.Float32s()[0]), 1e-10)
+
+	// errors
+	_, _, _, err = BatchNorm(x, nil, nil, nil, nil, 1, 0.1, 0, false)
+	assert.NotNil(err)
+	_, _, _, err = BatchNorm(x, New(WithShape(3), Of(Float64)), nil, nil, nil, 1, 0.1, 0, true)
+	assert.NotNil(err)
+}
+
+func TestBatchNormB(t *testing.T) {
+	xs := Shape{3, 2, 2, 2}
+	xBack := Random(Float64, xs.TotalSize()).([]float64)
+	sBack := Random(Float64, 2).([]float64)
+	bBack := Random(Float64, 2).([]float64)
+	grad := Random(Float64, xs.TotalSize()).([]float64)
+	loss := func(x, s, b []float64) float64 {
+		y, _, _, err := BatchNorm(New(WithShape(xs...), WithBacking(x)), New(WithShape(2), WithBacking(s)), New(WithShape(2), WithBacking(b)), nil, nil, 1, 0.1, 1e-3, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dot(y.Data().([]float64), grad)
+	}
+
+	x := New(WithShape(xs...), WithBacking(xBack))
+	scale := New(WithShape(2), WithBacking(sBack))
+	_, mean, invStd, err := BatchNorm(x, scale, nil, nil, nil, 1, 0.1, 1e-3, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dx, ds, db, err := BatchNormB(x, scale, mean, invStd, New(WithShape(xs...), WithBacking(grad)), 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice(t, numGrad(func(v []float64) float64 { return loss(v, sBack, bBack) }, xBack), dx.Data(), 1e-6)
+	assert.InDeltaSlice(t, numGrad(func(v []float64) float64 { return loss(xBack, v, bBack) }, sBack), ds.Data(), 1e-6)
+	assert.InDeltaSlice(t, numGrad(func(v []float64) float64 { return loss(xBack, sBack, v) }, bBack), db.Data(), 1e-6)
+}
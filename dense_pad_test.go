@@ -0,0 +1,146 @@
+package tensor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPad(t *testing.T) {
+	assert := assert.New(t)
+	v := New(WithShape(3), WithBacking([]float64{1, 2, 3}))
+	modes := []struct {
+		mode    PadMode
+		correct []float64
+	}{
+		{PadConstant, []float64{9, 9, 1, 2, 3, 9, 9, 9}},
+		{PadReflect, []float64{3, 2, 1, 2, 3, 2, 1, 2}},
+		{PadSymmetric, []float64{2, 1, 1, 2, 3, 3, 2, 1}},
+		{PadEdge, []float64{1, 1, 1, 2, 3, 3, 3, 3}},
+		{PadCircular, []float64{2, 3, 1, 2, 3, 1, 2, 3}},
+	}
+	for _, m := range modes {
+		p, err := Pad(v, [][2]int{{2, 3}}, m.mode, 9.0)
+		if err != nil {
+			t.Errorf("%v: %v", m.mode, err)
+			continue
+		}
+		assert.Equal(Shape{8}, p.Shape(), "%v", m.mode)
+		assert.Equal(m.correct, p.Data(), "%v", m.mode)
+	}
+
+	// matrices, of any dtype
+	s := New(WithShape(2, 2), WithBacking([]string{"a", "b", "c", "d"}))
+	p, err := Pad(s, [][2]int{{1, 0}, {0, 1}}, PadConstant, "-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 3}, p.Shape())
+	assert.Equal([]string{"-", "-", "-", "a", "b", "-", "c", "d", "-"}, p.Data())
+
+	p, err = Pad(s, [][2]int{{1, 1}, {1, 0}}, PadEdge, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]string{"a", "a", "b", "a", "a", "b", "c", "c", "d", "c", "c", "d"}, p.Data())
+
+	// zero padding by default, on a transposed view
+	T := New(WithShape(2, 2), WithBacking([]int{1, 2, 3, 4}))
+	T.T()
+	p, err = Pad(T, [][2]int{{0, 1}, {0, 0}}, PadConstant, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 3, 2, 4, 0, 0}, p.Data())
+
+	// padding wider than the axis keeps reflecting
+	p, err = Pad(New(WithShape(2), WithBacking([]float32{1, 2})), [][2]int{{3, 0}}, PadReflect, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{2, 1, 2, 1, 2}, p.Data())
+
+	// masks are padded along
+	m := New(WithShape(2), WithBacking([]float64{1, 2}, []bool{true, false}))
+	p, err = Pad(m, [][2]int{{1, 1}}, PadEdge, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]bool{true, true, false, false}, p.(*Dense).Mask())
+
+	// errors
+	_, err = Pad(v, [][2]int{{1, 1}, {1, 1}}, PadConstant, nil)
+	assert.NotNil(err)
+	_, err = Pad(v, [][2]int{{-1, 1}}, PadConstant, nil)
+	assert.NotNil(err)
+	_, err = Pad(v, [][2]int{{1, 1}}, PadConstant, "x")
+	assert.NotNil(err)
+
+	// only constant padding can extend an empty axis
+	empty := New(WithShape(2, 0), Of(Float64))
+	for _, mode := range []PadMode{PadReflect, PadSymmetric, PadEdge, PadCircular} {
+		_, err = Pad(empty, [][2]int{{0, 0}, {1, 1}}, mode, nil)
+		assert.NotNil(err, "%v", mode)
+	}
+	p, err = Pad(empty, [][2]int{{0, 0}, {1, 1}}, PadConstant, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, p.Shape())
+	p, err = Pad(empty, [][2]int{{1, 0}, {0, 0}}, PadCircular, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 0}, p.Shape())
+}
+
+func TestPadB(t *testing.T) {
+	assert := assert.New(t)
+	g := New(WithShape(8), WithBacking([]float64{1, 2, 3, 4, 5, 6, 7, 8}))
+	modes := []struct {
+		mode    PadMode
+		correct []float64
+	}{
+		{PadConstant, []float64{3, 4, 5}},
+		{PadReflect, []float64{3 + 7, 2 + 4 + 6 + 8, 1 + 5}},
+		{PadSymmetric, []float64{2 + 3 + 8, 1 + 4 + 7, 5 + 6}},
+		{PadEdge, []float64{1 + 2 + 3, 4, 5 + 6 + 7 + 8}},
+		{PadCircular, []float64{3 + 6, 1 + 4 + 7, 2 + 5 + 8}},
+	}
+	for _, m := range modes {
+		dx, err := PadB(g, [][2]int{{2, 3}}, m.mode)
+		if err != nil {
+			t.Errorf("%v: %v", m.mode, err)
+			continue
+		}
+		assert.Equal(Shape{3}, dx.Shape(), "%v", m.mode)
+		assert.Equal(m.correct, dx.Data(), "%v", m.mode)
+	}
+
+	// PadB is the adjoint of Pad: <Pad(x), g> = <x, PadB(g)>
+	x := New(WithShape(3, 4), WithBacking(Random(Float64, 12)))
+	widths := [][2]int{{2, 1}, {3, 4}}
+	for _, mode := range []PadMode{PadReflect, PadSymmetric, PadEdge, PadCircular} {
+		p, err := Pad(x, widths, mode, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g := New(WithShape(p.Shape()...), WithBacking(Random(Float64, p.Shape().TotalSize())))
+		dx, err := PadB(g, widths, mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.InDelta(dot(p.Data().([]float64), g.Data().([]float64)), dot(x.Data().([]float64), dx.Data().([]float64)), 1e-9, "%v", mode)
+	}
+
+	// cropping works for all dtypes, accumulating does not
+	dx, err := PadB(New(WithShape(2, 2), WithBacking([]int{1, 2, 3, 4})), [][2]int{{1, 0}, {0, 1}}, PadConstant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{3}, dx.Data())
+	_, err = PadB(New(WithShape(3), WithBacking([]int{1, 2, 3})), [][2]int{{1, 1}}, PadEdge)
+	assert.NotNil(err)
+	_, err = PadB(g, [][2]int{{4, 4}}, PadConstant)
+	assert.NotNil(err)
+}
@@ -0,0 +1,250 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxPool2D(t *testing.T) {
+	assert := assert.New(t)
+	x := New(WithShape(1, 1, 4, 4), WithBacking([]float64{
+		1, 2, 5, 0,
+		3, 4, 1, 1,
+		0, 9, 2, 8,
+		7, 1, 3, 6,
+	}))
+	w := Window2D{KernelH: 2, KernelW: 2, StrideH: 2, StrideW: 2}
+	out, argmax, err := MaxPool2D(x, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 1, 2, 2}, out.Shape())
+	assert.Equal([]float64{4, 5, 9, 8}, out.Data())
+	assert.Equal([]int{5, 2, 9, 11}, argmax.Data())
+
+	g := New(WithShape(1, 1, 2, 2), WithBacking([]float64{1, 2, 3, 4}))
+	dx, err := MaxPool2DB(x, argmax, g, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(x.Shape(), dx.Shape())
+	assert.Equal([]float64{
+		0, 0, 2, 0,
+		0, 1, 0, 0,
+		0, 3, 0, 4,
+		0, 0, 0, 0,
+	}, dx.Data())
+
+	// overlapping windows accumulate; padding never wins even over negative values
+	x = New(WithShape(1, 1, 2, 2), WithBacking([]float32{-1, -2, -3, -4}))
+	w = Window2D{KernelH: 2, KernelW: 2, PadH: 1, PadW: 1}
+	out, argmax, err = MaxPool2D(x, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 1, 3, 3}, out.Shape())
+	assert.Equal([]float32{-1, -1, -2, -1, -1, -2, -3, -3, -4}, out.Data())
+	dx, err = MaxPool2DB(x, argmax, Ones(Float32, 1, 1, 3, 3), w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{4, 2, 2, 1}, dx.Data())
+
+	// NaNs propagate
+	x = New(WithShape(1, 1, 1, 3), WithBacking([]float64{1, math.NaN(), 2}))
+	out, _, err = MaxPool2D(x, Window2D{KernelH: 1, KernelW: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(math.IsNaN(out.Data().([]float64)[0]))
+
+	// NHWC gives the same results as NCHW, transposed
+	x = New(WithShape(2, 3, 5, 4), WithBacking(Random(Float64, 120)))
+	w = Window2D{KernelH: 3, KernelW: 2, StrideH: 2, PadH: 1}
+	out, argmax, err = MaxPool2D(x, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xT, _ := Transpose(x, 0, 2, 3, 1)
+	w.Layout = NHWC
+	outT, argmaxT, err := MaxPool2D(xT, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	back, _ := Transpose(outT, 0, 3, 1, 2)
+	assert.Equal(out.Data(), back.Data())
+	back, _ = Transpose(argmaxT, 0, 3, 1, 2)
+	assert.Equal(argmax.Data(), back.Data())
+
+	// errors
+	_, _, err = MaxPool2D(New(WithShape(1, 1, 2, 2), Of(Int)), Window2D{KernelH: 1, KernelW: 1})
+	assert.NotNil(err)
+	_, _, err = MaxPool2D(New(WithShape(1, 2, 2), Of(Float64)), Window2D{KernelH: 1, KernelW: 1})
+	assert.NotNil(err)
+	_, err = MaxPool2DB(x, New(WithShape(2, 3, 1, 1), Of(Int)), New(WithShape(2, 3, 1, 1), Of(Float64)), Window2D{KernelH: 3, KernelW: 2})
+	assert.NotNil(err)
+	x = New(WithShape(1, 1, 2, 2), Of(Float64))
+	for _, p := range []int{4, -2} {
+		_, err = MaxPool2DB(x, New(WithShape(1, 1, 1, 1), WithBacking([]int{p})), Ones(Float64, 1, 1, 1, 1), Window2D{KernelH: 2, KernelW: 2})
+		assert.NotNil(err, "argmax %d", p)
+	}
+}
+
+func TestAvgPool2D(t *testing.T) {
+	assert := assert.New(t)
+	x := New(WithShape(1, 1, 3, 3), WithBacking([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9}))
+	w := Window2D{KernelH: 2, KernelW: 2}
+	out, err := AvgPool2D(x, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 1, 2, 2}, out.Shape())
+	assert.Equal([]float64{3, 4, 6, 7}, out.Data())
+
+	// padding counts towards the window
+	out, err = AvgPool2D(x, Window2D{KernelH: 2, KernelW: 2, StrideH: 2, StrideW: 2, PadH: 1, PadW: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0.25, 1.25, 2.75, 7}, out.Data())
+
+	dx, err := AvgPool2DB(x, Ones(Float64, 1, 1, 2, 2), w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0.25, 0.5, 0.25, 0.5, 1, 0.5, 0.25, 0.5, 0.25}, dx.Data())
+
+	// <AvgPool2D(x), g> == <x, AvgPool2DB(g)>
+	windows := []Window2D{
+		{KernelH: 3, KernelW: 2, StrideH: 2, PadW: 1},
+		{KernelH: 2, KernelW: 2, DilationH: 2, Layout: NHWC},
+	}
+	for _, w := range windows {
+		x := New(WithShape(2, 5, 6, 3), Of(Float32))
+		copy(x.Float32s(), Random(Float32, 180).([]float32))
+		out, err := AvgPool2D(x, w)
+		if err != nil {
+			t.Fatalf("%+v: %v", w, err)
+		}
+		g := New(WithShape(out.Shape().Clone()...), WithBacking(Random(Float32, out.Shape().TotalSize())))
+		dx, err := AvgPool2DB(x, g, w)
+		if err != nil {
+			t.Fatalf("%+v: %v", w, err)
+		}
+		var lhs, rhs float64
+		for i, v := range out.Data().([]float32) {
+			lhs += float64(v * g.Float32s()[i])
+		}
+		for i, v := range dx.Data().([]float32) {
+			rhs += float64(v * x.Float32s()[i])
+		}
+		assert.InDelta(lhs, rhs, 1e-4, "%+v", w)
+	}
+}
+
+func TestPool1D(t *testing.T) {
+	assert := assert.New(t)
+	x := New(WithShape(1, 2, 4), WithBacking([]float64{1, 3, 2, 4, 8, 6, 7, 5}))
+	w := Window1D{Kernel: 2, Stride: 2}
+
+	out, argmax, err := MaxPool1D(x, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 2, 2}, out.Shape())
+	assert.Equal(Shape{1, 2, 2}, argmax.Shape())
+	assert.Equal([]float64{3, 4, 8, 7}, out.Data())
+	assert.Equal([]int{1, 3, 0, 2}, argmax.Data())
+	assert.Equal(Shape{1, 2, 4}, x.Shape(), "input should not be reshaped")
+
+	dx, err := MaxPool1DB(x, argmax, Ones(Float64, 1, 2, 2), w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 2, 4}, dx.Shape())
+	assert.Equal([]float64{0, 1, 0, 1, 1, 0, 1, 0}, dx.Data())
+
+	out, err = AvgPool1D(x, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{2, 3, 7, 6}, out.Data())
+	dx, err = AvgPool1DB(x, Ones(Float64, 1, 2, 2), w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5}, dx.Data())
+
+	// NHWC: (batch, length, channels)
+	x = New(WithShape(1, 4, 2), WithBacking([]float64{1, 8, 3, 6, 2, 7, 4, 5}))
+	out, argmax, err = MaxPool1D(x, Window1D{Kernel: 2, Stride: 2, Layout: NHWC})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 2, 2}, out.Shape())
+	assert.Equal([]float64{3, 8, 4, 7}, out.Data())
+	assert.Equal([]int{1, 0, 3, 2}, argmax.Data())
+}
+
+func TestGlobalPool(t *testing.T) {
+	assert := assert.New(t)
+	x := New(WithShape(2, 2, 1, 3), WithBacking([]float64{
+		1, 5, 3,
+		-1, -2, -3,
+
+		0, 0, 6,
+		4, 2, 0,
+	}))
+	out, argmax, err := GlobalMaxPool(x, NCHW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, out.Shape())
+	assert.Equal([]float64{5, -1, 6, 4}, out.Data())
+	assert.Equal([]int{1, 0, 2, 0}, argmax.Data())
+
+	g := New(WithShape(2, 2), WithBacking([]float64{1, 2, 3, 4}))
+	dx, err := GlobalMaxPoolB(x, argmax, g, NCHW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{0, 1, 0, 2, 0, 0, 0, 0, 3, 4, 0, 0}, dx.Data())
+
+	out, err = GlobalAvgPool(x, NCHW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{3, -2, 2, 2}, out.Data())
+	dx, err = GlobalAvgPoolB(x, g, NCHW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.InDeltaSlice([]float64{1.0 / 3, 1.0 / 3, 1.0 / 3, 2.0 / 3, 2.0 / 3, 2.0 / 3, 1, 1, 1, 4.0 / 3, 4.0 / 3, 4.0 / 3}, dx.Data(), 1e-12)
+
+	// NHWC, with any number of spatial dimensions
+	xT, _ := Transpose(x, 0, 2, 3, 1)
+	out, argmax, err = GlobalMaxPool(xT, NHWC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float64{5, -1, 6, 4}, out.Data())
+	assert.Equal([]int{1, 0, 2, 0}, argmax.Data())
+
+	x3 := New(WithShape(1, 4, 1), WithBacking([]float32{1, 2, 3, 4}))
+	out, err = GlobalAvgPool(x3, NHWC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{1, 1}, out.Shape())
+	assert.Equal([]float32{2.5}, out.Data())
+
+	// errors
+	_, err = GlobalAvgPool(New(WithShape(2, 2), Of(Float64)), NCHW)
+	assert.NotNil(err)
+	_, err = GlobalAvgPoolB(x, New(WithShape(2, 3), Of(Float64)), NCHW)
+	assert.NotNil(err)
+	_, err = GlobalMaxPoolB(x, New(WithShape(2, 2), WithBacking([]int{0, 0, 0, 99})), g, NCHW)
+	assert.NotNil(err)
+}
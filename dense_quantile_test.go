@@ -0,0 +1,137 @@
+package tensor
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectKth(t *testing.T) {
+	r := rand.New(rand.NewSource(1337))
+	for _, n := range []int{1, 2, 3, 10, 101} {
+		for k := 0; k < n; k++ {
+			a := make([]float64, n)
+			for i := range a {
+				a[i] = float64(r.Intn(n/2 + 1)) // with repeated values
+			}
+			sorted := append([]float64(nil), a...)
+			sort.Float64s(sorted)
+
+			selectKth(a, k)
+			if a[k] != sorted[k] {
+				t.Fatalf("n = %d, k = %d: expected %v, got %v", n, k, sorted[k], a[k])
+			}
+			for i := range a {
+				if (i < k && a[i] > a[k]) || (i > k && a[i] < a[k]) {
+					t.Fatalf("n = %d, k = %d: %v is out of place", n, k, a)
+				}
+			}
+		}
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	assert := assert.New(t)
+	// numpy.quantile([3, 1, 4, 1, 5, 9, 2, 6], 0.3, method=...)
+	T := New(WithShape(8), WithBacking([]float64{3, 1, 4, 1, 5, 9, 2, 6}))
+	testCases := []struct {
+		method  QuantileMethod
+		correct float64
+	}{
+		{QuantileLinear, 2.1},
+		{QuantileLower, 2},
+		{QuantileHigher, 3},
+		{QuantileNearest, 2},
+		{QuantileMidpoint, 2.5},
+	}
+	for _, tc := range testCases {
+		q, err := Quantile(T, nil, tc.method, 0.3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.InDelta(tc.correct, q.Data(), 1e-12, "%v", tc.method)
+	}
+	// the input is not reordered
+	assert.Equal([]float64{3, 1, 4, 1, 5, 9, 2, 6}, T.Data())
+
+	// several quantiles give a leading axis
+	q, err := Quantile(T, nil, QuantileLinear, 0, 1, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3}, q.Shape())
+	assert.Equal([]float64{1, 9, 3.5}, q.Data())
+
+	// along axes
+	M := New(WithShape(2, 3), WithBacking([]float32{1, 5, 3, 6, 4, 2}))
+	med, err := Median(M, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{3, 4}, med.Data())
+	med, err = Median(M, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]float32{3.5, 4.5, 2.5}, med.Data())
+	med, err = Median(M)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(float32(3.5), med.Data())
+
+	p, err := Percentile(M, []int{1}, QuantileLower, 50, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, p.Shape())
+	assert.Equal([]float32{3, 4, 5, 6}, p.Data())
+
+	// NaNs propagate
+	N := New(WithShape(2, 2), WithBacking([]float64{1, math.NaN(), 2, 3}))
+	med, err = Median(N, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(math.IsNaN(med.Data().([]float64)[0]))
+	assert.Equal(2.5, med.Data().([]float64)[1])
+
+	_, err = Quantile(T, nil, QuantileLinear, 1.5)
+	assert.NotNil(err)
+	_, err = Quantile(T, nil, QuantileLinear)
+	assert.NotNil(err)
+	_, err = Percentile(T, nil, QuantileLinear, -1)
+	assert.NotNil(err)
+	_, err = Quantile(T, nil, QuantileMethod(10), 0.5)
+	assert.NotNil(err)
+	_, err = Median(T, 1)
+	assert.NotNil(err)
+	_, err = Median(New(WithShape(2), Of(Int)))
+	assert.NotNil(err)
+}
+
+func TestQuantile_masked(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking([]float64{1, 100, 3, 4, 5, 6}, []bool{false, true, false, true, true, true}))
+	med, err := Median(T, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2.0, med.Data().([]float64)[0])
+	// with every value masked, there is no median
+	assert.True(math.IsNaN(med.Data().([]float64)[1]))
+
+	// views of masked tensors
+	V, err := T.Slice(nil, S(1, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	med, err = Median(V)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3.0, med.Data())
+}
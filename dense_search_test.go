@@ -0,0 +1,98 @@
+package tensor
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchSorted(t *testing.T) {
+	assert := assert.New(t)
+	// numpy.searchsorted([1, 2, 2, 3, 5], [[0, 2], [4, 6]], side=...)
+	sorted := New(WithShape(5), WithBacking([]float64{1, 2, 2, 3, 5}))
+	values := New(WithShape(2, 2), WithBacking([]float64{0, 2, 4, 6}))
+	idx, err := SearchSorted(sorted, values, SearchLeft)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, idx.Shape())
+	assert.Equal([]int{0, 1, 4, 5}, idx.Data())
+	idx, err = SearchSorted(sorted, values, SearchRight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{0, 3, 4, 5}, idx.Data())
+
+	idx, err = SearchSorted(sorted, New(FromScalar(3.0)), SearchRight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(4, idx.Data())
+
+	// batched along the last axis
+	B := New(WithShape(2, 3), WithBacking([]int{1, 3, 5, 10, 20, 30}))
+	V := New(WithShape(2, 2), WithBacking([]int{3, 6, 3, 25}))
+	idx, err = SearchSorted(B, V, SearchLeft)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 3, 0, 2}, idx.Data())
+
+	// strings, from a view
+	strs := New(WithShape(2, 2), WithBacking([]string{"a", "x", "c", "y"}))
+	col, err := strs.Slice(nil, S(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx, err = SearchSorted(col, New(WithShape(1), WithBacking([]string{"b"})), SearchLeft)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1}, idx.Data())
+
+	_, err = SearchSorted(B, New(WithShape(3, 2), Of(Int)), SearchLeft)
+	assert.NotNil(err)
+	_, err = SearchSorted(B, New(FromScalar(2)), SearchLeft)
+	assert.NotNil(err)
+	_, err = SearchSorted(sorted, V, SearchLeft)
+	assert.NotNil(err)
+	_, err = SearchSorted(sorted, values, SearchSide(4))
+	assert.NotNil(err)
+}
+
+func TestSampleCategorical(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1337))
+
+	W := New(WithShape(2, 3), WithBacking([]float64{1, 0, 3, 0, 0, 2}))
+	samples, err := SampleCategorical(W, 4000, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 4000}, samples.Shape())
+
+	var counts [2][3]int
+	for i, c := range samples.Data().([]int) {
+		counts[i/4000][c]++
+	}
+	// categories without weight are never drawn
+	assert.Equal(0, counts[0][1])
+	assert.Equal([3]int{0, 0, 4000}, counts[1])
+	assert.True(math.Abs(float64(counts[0][2])/4000-0.75) < 0.03, "%v", counts[0])
+
+	// float32 vectors, with the global source
+	samples, err = SampleCategorical(New(WithShape(2), WithBacking([]float32{0, 1})), 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 1, 1}, samples.Data())
+
+	_, err = SampleCategorical(New(WithShape(2), WithBacking([]float64{1, -1})), 1, r)
+	assert.NotNil(err)
+	_, err = SampleCategorical(New(WithShape(2), WithBacking([]float64{0, 0})), 1, r)
+	assert.NotNil(err)
+	_, err = SampleCategorical(New(WithShape(2), Of(Int)), 1, r)
+	assert.NotNil(err)
+}
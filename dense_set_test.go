@@ -0,0 +1,126 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnique(t *testing.T) {
+	assert := assert.New(t)
+	T := New(WithShape(2, 3), WithBacking([]int{3, 1, 2, 3, 3, 1}))
+	u, c, inv, err := Unique(T, AllAxes, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 2, 3}, u.Data())
+	assert.Equal([]int{2, 1, 3}, c.Data())
+	assert.Equal(Shape{2, 3}, inv.Shape())
+	assert.Equal([]int{2, 0, 1, 2, 2, 0}, inv.Data())
+
+	u, c, inv, err = Unique(T, AllAxes, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 2, 3}, u.Data())
+	assert.Nil(c)
+	assert.Nil(inv)
+
+	// unique rows and columns
+	R := New(WithShape(3, 2), WithBacking([]float64{1, 0, 0, 5, 1, 0}))
+	u, c, inv, err = Unique(R, 0, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 2}, u.Shape())
+	assert.Equal([]float64{0, 5, 1, 0}, u.Data())
+	assert.Equal([]int{1, 2}, c.Data())
+	assert.Equal([]int{1, 0, 1}, inv.Data())
+
+	u, _, _, err = Unique(R, 1, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{3, 2}, u.Shape())
+	assert.Equal([]float64{0, 1, 5, 0, 0, 1}, u.Data())
+
+	// strings, and NaNs, which are all equal and sort last
+	S := New(WithShape(4), WithBacking([]string{"b", "a", "c", "a"}))
+	u, _, _, err = Unique(S, AllAxes, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]string{"b", "a", "c", "a"}, S.Data())
+	assert.Equal([]string{"a", "b", "c"}, u.Data())
+
+	N := New(WithShape(4), WithBacking([]float32{float32(math.NaN()), 2, float32(math.NaN()), 1}))
+	u, c, _, err = Unique(N, AllAxes, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(3, u.Shape().TotalSize())
+	assert.Equal([]float32{1, 2}, u.Data().([]float32)[:2])
+	assert.True(math.IsNaN(float64(u.Data().([]float32)[2])))
+	assert.Equal([]int{1, 1, 2}, c.Data())
+
+	_, _, _, err = Unique(T, 2, false, false)
+	assert.NotNil(err)
+	_, _, _, err = Unique(New(WithShape(2), Of(Complex128)), AllAxes, false, false)
+	assert.NotNil(err)
+}
+
+func TestSetOps(t *testing.T) {
+	assert := assert.New(t)
+	a := New(WithShape(2, 3), WithBacking([]int{5, 1, 3, 3, 7, 1}))
+	b := New(WithShape(4), WithBacking([]int{3, 2, 7, 9}))
+
+	r, err := Intersect1D(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{3, 7}, r.Data())
+
+	r, err = Union1D(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 2, 3, 5, 7, 9}, r.Data())
+
+	r, err = SetDiff1D(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]int{1, 5}, r.Data())
+
+	r, err = Isin(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Shape{2, 3}, r.Shape())
+	assert.Equal([]bool{false, false, true, true, true, false}, r.Data())
+
+	// strings, from a view
+	s := New(WithShape(2, 2), WithBacking([]string{"x", "y", "z", "w"}))
+	V, err := s.Slice(nil, S(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = Union1D(V, New(WithShape(2), WithBacking([]string{"a", "x"})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]string{"a", "x", "z"}, r.Data())
+
+	// empty results
+	r, err = Intersect1D(b, New(WithShape(1), WithBacking([]int{4})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(0, r.Shape().TotalSize())
+
+	_, err = Union1D(a, New(WithShape(2), Of(Float64)))
+	assert.NotNil(err)
+	_, err = Isin(New(WithShape(2), Of(Bool)), New(WithShape(2), Of(Bool)))
+	assert.NotNil(err)
+}
@@ -94,6 +94,17 @@ func TestSoftMax(t *testing.T) {
 			axis:           -1,
 			expectedOutput: []float64{0.21383822, 0.23632777, 0.2611826, 0.2886514, 0.21383823, 0.23632778, 0.2611826, 0.2886514, 0.21383822, 0.23632777, 0.26118258, 0.2886514},
 		},
+		{
+			// the max has to be taken per row, otherwise the second row underflows
+			fn: SoftMax,
+			x: New(
+				Of(Float64),
+				WithShape(2, 2),
+				WithBacking([]float64{1000, 1000, 0, 0}),
+			),
+			axis:           -1,
+			expectedOutput: []float64{0.5, 0.5, 0.5, 0.5},
+		},
 	}
 	for i, tC := range testCases {
 		t.Run(fmt.Sprintf("Example #%d - %v %v", i+1, tC.x.Shape(), tC.x.Dtype()), func(t *testing.T) {
@@ -285,3 +296,76 @@ func TestSoftMaxB(t *testing.T) {
 		})
 	}
 }
+
+func TestSoftMax_views(t *testing.T) {
+	assert := assert.New(t)
+
+	// a broadcast view has zero strides and only 3 backing elements
+	x := New(WithShape(3), WithBacking([]float64{1, 2, 3}))
+	bx, err := BroadcastTo(x, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := New(WithShape(2, 3), WithBacking([]float64{1, 2, 3, 1, 2, 3}))
+	for _, axis := range []int{0, 1} {
+		got, err := SoftMax(bx, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := SoftMax(want, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(Shape{2, 3}, got.Shape())
+		assert.InDeltaSlice(expected.Data(), got.Data(), 1e-12, "axis %d", axis)
+
+		got, err = LogSoftMax(bx, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err = LogSoftMax(want, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.InDeltaSlice(expected.Data(), got.Data(), 1e-12, "axis %d", axis)
+
+		got, err = SoftMaxB(bx, bx, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err = SoftMaxB(want, want, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.InDeltaSlice(expected.Data(), got.Data(), 1e-12, "axis %d", axis)
+	}
+
+	// a flipped view has negative strides
+	y := New(WithShape(2, 3), WithBacking([]float32{1, 2, 3, 4, 5, 6}))
+	fy, err := Flip(y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantY := New(WithShape(2, 3), WithBacking([]float32{6, 5, 4, 3, 2, 1}))
+	for _, axis := range []int{0, 1} {
+		got, err := SoftMax(fy, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := SoftMax(wantY, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.InDeltaSlice(expected.Data(), got.Data(), 1e-6, "axis %d", axis)
+
+		got, err = LogSoftMaxB(fy, fy, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err = LogSoftMaxB(wantY, wantY, axis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.InDeltaSlice(expected.Data(), got.Data(), 1e-6, "axis %d", axis)
+	}
+}
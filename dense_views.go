@@ -13,5 +13,69 @@ func (t *Dense) Materialize() Tensor {
 	copyDenseIter(retVal, t, nil, nil)
 	retVal.e = t.e
 	retVal.oe = t.oe
+	if t.IsConjugated() {
+		conjInPlace(retVal)
+	}
 	return retVal
 }
+
+// viewWithAP returns a view that shares all of the data of t, accessed with the given shape and strides.
+// Views of a tensor with a thunked transpose are always marked as non-contiguous, as the data has not been moved.
+func (t *Dense) viewWithAP(shape Shape, strides []int, o DataOrder) *Dense {
+	return t.viewAt(0, shape, strides, o)
+}
+
+// viewAt is like viewWithAP, but the first element of the view is the element at the given offset from the first element of t.
+func (t *Dense) viewAt(offset int, shape Shape, strides []int, o DataOrder) *Dense {
+	if !t.old.IsZero() {
+		o = MakeDataOrder(o, NonContiguous)
+	}
+
+	view := borrowDense()
+	view.t = t.t
+	view.e = t.e
+	view.oe = t.oe
+	view.flag = t.flag
+	view.AP = MakeAP(shape, strides, o, t.Δ)
+	view.setParentTensor(t)
+
+	// with negative strides, the first element of a view is not where its data starts
+	start := t.origin() + offset - view.origin()
+	t.sliceInto(start, t.len(), &view.array)
+
+	if t.IsMasked() {
+		view.mask = t.mask[start:]
+	}
+	return view
+}
+
+// flipView returns a view of t with the values along the given axes in reverse order, which is done by negating their strides.
+func (t *Dense) flipView(axes []int) *Dense {
+	shape := t.Shape().Clone()
+	strides := append([]int(nil), t.Strides()...)
+	o := t.o
+	var offset int
+	for _, a := range axes {
+		if shape[a] < 2 {
+			continue
+		}
+		offset += (shape[a] - 1) * strides[a]
+		strides[a] = -strides[a]
+		o = MakeDataOrder(o, NonContiguous)
+	}
+	return t.viewAt(offset, shape, strides, o)
+}
+
+// packedOrder returns o, marked as non-contiguous if the strides do not walk over the shape without gaps.
+func packedOrder(shape Shape, strides []int, o DataOrder) DataOrder {
+	want := shape.CalcStrides()
+	if o.IsColMajor() {
+		want = shape.CalcStridesColMajor()
+	}
+	for i, s := range shape {
+		if s != 1 && strides[i] != want[i] {
+			return MakeDataOrder(o, NonContiguous)
+		}
+	}
+	return o
+}
@@ -1,5 +1,7 @@
 package tensor
 
+import "math/rand"
+
 // Memory is a representation of memory of the value.
 //
 // The main reason for requiring both Uintptr() and Pointer() methods is because while Go currently does not have a compacting
@@ -46,6 +48,14 @@ type standardEngine interface {
 	ElEqer
 	MinBetweener
 	MaxBetweener
+	Ander
+	Orer
+	Xorer
+	BitAnder
+	BitOrer
+	BitXorer
+	Shler
+	Shrer
 
 	// Anything that returns interface{} cannot be added here because they will likely have additional
 	// optimized versions of the functions for types.
@@ -73,6 +83,33 @@ type Concater interface {
 	Concat(t Tensor, axis int, others ...Tensor) (Tensor, error)
 }
 
+// Splitter is any engine that can split a Tensor into pieces along an axis. It is the inverse of Concater.
+type Splitter interface {
+	Split(t Tensor, axis int, sizes []int, materialize bool) ([]Tensor, error)
+}
+
+// Padder is any engine that can pad a Tensor, and compute the gradient of the padding.
+type Padder interface {
+	Pad(t Tensor, widths [][2]int, mode PadMode, value interface{}) (Tensor, error)
+	PadB(grad Tensor, widths [][2]int, mode PadMode) (Tensor, error)
+}
+
+// Flipper is any engine that can reverse the values of a Tensor along some axes, and rotate a Tensor by multiples of 90 degrees.
+type Flipper interface {
+	Flip(t Tensor, axes ...int) (Tensor, error)
+	Rot90(t Tensor, k, axis1, axis2 int) (Tensor, error)
+}
+
+// Roller is any engine that can shift the values of a Tensor along some axes, wrapping them around.
+type Roller interface {
+	Roll(t Tensor, shift int, axes ...int) (Tensor, error)
+}
+
+// Tiler is any engine that can repeat a whole Tensor along its axes.
+type Tiler interface {
+	Tile(t Tensor, reps ...int) (Tensor, error)
+}
+
 // Stacker is any engine that can stack multiple Tenosrs along an axis
 type Stacker interface {
 	Stack(t Tensor, axis int, others ...Tensor) (Tensor, error)
@@ -94,6 +131,17 @@ type Diager interface {
 	Diag(a Tensor) (Tensor, error)
 }
 
+// Triangler is any engine that can zero the values below or above a diagonal of a (batch of) matrices.
+type Triangler interface {
+	Triu(t Tensor, k int) (Tensor, error)
+	Tril(t Tensor, k int) (Tensor, error)
+}
+
+// DiagEmbedder is any engine that can build (batches of) matrices out of the values of their diagonals.
+type DiagEmbedder interface {
+	DiagEmbed(t Tensor, offset int) (Tensor, error)
+}
+
 /* NUMBER INTERFACES
 All these are expected to be unsafe on the first tensor
 */
@@ -173,6 +221,80 @@ type MaxBetweener interface {
 	MaxBetweenScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
 }
 
+/* LOGICAL AND BITWISE INTERFACES */
+
+// Ander is any engine that can perform an elementwise logical and.
+type Ander interface {
+	// And performs a ∧ b
+	And(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+
+	// AndScalar performs a ∧ b where one of the operands is scalar. leftTensor indicates if the tensor is the left operand.
+	AndScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
+// Orer is any engine that can perform an elementwise logical or.
+type Orer interface {
+	// Or performs a ∨ b
+	Or(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+
+	// OrScalar performs a ∨ b where one of the operands is scalar. leftTensor indicates if the tensor is the left operand.
+	OrScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
+// Xorer is any engine that can perform an elementwise logical exclusive or.
+type Xorer interface {
+	// Xor performs a ⊕ b
+	Xor(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+
+	// XorScalar performs a ⊕ b where one of the operands is scalar. leftTensor indicates if the tensor is the left operand.
+	XorScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
+// BitAnder is any engine that can perform an elementwise bitwise and.
+type BitAnder interface {
+	// BitAnd performs a & b
+	BitAnd(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+
+	// BitAndScalar performs a & b where one of the operands is scalar. leftTensor indicates if the tensor is the left operand.
+	BitAndScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
+// BitOrer is any engine that can perform an elementwise bitwise or.
+type BitOrer interface {
+	// BitOr performs a | b
+	BitOr(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+
+	// BitOrScalar performs a | b where one of the operands is scalar. leftTensor indicates if the tensor is the left operand.
+	BitOrScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
+// BitXorer is any engine that can perform an elementwise bitwise exclusive or.
+type BitXorer interface {
+	// BitXor performs a ^ b
+	BitXor(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+
+	// BitXorScalar performs a ^ b where one of the operands is scalar. leftTensor indicates if the tensor is the left operand.
+	BitXorScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
+// Shler is any engine that can perform an elementwise left shift.
+type Shler interface {
+	// Shl performs a << b
+	Shl(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+
+	// ShlScalar performs a << b where one of the operands is scalar. leftTensor indicates if the tensor is the left operand.
+	ShlScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
+// Shrer is any engine that can perform an elementwise right shift.
+type Shrer interface {
+	// Shr performs a >> b
+	Shr(a, b Tensor, opts ...FuncOpt) (Tensor, error)
+
+	// ShrScalar performs a >> b where one of the operands is scalar. leftTensor indicates if the tensor is the left operand.
+	ShrScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
+}
+
 /* LINEAR ALGEBRA INTERFACES */
 
 // Tracer is any engine that can return the trace (aka the sum of the diagonal elements).
@@ -226,6 +348,57 @@ type SVDer interface {
 	SVD(a Tensor, uv, full bool) (s, u, v Tensor, err error)
 }
 
+// Pinver is any engine that can compute the Moore-Penrose pseudo-inverse of a matrix
+type Pinver interface {
+	Pinv(a Tensor, rcond float64) (Tensor, error)
+}
+
+// MatrixRanker is any engine that can compute the rank of a matrix
+type MatrixRanker interface {
+	MatrixRank(a Tensor, tol float64) (int, error)
+}
+
+// MatrixPowerer is any engine that can raise a square matrix to an integer power
+type MatrixPowerer interface {
+	MatrixPower(a Tensor, n int) (Tensor, error)
+}
+
+// Kroner is any engine that can compute the Kronecker product of two tensors
+type Kroner interface {
+	Kron(a, b Tensor) (Tensor, error)
+}
+
+// Conder is any engine that can compute the condition number of a matrix
+type Conder interface {
+	Cond(a Tensor, ord NormOrder) (float64, error)
+}
+
+// Distancer is any engine that can compute pairwise distances and similarities between vectors
+type Distancer interface {
+	Cdist(a, b Tensor, metric DistanceMetric) (Tensor, error)
+	CosineSimilarity(a, b Tensor, axis int) (Tensor, error)
+}
+
+// FFTer is any engine that can compute discrete Fourier transforms
+type FFTer interface {
+	FFT(t Tensor, n, axis int, norm FFTNorm) (Tensor, error)
+	IFFT(t Tensor, n, axis int, norm FFTNorm) (Tensor, error)
+	RFFT(t Tensor, n, axis int, norm FFTNorm) (Tensor, error)
+	IRFFT(t Tensor, n, axis int, norm FFTNorm) (Tensor, error)
+	FFTN(t Tensor, s, axes []int, norm FFTNorm) (Tensor, error)
+	IFFTN(t Tensor, s, axes []int, norm FFTNorm) (Tensor, error)
+}
+
+// Complexer is any engine that can take complex tensors apart and put them together
+type Complexer interface {
+	Real(t Tensor) (Tensor, error)
+	Imag(t Tensor) (Tensor, error)
+	Conj(t Tensor) (Tensor, error)
+	Angle(t Tensor) (Tensor, error)
+	Magnitude(t Tensor) (Tensor, error)
+	Complex(re, im Tensor) (Tensor, error)
+}
+
 /* ORD INTERFACES */
 
 // Lter is any engine that can perform the Lt operation.
@@ -263,6 +436,12 @@ type ElEqer interface {
 	NeScalar(a Tensor, b interface{}, leftTensor bool, opts ...FuncOpt) (Tensor, error)
 }
 
+// IsCloser is any engine that can compare the values of two float or complex tensors up to a tolerance.
+type IsCloser interface {
+	IsClose(a, b Tensor, rtol, atol float64, equalNaN bool) (Tensor, error)
+	AllClose(a, b Tensor, rtol, atol float64, equalNaN bool) (bool, error)
+}
+
 /* Unary Operators for Numbers */
 
 // Mapper is any engine that can map a function onto the values of a tensor.
@@ -345,6 +524,16 @@ type Clamper interface {
 	Clamp(a Tensor, min, max interface{}, opts ...FuncOpt) (Tensor, error)
 }
 
+// Noter is any engine that can perform a logical not on the values of a Bool Tensor.
+type Noter interface {
+	Not(a Tensor, opts ...FuncOpt) (Tensor, error)
+}
+
+// BitNoter is any engine that can flip the bits of the values of an integer Tensor.
+type BitNoter interface {
+	BitNot(a Tensor, opts ...FuncOpt) (Tensor, error)
+}
+
 /* Reduction */
 
 // Reducer is any engine that can perform a reduction function.
@@ -377,6 +566,16 @@ type Maxer interface {
 	Max(a Tensor, along ...int) (Tensor, error)
 }
 
+// Anyer is any engine that can test whether any value is true along the axes of a Bool Tensor.
+type Anyer interface {
+	Any(a Tensor, along ...int) (Tensor, error)
+}
+
+// Aller is any engine that can test whether all the values are true along the axes of a Bool Tensor.
+type Aller interface {
+	All(a Tensor, along ...int) (Tensor, error)
+}
+
 /* Arg methods */
 
 // Argmaxer is any engine that can find the indices of the maximum values along an axis.
@@ -391,6 +590,50 @@ type Argminer interface {
 	Argmin(t Tensor, axis int) (Tensor, error)
 }
 
+// NanReducer is any engine that can perform reductions that ignore NaNs, and optionally masked values.
+type NanReducer interface {
+	NanSum(t Tensor, skipMasked bool, along ...int) (Tensor, error)
+	NanMean(t Tensor, skipMasked bool, along ...int) (Tensor, error)
+	NanMax(t Tensor, skipMasked bool, along ...int) (Tensor, error)
+	NanMin(t Tensor, skipMasked bool, along ...int) (Tensor, error)
+	NanArgmax(t Tensor, axis int, skipMasked bool) (Tensor, error)
+	NanArgmin(t Tensor, axis int, skipMasked bool) (Tensor, error)
+}
+
+// Quantiler is any engine that can compute the quantiles of a tensor along some axes
+type Quantiler interface {
+	Quantile(t Tensor, axes []int, method QuantileMethod, q ...float64) (Tensor, error)
+}
+
+// Histogrammer is any engine that can bin the values of a tensor
+type Histogrammer interface {
+	Histogram(t Tensor, bins int, lo, hi float64) (counts, edges Tensor, err error)
+	HistogramDD(t Tensor, bins []int, ranges [][2]float64) (counts Tensor, edges []Tensor, err error)
+	Bincount(t, weights Tensor, minlength int) (Tensor, error)
+	Digitize(t, edges Tensor, right bool) (Tensor, error)
+}
+
+// SetOper is any engine that can perform set operations on the values of tensors
+type SetOper interface {
+	Unique(t Tensor, axis int, withCounts, withInverse bool) (unique, counts, inverse Tensor, err error)
+	Intersect1D(a, b Tensor) (Tensor, error)
+	Union1D(a, b Tensor) (Tensor, error)
+	SetDiff1D(a, b Tensor) (Tensor, error)
+	Isin(t, test Tensor) (Tensor, error)
+}
+
+// Searcher is any engine that can search sorted tensors
+type Searcher interface {
+	SearchSorted(sorted, values Tensor, side SearchSide) (Tensor, error)
+	SampleCategorical(weights Tensor, n int, rng *rand.Rand) (Tensor, error)
+}
+
+// Differ is any engine that can compute the discrete differences of a tensor along an axis
+type Differ interface {
+	Diff(t Tensor, n, axis int, pre, post Tensor) (Tensor, error)
+	Gradient(t Tensor, spacing []float64, axes ...int) ([]Tensor, error)
+}
+
 // NaNChecker checks that the tensor contains a NaN
 // Errors are to be returned if the concept of NaN does not apply to the data type.
 // Other errors may also occur. See specific implementations for details
@@ -413,6 +656,62 @@ type ByIndiceser interface {
 	SelectByIndicesB(input, outGrad, indices Tensor, axis int, opts ...FuncOpt) (retVal Tensor, err error)
 }
 
+// Indexer is any engine that can index a tensor with Numpy style advanced indices, and assign or add values through them.
+type Indexer interface {
+	Index(t Tensor, indices ...interface{}) (Tensor, error)
+	IndexPut(t Tensor, value interface{}, indices ...interface{}) error
+	IndexAdd(t Tensor, value interface{}, indices ...interface{}) error
+}
+
+/* Convolution */
+
+// Im2Coler is any engine that can unroll the sliding windows of a batch of images into columns, and fold them back.
+type Im2Coler interface {
+	Im2Col(im Tensor, w Window2D, opts ...FuncOpt) (Tensor, error)
+	Col2Im(col Tensor, imShape Shape, w Window2D, opts ...FuncOpt) (Tensor, error)
+}
+
+// Convolver is any engine that can perform convolutions, as well as compute their gradients.
+type Convolver interface {
+	Conv2D(x, filter Tensor, w Window2D) (Tensor, error)
+	Conv2DB(x, filter, grad Tensor, w Window2D) (gradX, gradFilter Tensor, err error)
+
+	Conv1D(x, filter Tensor, w Window1D) (Tensor, error)
+	Conv1DB(x, filter, grad Tensor, w Window1D) (gradX, gradFilter Tensor, err error)
+}
+
+// Pooler is any engine that can perform windowed pooling, as well as compute its gradients.
+type Pooler interface {
+	MaxPool2D(x Tensor, w Window2D) (retVal, argmax Tensor, err error)
+	MaxPool2DB(x, argmax, grad Tensor, w Window2D) (Tensor, error)
+	AvgPool2D(x Tensor, w Window2D) (Tensor, error)
+	AvgPool2DB(x, grad Tensor, w Window2D) (Tensor, error)
+
+	MaxPool1D(x Tensor, w Window1D) (retVal, argmax Tensor, err error)
+	MaxPool1DB(x, argmax, grad Tensor, w Window1D) (Tensor, error)
+	AvgPool1D(x Tensor, w Window1D) (Tensor, error)
+	AvgPool1DB(x, grad Tensor, w Window1D) (Tensor, error)
+}
+
+// GlobalPooler is any engine that can pool over all the spatial dimensions of a batch.
+type GlobalPooler interface {
+	GlobalMaxPool(x Tensor, layout ImageLayout) (retVal, argmax Tensor, err error)
+	GlobalMaxPoolB(x, argmax, grad Tensor, layout ImageLayout) (Tensor, error)
+	GlobalAvgPool(x Tensor, layout ImageLayout) (Tensor, error)
+	GlobalAvgPoolB(x, grad Tensor, layout ImageLayout) (Tensor, error)
+}
+
+// Resizer is any engine that can resample the spatial dimensions of a batch of images, as well as compute its gradient.
+type Resizer interface {
+	Resize(x Tensor, newH, newW int, r Resampling) (Tensor, error)
+	ResizeB(x, grad Tensor, r Resampling) (Tensor, error)
+}
+
+// Interpolator is any engine that can interpolate between the points of a piecewise linear function.
+type Interpolator interface {
+	Interp(x, xp, fp Tensor) (Tensor, error)
+}
+
 /* Internal interfaces for faster shit */
 
 type denseArgmaxer interface {
@@ -430,3 +729,21 @@ type SoftMaxer interface {
 	SoftMax(x Tensor, axis int, opts ...FuncOpt) (retVal Tensor, err error)
 	SoftMaxB(output, grad Tensor, axis int, opts ...FuncOpt) (retVal Tensor, err error)
 }
+
+// Normalizer is any engine that can perform fused normalization layers and their backwards operations.
+type Normalizer interface {
+	LayerNorm(x, scale, bias Tensor, eps float64, axes ...int) (retVal, mean, invStd Tensor, err error)
+	LayerNormB(x, scale, mean, invStd, grad Tensor, axes ...int) (dx, dscale, dbias Tensor, err error)
+	RMSNorm(x, scale Tensor, eps float64, axes ...int) (retVal, invRMS Tensor, err error)
+	RMSNormB(x, scale, invRMS, grad Tensor, axes ...int) (dx, dscale Tensor, err error)
+	BatchNorm(x, scale, bias, runningMean, runningVar Tensor, axis int, momentum, eps float64, training bool) (retVal, mean, invStd Tensor, err error)
+	BatchNormB(x, scale, mean, invStd, grad Tensor, axis int, training bool) (dx, dscale, dbias Tensor, err error)
+}
+
+// CrossEntropyer is any engine that can compute classification losses against integer targets, fused with their gradients.
+type CrossEntropyer interface {
+	SoftmaxCrossEntropy(logits, targets Tensor, axis int, opts ...LossOpt) (Tensor, error)
+	SoftmaxCrossEntropyB(logits, targets, grad Tensor, axis int, opts ...LossOpt) (Tensor, error)
+	NLLLoss(logProbs, targets Tensor, opts ...LossOpt) (Tensor, error)
+	NLLLossB(logProbs, targets, grad Tensor, opts ...LossOpt) (Tensor, error)
+}
@@ -56,9 +56,12 @@ const (
 	opFail            = "Failed to perform %v"
 	extractionFail    = "Failed to extract %v from %T"
 	unknownState      = "Unknown state reached: Safe %t, Incr %t, Reuse %t"
+	incrUnsupported   = "%v does not support WithIncr()"
 	unsupportedDtype  = "Array of %v is unsupported for %v"
 	maskRequired      = "Masked array type required for %v"
 	inaccessibleData  = "Data in %p inaccessible"
+	readOnlyData      = "Data in %p is read-only. Materialize it before writing to it"
+	conjugatedData    = "Data in %p is lazily conjugated. Materialize it first"
 
 	methodNYI = "%q not yet implemented for %v"
 	typeNYI   = "%q not yet implemented for interactions with %T"
@@ -100,6 +100,11 @@ const (
 	ManuallyManaged
 	// IsOverallocated indicates that the memory for a given tensor is overallocated (i.e. the size-in-use is smaller than the size allocated)
 	IsOverallocated
+	// ReadOnly indicates that the memory must not be written to through the given tensor, because several of its elements
+	// share the same memory, like in broadcast views, or because the tensor does not hold the values in memory as they are, like conjugate transposes.
+	ReadOnly
+	// Conjugated indicates that the values of a complex tensor are the complex conjugates of the values in memory, as in the views returned by H().
+	Conjugated
 )
 
 func MakeMemoryFlag(fs ...MemoryFlag) (retVal MemoryFlag) {
@@ -116,6 +121,8 @@ func MakeMemoryFlag(fs ...MemoryFlag) (retVal MemoryFlag) {
 func (f MemoryFlag) nativelyAccessible() bool { return !((f & NativelyInaccessible) != 0) }
 func (f MemoryFlag) manuallyManaged() bool    { return (f & ManuallyManaged) != 0 }
 func (f MemoryFlag) isOverallocated() bool    { return (f & IsOverallocated) != 0 }
+func (f MemoryFlag) readOnly() bool           { return (f & ReadOnly) != 0 }
+func (f MemoryFlag) conjugated() bool         { return (f & Conjugated) != 0 }
 
 // OpOpt are the options used to call ops
 type OpOpt struct {
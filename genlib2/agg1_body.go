@@ -13,17 +13,16 @@ const (
 		case {{reflectKind .}}:
 			at := a.{{sliceOf .}}
 			bt := b.{{sliceOf .}}
-			{{$isDiv := eq $name "Div" -}}
-			{{$p := panicsDiv0 . -}}
+			{{$check := checksRight $name . -}}
 			switch {
 			case as && bs:
 				Vec{{$name}}{{short .}}(at, bt)
 			case as && !bs:
-				{{if and $isDiv $p}} err = {{end}} {{$name}}SV{{short .}}(at[0], bt)
+				{{if $check}} err = {{end}} {{$name}}SV{{short .}}(at[0], bt)
 			case !as && bs:
-				{{if and $isDiv $p}} err = {{end}} {{$name}}VS{{short .}}(at, bt[0])
+				{{if $check}} err = {{end}} {{$name}}VS{{short .}}(at, bt[0])
 			default:
-				{{if and $isDiv $p}} err = {{end}} Vec{{$name}}{{short .}}(at, bt)
+				{{if $check}} err = {{end}} Vec{{$name}}{{short .}}(at, bt)
 			}
 			return
 		{{end -}}
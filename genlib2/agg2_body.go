@@ -10,6 +10,9 @@ const cmpPrepRaw = `var safe, same bool
 	}
 	if !safe {
 		same = true
+		if err = checkWritable({{.VecVar}}); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.{{.Name}}")
+		}
 	}
 `
 
@@ -17,12 +20,25 @@ const arithPrepRaw = `var safe, toReuse, incr bool
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts({{.VecVar}}.Shape(), {{.VecVar}}.Dtype(), {{.VecVar}}.DataOrder(), true, opts...); err != nil{
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly({{.VecVar}}, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, "StdEng.{{.Name}}")
+	}
+	{{if .NoIncr -}}
+	if incr {
+		return nil, errors.Errorf(incrUnsupported, "{{.Name}}")
+	}
+	{{end -}}
 `
 
 const minmaxPrepRaw = `var safe bool
 	if reuse, safe, _, _, _, err = handleFuncOpts({{.VecVar}}.Shape(), {{.VecVar}}.Dtype(), {{.VecVar}}.DataOrder(), true, opts...); err != nil{
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if !safe {
+		if err = checkWritable({{.VecVar}}); err != nil {
+			return nil, errors.Wrapf(err, "StdEng.{{.Name}}")
+		}
+	}
 `
 
 const prepVVRaw = `if err = binaryCheck(a, b, {{.TypeClassCheck | lower}}Types); err != nil {
@@ -82,6 +98,9 @@ const prepUnaryRaw = `if err = unaryCheck(a, {{.TypeClassCheck | lower}}Types);
 	if reuse, safe, toReuse, incr, _, err = handleFuncOpts(a.Shape(), a.Dtype(), a.DataOrder(), true, opts...); err != nil {
 		return nil, errors.Wrap(err, "Unable to handle funcOpts")
 	}
+	if reuse, toReuse, err = handleReadOnly(a, reuse, safe, toReuse, incr); err != nil {
+		return nil, errors.Wrapf(err, opFail, "StdEng.{{.Name}}")
+	}
 
 	typ := a.Dtype().Type
 	var ait, rit Iterator
@@ -95,9 +114,11 @@ const prepUnaryRaw = `if err = unaryCheck(a, {{.TypeClassCheck | lower}}Types);
 
 const agg2BodyRaw = `if useIter {
 		switch {
+		{{if not .NoIncr -}}
 		case incr:
 			err = e.E.{{.Name}}IterIncr(typ, dataA, dataB, dataReuse, ait, bit, iit)
 			retVal = reuse
+		{{end -}}
 		{{if .VV -}}
 		case toReuse:
 			storage.CopyIter(typ,dataReuse, dataA, iit, ait)
@@ -148,9 +169,11 @@ const agg2BodyRaw = `if useIter {
 		return
 	}
 	switch {
+	{{if not .NoIncr -}}
 	case incr:
 		err = e.E.{{.Name}}Incr(typ, dataA, dataB, dataReuse)
 		retVal = reuse
+	{{end -}}
 	{{if .VV -}}
 	case toReuse:
 		err = e.E.{{.Name}}Recv(typ, dataA, dataB, dataReuse)
@@ -130,6 +130,13 @@ const (
 	}
 	`
 
+	checkNeg = `if {{.Right}} < 0 {
+		errs = append(errs, i)
+		{{.Range}}[i] = 0
+		continue
+	}
+	`
+
 	maskCheck = `if mask[i] {
 		continue
 	}
@@ -15,6 +15,17 @@ var arithSymbolTemplates = [...]string{
 	"{{if isFloatCmplx .}}{{mathPkg .}}Mod{{else}}%{{end}}",
 }
 
+var bitSymbolTemplates = [...]string{
+	"&&",
+	"||",
+	"!=",
+	"&",
+	"|",
+	"^",
+	"<<",
+	">>",
+}
+
 var cmpSymbolTemplates = [...]string{
 	">",
 	">=",
@@ -45,6 +56,11 @@ var unconditionalNumUnarySymbolTemplates = [...]string{
 	"{{.Range}}[i]*{{.Range}}[i]*", // cube
 }
 
+var unconditionalBitUnarySymbolTemplates = [...]string{
+	"!", // not
+	"^", // bitnot
+}
+
 var unconditionalFloatUnarySymbolTemplates = [...]string{
 	"{{mathPkg .Kind}}Exp",
 	"{{mathPkg .Kind}}Tanh",
@@ -292,6 +308,28 @@ var boolRepr = [...]reflect.Kind{
 	reflect.String,
 }
 
+var integer = [...]reflect.Kind{
+	reflect.Int,
+	reflect.Int8,
+	reflect.Int16,
+	reflect.Int32,
+	reflect.Int64,
+	reflect.Uint,
+	reflect.Uint8,
+	reflect.Uint16,
+	reflect.Uint32,
+	reflect.Uint64,
+}
+
+// shifting by a negative count panics
+var negShiftPanics = [...]reflect.Kind{
+	reflect.Int,
+	reflect.Int8,
+	reflect.Int16,
+	reflect.Int32,
+	reflect.Int64,
+}
+
 var div0panics = [...]reflect.Kind{
 	reflect.Int,
 	reflect.Int8,
@@ -323,7 +361,10 @@ var funcs = template.FuncMap{
 	"isEq":               isEq,
 	"isOrd":              isOrd,
 	"isBoolRepr":         isBoolRepr,
+	"isBool":             isBool,
+	"isInteger":          isInteger,
 	"panicsDiv0":         panicsDiv0,
+	"checksRight":        checksRight,
 
 	"short": short,
 	"clean": clean,
@@ -404,8 +445,10 @@ var nameMaps = map[string]string{
 }
 
 var arithBinOps []arithOp
+var bitBinOps []arithOp
 var cmpBinOps []cmpOp
 var typedAriths []TypedBinOp
+var typedBits []TypedBinOp
 var typedCmps []TypedBinOp
 
 var conditionalUnaries []unaryOp
@@ -438,6 +481,20 @@ func init() {
 		arithBinOps[i].symbol = arithSymbolTemplates[i]
 	}
 
+	bitBinOps = []arithOp{
+		{basicBinOp{"", "And", false, isBool}, "boolTypes", true, 1, false, "", true, false},
+		{basicBinOp{"", "Or", false, isBool}, "boolTypes", true, 0, false, "", true, false},
+		{basicBinOp{"", "Xor", false, isBool}, "boolTypes", true, 0, false, "", true, false},
+		{basicBinOp{"", "BitAnd", false, isInteger}, "integerTypes", false, 0, false, "", true, false},
+		{basicBinOp{"", "BitOr", false, isInteger}, "integerTypes", true, 0, false, "", true, false},
+		{basicBinOp{"", "BitXor", false, isInteger}, "integerTypes", true, 0, false, "", true, false},
+		{basicBinOp{"", "Shl", false, isInteger}, "integerTypes", false, 0, false, "", false, false},
+		{basicBinOp{"", "Shr", false, isInteger}, "integerTypes", false, 0, false, "", false, false},
+	}
+	for i := range bitBinOps {
+		bitBinOps[i].symbol = bitSymbolTemplates[i]
+	}
+
 	cmpBinOps = []cmpOp{
 		{basicBinOp{"", "Gt", false, isOrd}, "ordTypes", "Lt", true, false},
 		{basicBinOp{"", "Gte", false, isOrd}, "ordTypes", "Lte", true, false},
@@ -472,6 +529,9 @@ func init() {
 		{"", "Sqrt", true, isFloatCmplx, "floatcmplxTypes", "Square"},
 		{"", "Cbrt", true, isFloat, "floatTypes", "Cube"},
 		{"", "InvSqrt", true, isFloat, "floatTypes", ""}, // TODO: cmplx requires to much finagling to the template. Come back to it later
+
+		{"", "Not", false, isBool, "boolTypes", ""},
+		{"", "BitNot", false, isInteger, "integerTypes", "BitNot"},
 	}
 	nonF := len(unconditionalNumUnarySymbolTemplates)
 	for i := range unconditionalNumUnarySymbolTemplates {
@@ -480,6 +540,10 @@ func init() {
 	for i := range unconditionalFloatUnarySymbolTemplates {
 		unconditionalUnaries[i+nonF].symbol = unconditionalFloatUnarySymbolTemplates[i]
 	}
+	nonB := nonF + len(unconditionalFloatUnarySymbolTemplates)
+	for i := range unconditionalBitUnarySymbolTemplates {
+		unconditionalUnaries[i+nonB].symbol = unconditionalBitUnarySymbolTemplates[i]
+	}
 
 	specialUnaries = []UnaryOp{
 		specialUnaryOp{unaryOp{clampBody, "Clamp", false, isNonComplexNumber, "nonComplexNumberTypes", ""}, []string{"min", "max"}},
@@ -497,6 +561,16 @@ func init() {
 		}
 	}
 
+	for _, bo := range bitBinOps {
+		for _, k := range allKinds {
+			tb := TypedBinOp{
+				BinOp: bo,
+				k:     k,
+			}
+			typedBits = append(typedBits, tb)
+		}
+	}
+
 	for _, bo := range cmpBinOps {
 		for _, k := range allKinds {
 			tb := TypedBinOp{
@@ -59,6 +59,25 @@ func generateDenseArith(f io.Writer, ak Kinds) {
 	}
 }
 
+func generateDenseBit(f io.Writer, ak Kinds) {
+	var methods []*DenseBinOp
+	for _, bo := range bitBinOps {
+		meth := &DenseBinOp{
+			MethodName: bo.Name(),
+			Name:       bo.Name(),
+		}
+		methods = append(methods, meth)
+	}
+
+	for _, meth := range methods {
+		meth.Write(f)
+		meth.Scalar = true
+	}
+	for _, meth := range methods {
+		meth.Write(f)
+	}
+}
+
 func generateDenseCmp(f io.Writer, ak Kinds) {
 	var methods []*DenseBinOp
 	for _, cbo := range cmpBinOps {
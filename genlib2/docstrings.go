@@ -16,6 +16,24 @@ var arithDocStrings = map[string]*template.Template{
 	"DivScalar": template.Must(template.New("÷").Parse("// DivScalar performs {{.Left}} ÷ {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T), WithIncr(T)\n")),
 	"PowScalar": template.Must(template.New("^").Parse("// PowScalar performs {{.Left}} ^ {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T), WithIncr(T)\n")),
 	"ModScalar": template.Must(template.New("%").Parse("// ModScalar performs {{.Left}} % {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T), WithIncr(T)\n")),
+
+	"And":    template.Must(template.New("∧").Parse("// And performs {{.Left}} ∧ {{.Right}} elementwise. Both {{.Left}} and {{.Right}} must have the same shape.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"Or":     template.Must(template.New("∨").Parse("// Or performs {{.Left}} ∨ {{.Right}} elementwise. Both {{.Left}} and {{.Right}} must have the same shape.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"Xor":    template.Must(template.New("⊕").Parse("// Xor performs {{.Left}} ⊕ {{.Right}} elementwise. Both {{.Left}} and {{.Right}} must have the same shape.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"BitAnd": template.Must(template.New("&").Parse("// BitAnd performs {{.Left}} & {{.Right}} elementwise. Both {{.Left}} and {{.Right}} must have the same shape.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"BitOr":  template.Must(template.New("|").Parse("// BitOr performs {{.Left}} | {{.Right}} elementwise. Both {{.Left}} and {{.Right}} must have the same shape.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"BitXor": template.Must(template.New("^").Parse("// BitXor performs {{.Left}} ^ {{.Right}} elementwise. Both {{.Left}} and {{.Right}} must have the same shape.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"Shl":    template.Must(template.New("<<").Parse("// Shl performs {{.Left}} << {{.Right}} elementwise. Both {{.Left}} and {{.Right}} must have the same shape.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"Shr":    template.Must(template.New(">>").Parse("// Shr performs {{.Left}} >> {{.Right}} elementwise. Both {{.Left}} and {{.Right}} must have the same shape.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+
+	"AndScalar":    template.Must(template.New("∧").Parse("// AndScalar performs {{.Left}} ∧ {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"OrScalar":     template.Must(template.New("∨").Parse("// OrScalar performs {{.Left}} ∨ {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"XorScalar":    template.Must(template.New("⊕").Parse("// XorScalar performs {{.Left}} ⊕ {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"BitAndScalar": template.Must(template.New("&").Parse("// BitAndScalar performs {{.Left}} & {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"BitOrScalar":  template.Must(template.New("|").Parse("// BitOrScalar performs {{.Left}} | {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"BitXorScalar": template.Must(template.New("^").Parse("// BitXorScalar performs {{.Left}} ^ {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"ShlScalar":    template.Must(template.New("<<").Parse("// ShlScalar performs {{.Left}} << {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
+	"ShrScalar":    template.Must(template.New(">>").Parse("// ShrScalar performs {{.Left}} >> {{.Right}} elementwise. The leftTensor parameter indicates if the tensor is the left operand. Only scalar types are accepted in {{.Right}}.\n// Acceptable FuncOpts are: UseUnsafe(), WithReuse(T)\n")),
 }
 
 var cmpDocStrings = map[string]*template.Template{
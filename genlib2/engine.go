@@ -3,6 +3,7 @@ package main
 import (
 	"io"
 	"reflect"
+	"strings"
 	"text/template"
 )
 
@@ -12,6 +13,7 @@ type EngineArith struct {
 	PrepData       string
 	TypeClassCheck string
 	IsCommutative  bool
+	NoIncr         bool // the op cannot be used with WithIncr()
 
 	VV      bool
 	LeftVec bool
@@ -117,6 +119,32 @@ func generateStdEngArith(f io.Writer, ak Kinds) {
 
 }
 
+func generateStdEngBit(f io.Writer, ak Kinds) {
+	var methods []*EngineArith
+	for _, abo := range bitBinOps {
+		meth := &EngineArith{
+			Name:           abo.Name(),
+			VV:             true,
+			TypeClassCheck: strings.Title(strings.TrimSuffix(abo.TypeClassName, "Types")),
+			IsCommutative:  abo.IsCommutative,
+			NoIncr:         true,
+		}
+		methods = append(methods, meth)
+	}
+
+	// VV
+	for _, meth := range methods {
+		meth.Write(f)
+		meth.VV = false
+	}
+
+	// Scalar
+	for _, meth := range methods {
+		meth.Write(f)
+		meth.LeftVec = true
+	}
+}
+
 type EngineCmp struct {
 	Name           string
 	VecVar         string
@@ -400,6 +428,8 @@ func generateStdEngUncondUnary(f io.Writer, ak Kinds) {
 		"FloatCmplx", // Sqrt
 		"Float",      // Cbrt
 		"Float",      // InvSqrt
+		"Bool",       // Not
+		"Integer",    // BitNot
 	}
 	var gen []*EngineUnary
 	for i, u := range unconditionalUnaries {
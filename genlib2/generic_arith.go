@@ -403,6 +403,10 @@ func makeGenericVecVecAriths(tbo []TypedBinOp) (retVal []*GenericVecVecArith) {
 			fn.Check = panicsDiv0
 			fn.CheckTemplate = check0
 		}
+		if (tb.Name() == "Shl" || tb.Name() == "Shr") && panicsNegShift(tb.Kind()) {
+			fn.Check = panicsNegShift
+			fn.CheckTemplate = checkNeg
+		}
 
 		retVal = append(retVal, fn)
 
@@ -425,6 +429,10 @@ func makeGenericMixedAriths(tbo []TypedBinOp) (retVal []*GenericMixedArith) {
 			fn.Check = panicsDiv0
 			fn.CheckTemplate = check0
 		}
+		if (tb.Name() == "Shl" || tb.Name() == "Shr") && panicsNegShift(tb.Kind()) {
+			fn.Check = panicsNegShift
+			fn.CheckTemplate = checkNeg
+		}
 		retVal = append(retVal, fn)
 	}
 	return
@@ -530,3 +538,46 @@ func generateGenericScalarScalarArith(f io.Writer, ak Kinds) {
 		g.Write(f)
 	}
 }
+
+// generateGenericVecVecBit generates the logical and bitwise ops. There is no Incr variant of these.
+func generateGenericVecVecBit(f io.Writer, ak Kinds) {
+	gen := makeGenericVecVecAriths(typedBits)
+	for _, g := range gen {
+		g.Write(f)
+		g.Iter = true
+	}
+	for _, g := range gen {
+		g.Write(f)
+	}
+	for _, g := range gen {
+		g.Iter = false
+		g.WithRecv = true
+		g.Write(f)
+	}
+}
+
+func generateGenericMixedBit(f io.Writer, ak Kinds) {
+	gen := makeGenericMixedAriths(typedBits)
+
+	// SV first
+	for _, g := range gen {
+		g.Write(f)
+		g.Iter = true
+	}
+	for _, g := range gen {
+		g.Write(f)
+
+		// reset
+		g.LeftVec = true
+		g.Iter = false
+	}
+
+	// VS
+	for _, g := range gen {
+		g.Write(f)
+		g.Iter = true
+	}
+	for _, g := range gen {
+		g.Write(f)
+	}
+}
@@ -78,6 +78,17 @@ func isComplex(a reflect.Kind) bool {
 	return false
 }
 
+func isBool(a reflect.Kind) bool { return a == reflect.Bool }
+
+func isInteger(a reflect.Kind) bool {
+	for _, v := range integer {
+		if v == a {
+			return true
+		}
+	}
+	return false
+}
+
 func panicsDiv0(a reflect.Kind) bool {
 	for _, v := range div0panics {
 		if v == a {
@@ -87,6 +98,26 @@ func panicsDiv0(a reflect.Kind) bool {
 	return false
 }
 
+func panicsNegShift(a reflect.Kind) bool {
+	for _, v := range negShiftPanics {
+		if v == a {
+			return true
+		}
+	}
+	return false
+}
+
+// checksRight returns true if the named op has to check the right operand of kind a for the values that make it panic.
+func checksRight(name string, a reflect.Kind) bool {
+	switch name {
+	case "Div":
+		return panicsDiv0(a)
+	case "Shl", "Shr":
+		return panicsNegShift(a)
+	}
+	return false
+}
+
 func isEq(a reflect.Kind) bool {
 	for _, v := range elEq {
 		if v == a {
@@ -148,6 +148,41 @@ func generateEArith(f io.Writer, kinds Kinds) {
 	}
 }
 
+func generateEBit(f io.Writer, kinds Kinds) {
+	var methods []*InternalEngArithMethod
+	for _, bo := range bitBinOps {
+		var ks []reflect.Kind
+		for _, k := range kinds.Kinds {
+			if tc := bo.TypeClass(); tc != nil && tc(k) {
+				ks = append(ks, k)
+			}
+		}
+		meth := &InternalEngArithMethod{
+			BinOp: bo,
+			Kinds: ks,
+		}
+		methods = append(methods, meth)
+	}
+
+	// write vanilla
+	for _, meth := range methods {
+		meth.Write(f)
+		meth.Iter = true
+	}
+
+	// write iter
+	for _, meth := range methods {
+		meth.Write(f)
+		meth.Iter = false
+	}
+
+	// write recv
+	for _, meth := range methods {
+		meth.WithRecv = true
+		meth.Write(f)
+	}
+}
+
 /* MAP */
 
 type InternalEngMap struct {
@@ -59,6 +59,8 @@ func main() {
 	// execution
 	pipeline(execLoc, "generic_arith_vv.go", Kinds{allKinds}, generateGenericVecVecArith)
 	pipeline(execLoc, "generic_arith_mixed.go", Kinds{allKinds}, generateGenericMixedArith)
+	pipeline(execLoc, "generic_bit_vv.go", Kinds{allKinds}, generateGenericVecVecBit)
+	pipeline(execLoc, "generic_bit_mixed.go", Kinds{allKinds}, generateGenericMixedBit)
 	// pipeline(execLoc, "generic_arith.go", Kinds{allKinds}, generateGenericScalarScalarArith) // generate once and manually edit later
 	pipeline(execLoc, "generic_cmp_vv.go", Kinds{allKinds}, generateGenericVecVecCmp)
 	pipeline(execLoc, "generic_cmp_mixed.go", Kinds{allKinds}, generateGenericMixedCmp)
@@ -71,6 +73,7 @@ func main() {
 
 	// level 1 aggregation
 	pipeline(execLoc, "eng_arith.go", Kinds{allKinds}, generateEArith)
+	pipeline(execLoc, "eng_bit.go", Kinds{allKinds}, generateEBit)
 	pipeline(execLoc, "eng_map.go", Kinds{allKinds}, generateEMap)
 	pipeline(execLoc, "eng_cmp.go", Kinds{allKinds}, generateECmp)
 	pipeline(execLoc, "eng_minmaxbetween.go", Kinds{allKinds}, generateEMinMaxBetween)
@@ -81,12 +84,14 @@ func main() {
 
 	// level 2 aggregation
 	pipeline(tensorPkgLoc, "defaultengine_arith.go", Kinds{allKinds}, generateStdEngArith)
+	pipeline(tensorPkgLoc, "defaultengine_bit.go", Kinds{allKinds}, generateStdEngBit)
 	pipeline(tensorPkgLoc, "defaultengine_cmp.go", Kinds{allKinds}, generateStdEngCmp)
 	pipeline(tensorPkgLoc, "defaultengine_unary.go", Kinds{allKinds}, generateStdEngUncondUnary, generateStdEngCondUnary)
 	pipeline(tensorPkgLoc, "defaultengine_minmax.go", Kinds{allKinds}, generateStdEngMinMax)
 
 	// level 3 aggregation
 	pipeline(tensorPkgLoc, "dense_arith.go", Kinds{allKinds}, generateDenseArith)
+	pipeline(tensorPkgLoc, "dense_bit.go", Kinds{allKinds}, generateDenseBit)
 	pipeline(tensorPkgLoc, "dense_cmp.go", Kinds{allKinds}, generateDenseCmp) // generate once, manually edit later
 
 	// level 4 aggregation
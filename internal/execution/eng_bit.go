@@ -0,0 +1,2092 @@
+// Code generated by genlib2. DO NOT EDIT.
+
+package execution
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"gorgonia.org/tensor/internal/storage"
+)
+
+func (e E) And(t reflect.Type, a *storage.Header, b *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		switch {
+		case as && bs:
+			VecAndB(at, bt)
+		case as && !bs:
+			AndSVB(at[0], bt)
+		case !as && bs:
+			AndVSB(at, bt[0])
+		default:
+			VecAndB(at, bt)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for And", t)
+	}
+}
+
+func (e E) Or(t reflect.Type, a *storage.Header, b *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		switch {
+		case as && bs:
+			VecOrB(at, bt)
+		case as && !bs:
+			OrSVB(at[0], bt)
+		case !as && bs:
+			OrVSB(at, bt[0])
+		default:
+			VecOrB(at, bt)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for Or", t)
+	}
+}
+
+func (e E) Xor(t reflect.Type, a *storage.Header, b *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		switch {
+		case as && bs:
+			VecXorB(at, bt)
+		case as && !bs:
+			XorSVB(at[0], bt)
+		case !as && bs:
+			XorVSB(at, bt[0])
+		default:
+			VecXorB(at, bt)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for Xor", t)
+	}
+}
+
+func (e E) BitAnd(t reflect.Type, a *storage.Header, b *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecBitAndI(at, bt)
+		case as && !bs:
+			BitAndSVI(at[0], bt)
+		case !as && bs:
+			BitAndVSI(at, bt[0])
+		default:
+			VecBitAndI(at, bt)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecBitAndI8(at, bt)
+		case as && !bs:
+			BitAndSVI8(at[0], bt)
+		case !as && bs:
+			BitAndVSI8(at, bt[0])
+		default:
+			VecBitAndI8(at, bt)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecBitAndI16(at, bt)
+		case as && !bs:
+			BitAndSVI16(at[0], bt)
+		case !as && bs:
+			BitAndVSI16(at, bt[0])
+		default:
+			VecBitAndI16(at, bt)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecBitAndI32(at, bt)
+		case as && !bs:
+			BitAndSVI32(at[0], bt)
+		case !as && bs:
+			BitAndVSI32(at, bt[0])
+		default:
+			VecBitAndI32(at, bt)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecBitAndI64(at, bt)
+		case as && !bs:
+			BitAndSVI64(at[0], bt)
+		case !as && bs:
+			BitAndVSI64(at, bt[0])
+		default:
+			VecBitAndI64(at, bt)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecBitAndU(at, bt)
+		case as && !bs:
+			BitAndSVU(at[0], bt)
+		case !as && bs:
+			BitAndVSU(at, bt[0])
+		default:
+			VecBitAndU(at, bt)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecBitAndU8(at, bt)
+		case as && !bs:
+			BitAndSVU8(at[0], bt)
+		case !as && bs:
+			BitAndVSU8(at, bt[0])
+		default:
+			VecBitAndU8(at, bt)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecBitAndU16(at, bt)
+		case as && !bs:
+			BitAndSVU16(at[0], bt)
+		case !as && bs:
+			BitAndVSU16(at, bt[0])
+		default:
+			VecBitAndU16(at, bt)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecBitAndU32(at, bt)
+		case as && !bs:
+			BitAndSVU32(at[0], bt)
+		case !as && bs:
+			BitAndVSU32(at, bt[0])
+		default:
+			VecBitAndU32(at, bt)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecBitAndU64(at, bt)
+		case as && !bs:
+			BitAndSVU64(at[0], bt)
+		case !as && bs:
+			BitAndVSU64(at, bt[0])
+		default:
+			VecBitAndU64(at, bt)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitAnd", t)
+	}
+}
+
+func (e E) BitOr(t reflect.Type, a *storage.Header, b *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecBitOrI(at, bt)
+		case as && !bs:
+			BitOrSVI(at[0], bt)
+		case !as && bs:
+			BitOrVSI(at, bt[0])
+		default:
+			VecBitOrI(at, bt)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecBitOrI8(at, bt)
+		case as && !bs:
+			BitOrSVI8(at[0], bt)
+		case !as && bs:
+			BitOrVSI8(at, bt[0])
+		default:
+			VecBitOrI8(at, bt)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecBitOrI16(at, bt)
+		case as && !bs:
+			BitOrSVI16(at[0], bt)
+		case !as && bs:
+			BitOrVSI16(at, bt[0])
+		default:
+			VecBitOrI16(at, bt)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecBitOrI32(at, bt)
+		case as && !bs:
+			BitOrSVI32(at[0], bt)
+		case !as && bs:
+			BitOrVSI32(at, bt[0])
+		default:
+			VecBitOrI32(at, bt)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecBitOrI64(at, bt)
+		case as && !bs:
+			BitOrSVI64(at[0], bt)
+		case !as && bs:
+			BitOrVSI64(at, bt[0])
+		default:
+			VecBitOrI64(at, bt)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecBitOrU(at, bt)
+		case as && !bs:
+			BitOrSVU(at[0], bt)
+		case !as && bs:
+			BitOrVSU(at, bt[0])
+		default:
+			VecBitOrU(at, bt)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecBitOrU8(at, bt)
+		case as && !bs:
+			BitOrSVU8(at[0], bt)
+		case !as && bs:
+			BitOrVSU8(at, bt[0])
+		default:
+			VecBitOrU8(at, bt)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecBitOrU16(at, bt)
+		case as && !bs:
+			BitOrSVU16(at[0], bt)
+		case !as && bs:
+			BitOrVSU16(at, bt[0])
+		default:
+			VecBitOrU16(at, bt)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecBitOrU32(at, bt)
+		case as && !bs:
+			BitOrSVU32(at[0], bt)
+		case !as && bs:
+			BitOrVSU32(at, bt[0])
+		default:
+			VecBitOrU32(at, bt)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecBitOrU64(at, bt)
+		case as && !bs:
+			BitOrSVU64(at[0], bt)
+		case !as && bs:
+			BitOrVSU64(at, bt[0])
+		default:
+			VecBitOrU64(at, bt)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitOr", t)
+	}
+}
+
+func (e E) BitXor(t reflect.Type, a *storage.Header, b *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecBitXorI(at, bt)
+		case as && !bs:
+			BitXorSVI(at[0], bt)
+		case !as && bs:
+			BitXorVSI(at, bt[0])
+		default:
+			VecBitXorI(at, bt)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecBitXorI8(at, bt)
+		case as && !bs:
+			BitXorSVI8(at[0], bt)
+		case !as && bs:
+			BitXorVSI8(at, bt[0])
+		default:
+			VecBitXorI8(at, bt)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecBitXorI16(at, bt)
+		case as && !bs:
+			BitXorSVI16(at[0], bt)
+		case !as && bs:
+			BitXorVSI16(at, bt[0])
+		default:
+			VecBitXorI16(at, bt)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecBitXorI32(at, bt)
+		case as && !bs:
+			BitXorSVI32(at[0], bt)
+		case !as && bs:
+			BitXorVSI32(at, bt[0])
+		default:
+			VecBitXorI32(at, bt)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecBitXorI64(at, bt)
+		case as && !bs:
+			BitXorSVI64(at[0], bt)
+		case !as && bs:
+			BitXorVSI64(at, bt[0])
+		default:
+			VecBitXorI64(at, bt)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecBitXorU(at, bt)
+		case as && !bs:
+			BitXorSVU(at[0], bt)
+		case !as && bs:
+			BitXorVSU(at, bt[0])
+		default:
+			VecBitXorU(at, bt)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecBitXorU8(at, bt)
+		case as && !bs:
+			BitXorSVU8(at[0], bt)
+		case !as && bs:
+			BitXorVSU8(at, bt[0])
+		default:
+			VecBitXorU8(at, bt)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecBitXorU16(at, bt)
+		case as && !bs:
+			BitXorSVU16(at[0], bt)
+		case !as && bs:
+			BitXorVSU16(at, bt[0])
+		default:
+			VecBitXorU16(at, bt)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecBitXorU32(at, bt)
+		case as && !bs:
+			BitXorSVU32(at[0], bt)
+		case !as && bs:
+			BitXorVSU32(at, bt[0])
+		default:
+			VecBitXorU32(at, bt)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecBitXorU64(at, bt)
+		case as && !bs:
+			BitXorSVU64(at[0], bt)
+		case !as && bs:
+			BitXorVSU64(at, bt[0])
+		default:
+			VecBitXorU64(at, bt)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitXor", t)
+	}
+}
+
+func (e E) Shl(t reflect.Type, a *storage.Header, b *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecShlI(at, bt)
+		case as && !bs:
+			err = ShlSVI(at[0], bt)
+		case !as && bs:
+			err = ShlVSI(at, bt[0])
+		default:
+			err = VecShlI(at, bt)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecShlI8(at, bt)
+		case as && !bs:
+			err = ShlSVI8(at[0], bt)
+		case !as && bs:
+			err = ShlVSI8(at, bt[0])
+		default:
+			err = VecShlI8(at, bt)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecShlI16(at, bt)
+		case as && !bs:
+			err = ShlSVI16(at[0], bt)
+		case !as && bs:
+			err = ShlVSI16(at, bt[0])
+		default:
+			err = VecShlI16(at, bt)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecShlI32(at, bt)
+		case as && !bs:
+			err = ShlSVI32(at[0], bt)
+		case !as && bs:
+			err = ShlVSI32(at, bt[0])
+		default:
+			err = VecShlI32(at, bt)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecShlI64(at, bt)
+		case as && !bs:
+			err = ShlSVI64(at[0], bt)
+		case !as && bs:
+			err = ShlVSI64(at, bt[0])
+		default:
+			err = VecShlI64(at, bt)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecShlU(at, bt)
+		case as && !bs:
+			ShlSVU(at[0], bt)
+		case !as && bs:
+			ShlVSU(at, bt[0])
+		default:
+			VecShlU(at, bt)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecShlU8(at, bt)
+		case as && !bs:
+			ShlSVU8(at[0], bt)
+		case !as && bs:
+			ShlVSU8(at, bt[0])
+		default:
+			VecShlU8(at, bt)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecShlU16(at, bt)
+		case as && !bs:
+			ShlSVU16(at[0], bt)
+		case !as && bs:
+			ShlVSU16(at, bt[0])
+		default:
+			VecShlU16(at, bt)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecShlU32(at, bt)
+		case as && !bs:
+			ShlSVU32(at[0], bt)
+		case !as && bs:
+			ShlVSU32(at, bt[0])
+		default:
+			VecShlU32(at, bt)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecShlU64(at, bt)
+		case as && !bs:
+			ShlSVU64(at[0], bt)
+		case !as && bs:
+			ShlVSU64(at, bt[0])
+		default:
+			VecShlU64(at, bt)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for Shl", t)
+	}
+}
+
+func (e E) Shr(t reflect.Type, a *storage.Header, b *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecShrI(at, bt)
+		case as && !bs:
+			err = ShrSVI(at[0], bt)
+		case !as && bs:
+			err = ShrVSI(at, bt[0])
+		default:
+			err = VecShrI(at, bt)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecShrI8(at, bt)
+		case as && !bs:
+			err = ShrSVI8(at[0], bt)
+		case !as && bs:
+			err = ShrVSI8(at, bt[0])
+		default:
+			err = VecShrI8(at, bt)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecShrI16(at, bt)
+		case as && !bs:
+			err = ShrSVI16(at[0], bt)
+		case !as && bs:
+			err = ShrVSI16(at, bt[0])
+		default:
+			err = VecShrI16(at, bt)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecShrI32(at, bt)
+		case as && !bs:
+			err = ShrSVI32(at[0], bt)
+		case !as && bs:
+			err = ShrVSI32(at, bt[0])
+		default:
+			err = VecShrI32(at, bt)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecShrI64(at, bt)
+		case as && !bs:
+			err = ShrSVI64(at[0], bt)
+		case !as && bs:
+			err = ShrVSI64(at, bt[0])
+		default:
+			err = VecShrI64(at, bt)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecShrU(at, bt)
+		case as && !bs:
+			ShrSVU(at[0], bt)
+		case !as && bs:
+			ShrVSU(at, bt[0])
+		default:
+			VecShrU(at, bt)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecShrU8(at, bt)
+		case as && !bs:
+			ShrSVU8(at[0], bt)
+		case !as && bs:
+			ShrVSU8(at, bt[0])
+		default:
+			VecShrU8(at, bt)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecShrU16(at, bt)
+		case as && !bs:
+			ShrSVU16(at[0], bt)
+		case !as && bs:
+			ShrVSU16(at, bt[0])
+		default:
+			VecShrU16(at, bt)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecShrU32(at, bt)
+		case as && !bs:
+			ShrSVU32(at[0], bt)
+		case !as && bs:
+			ShrVSU32(at, bt[0])
+		default:
+			VecShrU32(at, bt)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecShrU64(at, bt)
+		case as && !bs:
+			ShrSVU64(at[0], bt)
+		case !as && bs:
+			ShrVSU64(at, bt[0])
+		default:
+			VecShrU64(at, bt)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for Shr", t)
+	}
+}
+
+func (e E) AndIter(t reflect.Type, a *storage.Header, b *storage.Header, ait Iterator, bit Iterator) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		switch {
+		case as && bs:
+			VecAndB(at, bt)
+		case as && !bs:
+			AndIterSVB(at[0], bt, bit)
+		case !as && bs:
+			AndIterVSB(at, bt[0], ait)
+		default:
+			AndIterB(at, bt, ait, bit)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for AndIter", t)
+	}
+}
+
+func (e E) OrIter(t reflect.Type, a *storage.Header, b *storage.Header, ait Iterator, bit Iterator) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		switch {
+		case as && bs:
+			VecOrB(at, bt)
+		case as && !bs:
+			OrIterSVB(at[0], bt, bit)
+		case !as && bs:
+			OrIterVSB(at, bt[0], ait)
+		default:
+			OrIterB(at, bt, ait, bit)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for OrIter", t)
+	}
+}
+
+func (e E) XorIter(t reflect.Type, a *storage.Header, b *storage.Header, ait Iterator, bit Iterator) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		switch {
+		case as && bs:
+			VecXorB(at, bt)
+		case as && !bs:
+			XorIterSVB(at[0], bt, bit)
+		case !as && bs:
+			XorIterVSB(at, bt[0], ait)
+		default:
+			XorIterB(at, bt, ait, bit)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for XorIter", t)
+	}
+}
+
+func (e E) BitAndIter(t reflect.Type, a *storage.Header, b *storage.Header, ait Iterator, bit Iterator) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecBitAndI(at, bt)
+		case as && !bs:
+			BitAndIterSVI(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSI(at, bt[0], ait)
+		default:
+			BitAndIterI(at, bt, ait, bit)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecBitAndI8(at, bt)
+		case as && !bs:
+			BitAndIterSVI8(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSI8(at, bt[0], ait)
+		default:
+			BitAndIterI8(at, bt, ait, bit)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecBitAndI16(at, bt)
+		case as && !bs:
+			BitAndIterSVI16(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSI16(at, bt[0], ait)
+		default:
+			BitAndIterI16(at, bt, ait, bit)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecBitAndI32(at, bt)
+		case as && !bs:
+			BitAndIterSVI32(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSI32(at, bt[0], ait)
+		default:
+			BitAndIterI32(at, bt, ait, bit)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecBitAndI64(at, bt)
+		case as && !bs:
+			BitAndIterSVI64(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSI64(at, bt[0], ait)
+		default:
+			BitAndIterI64(at, bt, ait, bit)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecBitAndU(at, bt)
+		case as && !bs:
+			BitAndIterSVU(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSU(at, bt[0], ait)
+		default:
+			BitAndIterU(at, bt, ait, bit)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecBitAndU8(at, bt)
+		case as && !bs:
+			BitAndIterSVU8(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSU8(at, bt[0], ait)
+		default:
+			BitAndIterU8(at, bt, ait, bit)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecBitAndU16(at, bt)
+		case as && !bs:
+			BitAndIterSVU16(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSU16(at, bt[0], ait)
+		default:
+			BitAndIterU16(at, bt, ait, bit)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecBitAndU32(at, bt)
+		case as && !bs:
+			BitAndIterSVU32(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSU32(at, bt[0], ait)
+		default:
+			BitAndIterU32(at, bt, ait, bit)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecBitAndU64(at, bt)
+		case as && !bs:
+			BitAndIterSVU64(at[0], bt, bit)
+		case !as && bs:
+			BitAndIterVSU64(at, bt[0], ait)
+		default:
+			BitAndIterU64(at, bt, ait, bit)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitAndIter", t)
+	}
+}
+
+func (e E) BitOrIter(t reflect.Type, a *storage.Header, b *storage.Header, ait Iterator, bit Iterator) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecBitOrI(at, bt)
+		case as && !bs:
+			BitOrIterSVI(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSI(at, bt[0], ait)
+		default:
+			BitOrIterI(at, bt, ait, bit)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecBitOrI8(at, bt)
+		case as && !bs:
+			BitOrIterSVI8(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSI8(at, bt[0], ait)
+		default:
+			BitOrIterI8(at, bt, ait, bit)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecBitOrI16(at, bt)
+		case as && !bs:
+			BitOrIterSVI16(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSI16(at, bt[0], ait)
+		default:
+			BitOrIterI16(at, bt, ait, bit)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecBitOrI32(at, bt)
+		case as && !bs:
+			BitOrIterSVI32(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSI32(at, bt[0], ait)
+		default:
+			BitOrIterI32(at, bt, ait, bit)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecBitOrI64(at, bt)
+		case as && !bs:
+			BitOrIterSVI64(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSI64(at, bt[0], ait)
+		default:
+			BitOrIterI64(at, bt, ait, bit)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecBitOrU(at, bt)
+		case as && !bs:
+			BitOrIterSVU(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSU(at, bt[0], ait)
+		default:
+			BitOrIterU(at, bt, ait, bit)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecBitOrU8(at, bt)
+		case as && !bs:
+			BitOrIterSVU8(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSU8(at, bt[0], ait)
+		default:
+			BitOrIterU8(at, bt, ait, bit)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecBitOrU16(at, bt)
+		case as && !bs:
+			BitOrIterSVU16(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSU16(at, bt[0], ait)
+		default:
+			BitOrIterU16(at, bt, ait, bit)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecBitOrU32(at, bt)
+		case as && !bs:
+			BitOrIterSVU32(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSU32(at, bt[0], ait)
+		default:
+			BitOrIterU32(at, bt, ait, bit)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecBitOrU64(at, bt)
+		case as && !bs:
+			BitOrIterSVU64(at[0], bt, bit)
+		case !as && bs:
+			BitOrIterVSU64(at, bt[0], ait)
+		default:
+			BitOrIterU64(at, bt, ait, bit)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitOrIter", t)
+	}
+}
+
+func (e E) BitXorIter(t reflect.Type, a *storage.Header, b *storage.Header, ait Iterator, bit Iterator) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecBitXorI(at, bt)
+		case as && !bs:
+			BitXorIterSVI(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSI(at, bt[0], ait)
+		default:
+			BitXorIterI(at, bt, ait, bit)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecBitXorI8(at, bt)
+		case as && !bs:
+			BitXorIterSVI8(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSI8(at, bt[0], ait)
+		default:
+			BitXorIterI8(at, bt, ait, bit)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecBitXorI16(at, bt)
+		case as && !bs:
+			BitXorIterSVI16(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSI16(at, bt[0], ait)
+		default:
+			BitXorIterI16(at, bt, ait, bit)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecBitXorI32(at, bt)
+		case as && !bs:
+			BitXorIterSVI32(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSI32(at, bt[0], ait)
+		default:
+			BitXorIterI32(at, bt, ait, bit)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecBitXorI64(at, bt)
+		case as && !bs:
+			BitXorIterSVI64(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSI64(at, bt[0], ait)
+		default:
+			BitXorIterI64(at, bt, ait, bit)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecBitXorU(at, bt)
+		case as && !bs:
+			BitXorIterSVU(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSU(at, bt[0], ait)
+		default:
+			BitXorIterU(at, bt, ait, bit)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecBitXorU8(at, bt)
+		case as && !bs:
+			BitXorIterSVU8(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSU8(at, bt[0], ait)
+		default:
+			BitXorIterU8(at, bt, ait, bit)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecBitXorU16(at, bt)
+		case as && !bs:
+			BitXorIterSVU16(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSU16(at, bt[0], ait)
+		default:
+			BitXorIterU16(at, bt, ait, bit)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecBitXorU32(at, bt)
+		case as && !bs:
+			BitXorIterSVU32(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSU32(at, bt[0], ait)
+		default:
+			BitXorIterU32(at, bt, ait, bit)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecBitXorU64(at, bt)
+		case as && !bs:
+			BitXorIterSVU64(at[0], bt, bit)
+		case !as && bs:
+			BitXorIterVSU64(at, bt[0], ait)
+		default:
+			BitXorIterU64(at, bt, ait, bit)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitXorIter", t)
+	}
+}
+
+func (e E) ShlIter(t reflect.Type, a *storage.Header, b *storage.Header, ait Iterator, bit Iterator) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecShlI(at, bt)
+		case as && !bs:
+			ShlIterSVI(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSI(at, bt[0], ait)
+		default:
+			ShlIterI(at, bt, ait, bit)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecShlI8(at, bt)
+		case as && !bs:
+			ShlIterSVI8(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSI8(at, bt[0], ait)
+		default:
+			ShlIterI8(at, bt, ait, bit)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecShlI16(at, bt)
+		case as && !bs:
+			ShlIterSVI16(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSI16(at, bt[0], ait)
+		default:
+			ShlIterI16(at, bt, ait, bit)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecShlI32(at, bt)
+		case as && !bs:
+			ShlIterSVI32(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSI32(at, bt[0], ait)
+		default:
+			ShlIterI32(at, bt, ait, bit)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecShlI64(at, bt)
+		case as && !bs:
+			ShlIterSVI64(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSI64(at, bt[0], ait)
+		default:
+			ShlIterI64(at, bt, ait, bit)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecShlU(at, bt)
+		case as && !bs:
+			ShlIterSVU(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSU(at, bt[0], ait)
+		default:
+			ShlIterU(at, bt, ait, bit)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecShlU8(at, bt)
+		case as && !bs:
+			ShlIterSVU8(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSU8(at, bt[0], ait)
+		default:
+			ShlIterU8(at, bt, ait, bit)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecShlU16(at, bt)
+		case as && !bs:
+			ShlIterSVU16(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSU16(at, bt[0], ait)
+		default:
+			ShlIterU16(at, bt, ait, bit)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecShlU32(at, bt)
+		case as && !bs:
+			ShlIterSVU32(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSU32(at, bt[0], ait)
+		default:
+			ShlIterU32(at, bt, ait, bit)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecShlU64(at, bt)
+		case as && !bs:
+			ShlIterSVU64(at[0], bt, bit)
+		case !as && bs:
+			ShlIterVSU64(at, bt[0], ait)
+		default:
+			ShlIterU64(at, bt, ait, bit)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for ShlIter", t)
+	}
+}
+
+func (e E) ShrIter(t reflect.Type, a *storage.Header, b *storage.Header, ait Iterator, bit Iterator) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		switch {
+		case as && bs:
+			VecShrI(at, bt)
+		case as && !bs:
+			ShrIterSVI(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSI(at, bt[0], ait)
+		default:
+			ShrIterI(at, bt, ait, bit)
+		}
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		switch {
+		case as && bs:
+			VecShrI8(at, bt)
+		case as && !bs:
+			ShrIterSVI8(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSI8(at, bt[0], ait)
+		default:
+			ShrIterI8(at, bt, ait, bit)
+		}
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		switch {
+		case as && bs:
+			VecShrI16(at, bt)
+		case as && !bs:
+			ShrIterSVI16(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSI16(at, bt[0], ait)
+		default:
+			ShrIterI16(at, bt, ait, bit)
+		}
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		switch {
+		case as && bs:
+			VecShrI32(at, bt)
+		case as && !bs:
+			ShrIterSVI32(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSI32(at, bt[0], ait)
+		default:
+			ShrIterI32(at, bt, ait, bit)
+		}
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		switch {
+		case as && bs:
+			VecShrI64(at, bt)
+		case as && !bs:
+			ShrIterSVI64(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSI64(at, bt[0], ait)
+		default:
+			ShrIterI64(at, bt, ait, bit)
+		}
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		switch {
+		case as && bs:
+			VecShrU(at, bt)
+		case as && !bs:
+			ShrIterSVU(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSU(at, bt[0], ait)
+		default:
+			ShrIterU(at, bt, ait, bit)
+		}
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		switch {
+		case as && bs:
+			VecShrU8(at, bt)
+		case as && !bs:
+			ShrIterSVU8(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSU8(at, bt[0], ait)
+		default:
+			ShrIterU8(at, bt, ait, bit)
+		}
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		switch {
+		case as && bs:
+			VecShrU16(at, bt)
+		case as && !bs:
+			ShrIterSVU16(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSU16(at, bt[0], ait)
+		default:
+			ShrIterU16(at, bt, ait, bit)
+		}
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		switch {
+		case as && bs:
+			VecShrU32(at, bt)
+		case as && !bs:
+			ShrIterSVU32(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSU32(at, bt[0], ait)
+		default:
+			ShrIterU32(at, bt, ait, bit)
+		}
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		switch {
+		case as && bs:
+			VecShrU64(at, bt)
+		case as && !bs:
+			ShrIterSVU64(at[0], bt, bit)
+		case !as && bs:
+			ShrIterVSU64(at, bt[0], ait)
+		default:
+			ShrIterU64(at, bt, ait, bit)
+		}
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for ShrIter", t)
+	}
+}
+
+func (e E) AndRecv(t reflect.Type, a *storage.Header, b *storage.Header, recv *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+	rs := isScalar(recv, t)
+
+	if ((as && !bs) || (bs && !as)) && rs {
+		return errors.Errorf("Cannot increment on a scalar increment. len(a): %d, len(b) %d", a.TypedLen(t), b.TypedLen(t))
+	}
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		rt := recv.Bools()
+		AndRecvB(at, bt, rt)
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for AndRecv", t)
+	}
+}
+
+func (e E) OrRecv(t reflect.Type, a *storage.Header, b *storage.Header, recv *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+	rs := isScalar(recv, t)
+
+	if ((as && !bs) || (bs && !as)) && rs {
+		return errors.Errorf("Cannot increment on a scalar increment. len(a): %d, len(b) %d", a.TypedLen(t), b.TypedLen(t))
+	}
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		rt := recv.Bools()
+		OrRecvB(at, bt, rt)
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for OrRecv", t)
+	}
+}
+
+func (e E) XorRecv(t reflect.Type, a *storage.Header, b *storage.Header, recv *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+	rs := isScalar(recv, t)
+
+	if ((as && !bs) || (bs && !as)) && rs {
+		return errors.Errorf("Cannot increment on a scalar increment. len(a): %d, len(b) %d", a.TypedLen(t), b.TypedLen(t))
+	}
+
+	switch t {
+	case Bool:
+		at := a.Bools()
+		bt := b.Bools()
+		rt := recv.Bools()
+		XorRecvB(at, bt, rt)
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for XorRecv", t)
+	}
+}
+
+func (e E) BitAndRecv(t reflect.Type, a *storage.Header, b *storage.Header, recv *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+	rs := isScalar(recv, t)
+
+	if ((as && !bs) || (bs && !as)) && rs {
+		return errors.Errorf("Cannot increment on a scalar increment. len(a): %d, len(b) %d", a.TypedLen(t), b.TypedLen(t))
+	}
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		rt := recv.Ints()
+		BitAndRecvI(at, bt, rt)
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		rt := recv.Int8s()
+		BitAndRecvI8(at, bt, rt)
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		rt := recv.Int16s()
+		BitAndRecvI16(at, bt, rt)
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		rt := recv.Int32s()
+		BitAndRecvI32(at, bt, rt)
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		rt := recv.Int64s()
+		BitAndRecvI64(at, bt, rt)
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		rt := recv.Uints()
+		BitAndRecvU(at, bt, rt)
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		rt := recv.Uint8s()
+		BitAndRecvU8(at, bt, rt)
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		rt := recv.Uint16s()
+		BitAndRecvU16(at, bt, rt)
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		rt := recv.Uint32s()
+		BitAndRecvU32(at, bt, rt)
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		rt := recv.Uint64s()
+		BitAndRecvU64(at, bt, rt)
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitAndRecv", t)
+	}
+}
+
+func (e E) BitOrRecv(t reflect.Type, a *storage.Header, b *storage.Header, recv *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+	rs := isScalar(recv, t)
+
+	if ((as && !bs) || (bs && !as)) && rs {
+		return errors.Errorf("Cannot increment on a scalar increment. len(a): %d, len(b) %d", a.TypedLen(t), b.TypedLen(t))
+	}
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		rt := recv.Ints()
+		BitOrRecvI(at, bt, rt)
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		rt := recv.Int8s()
+		BitOrRecvI8(at, bt, rt)
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		rt := recv.Int16s()
+		BitOrRecvI16(at, bt, rt)
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		rt := recv.Int32s()
+		BitOrRecvI32(at, bt, rt)
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		rt := recv.Int64s()
+		BitOrRecvI64(at, bt, rt)
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		rt := recv.Uints()
+		BitOrRecvU(at, bt, rt)
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		rt := recv.Uint8s()
+		BitOrRecvU8(at, bt, rt)
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		rt := recv.Uint16s()
+		BitOrRecvU16(at, bt, rt)
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		rt := recv.Uint32s()
+		BitOrRecvU32(at, bt, rt)
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		rt := recv.Uint64s()
+		BitOrRecvU64(at, bt, rt)
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitOrRecv", t)
+	}
+}
+
+func (e E) BitXorRecv(t reflect.Type, a *storage.Header, b *storage.Header, recv *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+	rs := isScalar(recv, t)
+
+	if ((as && !bs) || (bs && !as)) && rs {
+		return errors.Errorf("Cannot increment on a scalar increment. len(a): %d, len(b) %d", a.TypedLen(t), b.TypedLen(t))
+	}
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		rt := recv.Ints()
+		BitXorRecvI(at, bt, rt)
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		rt := recv.Int8s()
+		BitXorRecvI8(at, bt, rt)
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		rt := recv.Int16s()
+		BitXorRecvI16(at, bt, rt)
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		rt := recv.Int32s()
+		BitXorRecvI32(at, bt, rt)
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		rt := recv.Int64s()
+		BitXorRecvI64(at, bt, rt)
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		rt := recv.Uints()
+		BitXorRecvU(at, bt, rt)
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		rt := recv.Uint8s()
+		BitXorRecvU8(at, bt, rt)
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		rt := recv.Uint16s()
+		BitXorRecvU16(at, bt, rt)
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		rt := recv.Uint32s()
+		BitXorRecvU32(at, bt, rt)
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		rt := recv.Uint64s()
+		BitXorRecvU64(at, bt, rt)
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for BitXorRecv", t)
+	}
+}
+
+func (e E) ShlRecv(t reflect.Type, a *storage.Header, b *storage.Header, recv *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+	rs := isScalar(recv, t)
+
+	if ((as && !bs) || (bs && !as)) && rs {
+		return errors.Errorf("Cannot increment on a scalar increment. len(a): %d, len(b) %d", a.TypedLen(t), b.TypedLen(t))
+	}
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		rt := recv.Ints()
+		ShlRecvI(at, bt, rt)
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		rt := recv.Int8s()
+		ShlRecvI8(at, bt, rt)
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		rt := recv.Int16s()
+		ShlRecvI16(at, bt, rt)
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		rt := recv.Int32s()
+		ShlRecvI32(at, bt, rt)
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		rt := recv.Int64s()
+		ShlRecvI64(at, bt, rt)
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		rt := recv.Uints()
+		ShlRecvU(at, bt, rt)
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		rt := recv.Uint8s()
+		ShlRecvU8(at, bt, rt)
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		rt := recv.Uint16s()
+		ShlRecvU16(at, bt, rt)
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		rt := recv.Uint32s()
+		ShlRecvU32(at, bt, rt)
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		rt := recv.Uint64s()
+		ShlRecvU64(at, bt, rt)
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for ShlRecv", t)
+	}
+}
+
+func (e E) ShrRecv(t reflect.Type, a *storage.Header, b *storage.Header, recv *storage.Header) (err error) {
+	as := isScalar(a, t)
+	bs := isScalar(b, t)
+	rs := isScalar(recv, t)
+
+	if ((as && !bs) || (bs && !as)) && rs {
+		return errors.Errorf("Cannot increment on a scalar increment. len(a): %d, len(b) %d", a.TypedLen(t), b.TypedLen(t))
+	}
+
+	switch t {
+	case Int:
+		at := a.Ints()
+		bt := b.Ints()
+		rt := recv.Ints()
+		ShrRecvI(at, bt, rt)
+		return
+	case Int8:
+		at := a.Int8s()
+		bt := b.Int8s()
+		rt := recv.Int8s()
+		ShrRecvI8(at, bt, rt)
+		return
+	case Int16:
+		at := a.Int16s()
+		bt := b.Int16s()
+		rt := recv.Int16s()
+		ShrRecvI16(at, bt, rt)
+		return
+	case Int32:
+		at := a.Int32s()
+		bt := b.Int32s()
+		rt := recv.Int32s()
+		ShrRecvI32(at, bt, rt)
+		return
+	case Int64:
+		at := a.Int64s()
+		bt := b.Int64s()
+		rt := recv.Int64s()
+		ShrRecvI64(at, bt, rt)
+		return
+	case Uint:
+		at := a.Uints()
+		bt := b.Uints()
+		rt := recv.Uints()
+		ShrRecvU(at, bt, rt)
+		return
+	case Uint8:
+		at := a.Uint8s()
+		bt := b.Uint8s()
+		rt := recv.Uint8s()
+		ShrRecvU8(at, bt, rt)
+		return
+	case Uint16:
+		at := a.Uint16s()
+		bt := b.Uint16s()
+		rt := recv.Uint16s()
+		ShrRecvU16(at, bt, rt)
+		return
+	case Uint32:
+		at := a.Uint32s()
+		bt := b.Uint32s()
+		rt := recv.Uint32s()
+		ShrRecvU32(at, bt, rt)
+		return
+	case Uint64:
+		at := a.Uint64s()
+		bt := b.Uint64s()
+		rt := recv.Uint64s()
+		ShrRecvU64(at, bt, rt)
+		return
+	default:
+		return errors.Errorf("Unsupported type %v for ShrRecv", t)
+	}
+}
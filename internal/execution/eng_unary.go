@@ -339,6 +339,53 @@ func (e E) InvSqrt(t reflect.Type, a *storage.Header) (err error) {
 	}
 }
 
+func (e E) Not(t reflect.Type, a *storage.Header) (err error) {
+	switch t {
+	case Bool:
+		NotB(a.Bools())
+		return nil
+	default:
+		return errors.Errorf("Unsupported type %v for Not", t)
+	}
+}
+
+func (e E) BitNot(t reflect.Type, a *storage.Header) (err error) {
+	switch t {
+	case Int:
+		BitNotI(a.Ints())
+		return nil
+	case Int8:
+		BitNotI8(a.Int8s())
+		return nil
+	case Int16:
+		BitNotI16(a.Int16s())
+		return nil
+	case Int32:
+		BitNotI32(a.Int32s())
+		return nil
+	case Int64:
+		BitNotI64(a.Int64s())
+		return nil
+	case Uint:
+		BitNotU(a.Uints())
+		return nil
+	case Uint8:
+		BitNotU8(a.Uint8s())
+		return nil
+	case Uint16:
+		BitNotU16(a.Uint16s())
+		return nil
+	case Uint32:
+		BitNotU32(a.Uint32s())
+		return nil
+	case Uint64:
+		BitNotU64(a.Uint64s())
+		return nil
+	default:
+		return errors.Errorf("Unsupported type %v for BitNot", t)
+	}
+}
+
 func (e E) NegIter(t reflect.Type, a *storage.Header, ait Iterator) (err error) {
 	switch t {
 	case Int:
@@ -587,6 +634,42 @@ func (e E) InvSqrtIter(t reflect.Type, a *storage.Header, ait Iterator) (err err
 	}
 }
 
+func (e E) NotIter(t reflect.Type, a *storage.Header, ait Iterator) (err error) {
+	switch t {
+	case Bool:
+		return NotIterB(a.Bools(), ait)
+	default:
+		return errors.Errorf("Unsupported type %v for NotIter", t)
+	}
+}
+
+func (e E) BitNotIter(t reflect.Type, a *storage.Header, ait Iterator) (err error) {
+	switch t {
+	case Int:
+		return BitNotIterI(a.Ints(), ait)
+	case Int8:
+		return BitNotIterI8(a.Int8s(), ait)
+	case Int16:
+		return BitNotIterI16(a.Int16s(), ait)
+	case Int32:
+		return BitNotIterI32(a.Int32s(), ait)
+	case Int64:
+		return BitNotIterI64(a.Int64s(), ait)
+	case Uint:
+		return BitNotIterU(a.Uints(), ait)
+	case Uint8:
+		return BitNotIterU8(a.Uint8s(), ait)
+	case Uint16:
+		return BitNotIterU16(a.Uint16s(), ait)
+	case Uint32:
+		return BitNotIterU32(a.Uint32s(), ait)
+	case Uint64:
+		return BitNotIterU64(a.Uint64s(), ait)
+	default:
+		return errors.Errorf("Unsupported type %v for BitNotIter", t)
+	}
+}
+
 func (e E) Abs(t reflect.Type, a *storage.Header) (err error) {
 	switch t {
 	case Int:
@@ -0,0 +1,2729 @@
+// Code generated by genlib2. DO NOT EDIT.
+
+package execution
+
+func AndSVB(a bool, b []bool) {
+	for i := range b {
+		b[i] = a && b[i]
+	}
+}
+
+func OrSVB(a bool, b []bool) {
+	for i := range b {
+		b[i] = a || b[i]
+	}
+}
+
+func XorSVB(a bool, b []bool) {
+	for i := range b {
+		b[i] = a != b[i]
+	}
+}
+
+func BitAndSVI(a int, b []int) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVI8(a int8, b []int8) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVI16(a int16, b []int16) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVI32(a int32, b []int32) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVI64(a int64, b []int64) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVU(a uint, b []uint) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVU8(a uint8, b []uint8) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVU16(a uint16, b []uint16) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVU32(a uint32, b []uint32) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitAndSVU64(a uint64, b []uint64) {
+	for i := range b {
+		b[i] = a & b[i]
+	}
+}
+
+func BitOrSVI(a int, b []int) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVI8(a int8, b []int8) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVI16(a int16, b []int16) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVI32(a int32, b []int32) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVI64(a int64, b []int64) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVU(a uint, b []uint) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVU8(a uint8, b []uint8) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVU16(a uint16, b []uint16) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVU32(a uint32, b []uint32) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitOrSVU64(a uint64, b []uint64) {
+	for i := range b {
+		b[i] = a | b[i]
+	}
+}
+
+func BitXorSVI(a int, b []int) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVI8(a int8, b []int8) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVI16(a int16, b []int16) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVI32(a int32, b []int32) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVI64(a int64, b []int64) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVU(a uint, b []uint) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVU8(a uint8, b []uint8) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVU16(a uint16, b []uint16) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVU32(a uint32, b []uint32) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func BitXorSVU64(a uint64, b []uint64) {
+	for i := range b {
+		b[i] = a ^ b[i]
+	}
+}
+
+func ShlSVI(a int, b []int) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlSVI8(a int8, b []int8) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlSVI16(a int16, b []int16) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlSVI32(a int32, b []int32) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlSVI64(a int64, b []int64) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlSVU(a uint, b []uint) {
+	for i := range b {
+		b[i] = a << b[i]
+	}
+}
+
+func ShlSVU8(a uint8, b []uint8) {
+	for i := range b {
+		b[i] = a << b[i]
+	}
+}
+
+func ShlSVU16(a uint16, b []uint16) {
+	for i := range b {
+		b[i] = a << b[i]
+	}
+}
+
+func ShlSVU32(a uint32, b []uint32) {
+	for i := range b {
+		b[i] = a << b[i]
+	}
+}
+
+func ShlSVU64(a uint64, b []uint64) {
+	for i := range b {
+		b[i] = a << b[i]
+	}
+}
+
+func ShrSVI(a int, b []int) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrSVI8(a int8, b []int8) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrSVI16(a int16, b []int16) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrSVI32(a int32, b []int32) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrSVI64(a int64, b []int64) (err error) {
+	var errs errorIndices
+	for i := range b {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			b[i] = 0
+			continue
+		}
+		b[i] = a >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrSVU(a uint, b []uint) {
+	for i := range b {
+		b[i] = a >> b[i]
+	}
+}
+
+func ShrSVU8(a uint8, b []uint8) {
+	for i := range b {
+		b[i] = a >> b[i]
+	}
+}
+
+func ShrSVU16(a uint16, b []uint16) {
+	for i := range b {
+		b[i] = a >> b[i]
+	}
+}
+
+func ShrSVU32(a uint32, b []uint32) {
+	for i := range b {
+		b[i] = a >> b[i]
+	}
+}
+
+func ShrSVU64(a uint64, b []uint64) {
+	for i := range b {
+		b[i] = a >> b[i]
+	}
+}
+
+func AndIterSVB(a bool, b []bool, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a && b[i]
+		}
+	}
+	return
+}
+
+func OrIterSVB(a bool, b []bool, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a || b[i]
+		}
+	}
+	return
+}
+
+func XorIterSVB(a bool, b []bool, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a != b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVI(a int, b []int, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVI8(a int8, b []int8, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVI16(a int16, b []int16, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVI32(a int32, b []int32, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVI64(a int64, b []int64, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVU(a uint, b []uint, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVU8(a uint8, b []uint8, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVU16(a uint16, b []uint16, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVU32(a uint32, b []uint32, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitAndIterSVU64(a uint64, b []uint64, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a & b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVI(a int, b []int, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVI8(a int8, b []int8, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVI16(a int16, b []int16, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVI32(a int32, b []int32, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVI64(a int64, b []int64, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVU(a uint, b []uint, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVU8(a uint8, b []uint8, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVU16(a uint16, b []uint16, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVU32(a uint32, b []uint32, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitOrIterSVU64(a uint64, b []uint64, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a | b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVI(a int, b []int, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVI8(a int8, b []int8, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVI16(a int16, b []int16, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVI32(a int32, b []int32, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVI64(a int64, b []int64, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVU(a uint, b []uint, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVU8(a uint8, b []uint8, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVU16(a uint16, b []uint16, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVU32(a uint32, b []uint32, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func BitXorIterSVU64(a uint64, b []uint64, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a ^ b[i]
+		}
+	}
+	return
+}
+
+func ShlIterSVI(a int, b []int, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a << b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterSVI8(a int8, b []int8, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a << b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterSVI16(a int16, b []int16, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a << b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterSVI32(a int32, b []int32, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a << b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterSVI64(a int64, b []int64, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a << b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterSVU(a uint, b []uint, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a << b[i]
+		}
+	}
+	return
+}
+
+func ShlIterSVU8(a uint8, b []uint8, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a << b[i]
+		}
+	}
+	return
+}
+
+func ShlIterSVU16(a uint16, b []uint16, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a << b[i]
+		}
+	}
+	return
+}
+
+func ShlIterSVU32(a uint32, b []uint32, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a << b[i]
+		}
+	}
+	return
+}
+
+func ShlIterSVU64(a uint64, b []uint64, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a << b[i]
+		}
+	}
+	return
+}
+
+func ShrIterSVI(a int, b []int, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a >> b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterSVI8(a int8, b []int8, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a >> b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterSVI16(a int16, b []int16, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a >> b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterSVI32(a int32, b []int32, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a >> b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterSVI64(a int64, b []int64, bit Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b[i] < 0 {
+				errs = append(errs, i)
+				b[i] = 0
+				continue
+			}
+			b[i] = a >> b[i]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterSVU(a uint, b []uint, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a >> b[i]
+		}
+	}
+	return
+}
+
+func ShrIterSVU8(a uint8, b []uint8, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a >> b[i]
+		}
+	}
+	return
+}
+
+func ShrIterSVU16(a uint16, b []uint16, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a >> b[i]
+		}
+	}
+	return
+}
+
+func ShrIterSVU32(a uint32, b []uint32, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a >> b[i]
+		}
+	}
+	return
+}
+
+func ShrIterSVU64(a uint64, b []uint64, bit Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			b[i] = a >> b[i]
+		}
+	}
+	return
+}
+
+func AndVSB(a []bool, b bool) {
+	for i := range a {
+		a[i] = a[i] && b
+	}
+}
+
+func OrVSB(a []bool, b bool) {
+	for i := range a {
+		a[i] = a[i] || b
+	}
+}
+
+func XorVSB(a []bool, b bool) {
+	for i := range a {
+		a[i] = a[i] != b
+	}
+}
+
+func BitAndVSI(a []int, b int) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSI8(a []int8, b int8) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSI16(a []int16, b int16) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSI32(a []int32, b int32) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSI64(a []int64, b int64) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSU(a []uint, b uint) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSU8(a []uint8, b uint8) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSU16(a []uint16, b uint16) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSU32(a []uint32, b uint32) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitAndVSU64(a []uint64, b uint64) {
+	for i := range a {
+		a[i] = a[i] & b
+	}
+}
+
+func BitOrVSI(a []int, b int) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSI8(a []int8, b int8) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSI16(a []int16, b int16) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSI32(a []int32, b int32) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSI64(a []int64, b int64) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSU(a []uint, b uint) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSU8(a []uint8, b uint8) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSU16(a []uint16, b uint16) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSU32(a []uint32, b uint32) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitOrVSU64(a []uint64, b uint64) {
+	for i := range a {
+		a[i] = a[i] | b
+	}
+}
+
+func BitXorVSI(a []int, b int) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSI8(a []int8, b int8) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSI16(a []int16, b int16) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSI32(a []int32, b int32) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSI64(a []int64, b int64) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSU(a []uint, b uint) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSU8(a []uint8, b uint8) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSU16(a []uint16, b uint16) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSU32(a []uint32, b uint32) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func BitXorVSU64(a []uint64, b uint64) {
+	for i := range a {
+		a[i] = a[i] ^ b
+	}
+}
+
+func ShlVSI(a []int, b int) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlVSI8(a []int8, b int8) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlVSI16(a []int16, b int16) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlVSI32(a []int32, b int32) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlVSI64(a []int64, b int64) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlVSU(a []uint, b uint) {
+	for i := range a {
+		a[i] = a[i] << b
+	}
+}
+
+func ShlVSU8(a []uint8, b uint8) {
+	for i := range a {
+		a[i] = a[i] << b
+	}
+}
+
+func ShlVSU16(a []uint16, b uint16) {
+	for i := range a {
+		a[i] = a[i] << b
+	}
+}
+
+func ShlVSU32(a []uint32, b uint32) {
+	for i := range a {
+		a[i] = a[i] << b
+	}
+}
+
+func ShlVSU64(a []uint64, b uint64) {
+	for i := range a {
+		a[i] = a[i] << b
+	}
+}
+
+func ShrVSI(a []int, b int) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrVSI8(a []int8, b int8) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrVSI16(a []int16, b int16) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrVSI32(a []int32, b int32) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrVSI64(a []int64, b int64) (err error) {
+	var errs errorIndices
+	for i := range a {
+		if b < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrVSU(a []uint, b uint) {
+	for i := range a {
+		a[i] = a[i] >> b
+	}
+}
+
+func ShrVSU8(a []uint8, b uint8) {
+	for i := range a {
+		a[i] = a[i] >> b
+	}
+}
+
+func ShrVSU16(a []uint16, b uint16) {
+	for i := range a {
+		a[i] = a[i] >> b
+	}
+}
+
+func ShrVSU32(a []uint32, b uint32) {
+	for i := range a {
+		a[i] = a[i] >> b
+	}
+}
+
+func ShrVSU64(a []uint64, b uint64) {
+	for i := range a {
+		a[i] = a[i] >> b
+	}
+}
+
+func AndIterVSB(a []bool, b bool, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] && b
+		}
+	}
+	return
+}
+
+func OrIterVSB(a []bool, b bool, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] || b
+		}
+	}
+	return
+}
+
+func XorIterVSB(a []bool, b bool, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] != b
+		}
+	}
+	return
+}
+
+func BitAndIterVSI(a []int, b int, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSI8(a []int8, b int8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSI16(a []int16, b int16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSI32(a []int32, b int32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSI64(a []int64, b int64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSU(a []uint, b uint, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSU8(a []uint8, b uint8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSU16(a []uint16, b uint16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSU32(a []uint32, b uint32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitAndIterVSU64(a []uint64, b uint64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] & b
+		}
+	}
+	return
+}
+
+func BitOrIterVSI(a []int, b int, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSI8(a []int8, b int8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSI16(a []int16, b int16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSI32(a []int32, b int32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSI64(a []int64, b int64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSU(a []uint, b uint, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSU8(a []uint8, b uint8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSU16(a []uint16, b uint16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSU32(a []uint32, b uint32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitOrIterVSU64(a []uint64, b uint64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] | b
+		}
+	}
+	return
+}
+
+func BitXorIterVSI(a []int, b int, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSI8(a []int8, b int8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSI16(a []int16, b int16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSI32(a []int32, b int32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSI64(a []int64, b int64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSU(a []uint, b uint, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSU8(a []uint8, b uint8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSU16(a []uint16, b uint16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSU32(a []uint32, b uint32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func BitXorIterVSU64(a []uint64, b uint64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] ^ b
+		}
+	}
+	return
+}
+
+func ShlIterVSI(a []int, b int, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterVSI8(a []int8, b int8, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterVSI16(a []int16, b int16, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterVSI32(a []int32, b int32, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterVSI64(a []int64, b int64, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterVSU(a []uint, b uint, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] << b
+		}
+	}
+	return
+}
+
+func ShlIterVSU8(a []uint8, b uint8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] << b
+		}
+	}
+	return
+}
+
+func ShlIterVSU16(a []uint16, b uint16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] << b
+		}
+	}
+	return
+}
+
+func ShlIterVSU32(a []uint32, b uint32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] << b
+		}
+	}
+	return
+}
+
+func ShlIterVSU64(a []uint64, b uint64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] << b
+		}
+	}
+	return
+}
+
+func ShrIterVSI(a []int, b int, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterVSI8(a []int8, b int8, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterVSI16(a []int16, b int16, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterVSI32(a []int32, b int32, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterVSI64(a []int64, b int64, ait Iterator) (err error) {
+	var errs errorIndices
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			if b < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterVSU(a []uint, b uint, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] >> b
+		}
+	}
+	return
+}
+
+func ShrIterVSU8(a []uint8, b uint8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] >> b
+		}
+	}
+	return
+}
+
+func ShrIterVSU16(a []uint16, b uint16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] >> b
+		}
+	}
+	return
+}
+
+func ShrIterVSU32(a []uint32, b uint32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] >> b
+		}
+	}
+	return
+}
+
+func ShrIterVSU64(a []uint64, b uint64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = a[i] >> b
+		}
+	}
+	return
+}
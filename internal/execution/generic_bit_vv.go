@@ -0,0 +1,2238 @@
+// Code generated by genlib2. DO NOT EDIT.
+
+package execution
+
+func VecAndB(a []bool, b []bool) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] && b[i]
+	}
+}
+
+func VecOrB(a []bool, b []bool) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] || b[i]
+	}
+}
+
+func VecXorB(a []bool, b []bool) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] != b[i]
+	}
+}
+
+func VecBitAndI(a []int, b []int) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndI8(a []int8, b []int8) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndI16(a []int16, b []int16) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndI32(a []int32, b []int32) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndI64(a []int64, b []int64) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndU(a []uint, b []uint) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndU8(a []uint8, b []uint8) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndU16(a []uint16, b []uint16) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndU32(a []uint32, b []uint32) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitAndU64(a []uint64, b []uint64) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] & b[i]
+	}
+}
+
+func VecBitOrI(a []int, b []int) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrI8(a []int8, b []int8) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrI16(a []int16, b []int16) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrI32(a []int32, b []int32) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrI64(a []int64, b []int64) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrU(a []uint, b []uint) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrU8(a []uint8, b []uint8) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrU16(a []uint16, b []uint16) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrU32(a []uint32, b []uint32) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitOrU64(a []uint64, b []uint64) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] | b[i]
+	}
+}
+
+func VecBitXorI(a []int, b []int) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorI8(a []int8, b []int8) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorI16(a []int16, b []int16) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorI32(a []int32, b []int32) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorI64(a []int64, b []int64) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorU(a []uint, b []uint) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorU8(a []uint8, b []uint8) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorU16(a []uint16, b []uint16) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorU32(a []uint32, b []uint32) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecBitXorU64(a []uint64, b []uint64) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] ^ b[i]
+	}
+}
+
+func VecShlI(a []int, b []int) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShlI8(a []int8, b []int8) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShlI16(a []int16, b []int16) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShlI32(a []int32, b []int32) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShlI64(a []int64, b []int64) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShlU(a []uint, b []uint) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] << b[i]
+	}
+}
+
+func VecShlU8(a []uint8, b []uint8) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] << b[i]
+	}
+}
+
+func VecShlU16(a []uint16, b []uint16) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] << b[i]
+	}
+}
+
+func VecShlU32(a []uint32, b []uint32) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] << b[i]
+	}
+}
+
+func VecShlU64(a []uint64, b []uint64) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] << b[i]
+	}
+}
+
+func VecShrI(a []int, b []int) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShrI8(a []int8, b []int8) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShrI16(a []int16, b []int16) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShrI32(a []int32, b []int32) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShrI64(a []int64, b []int64) (err error) {
+	a = a[:]
+	b = b[:len(a)]
+	var errs errorIndices
+	for i := range a {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			a[i] = 0
+			continue
+		}
+		a[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func VecShrU(a []uint, b []uint) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] >> b[i]
+	}
+}
+
+func VecShrU8(a []uint8, b []uint8) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] >> b[i]
+	}
+}
+
+func VecShrU16(a []uint16, b []uint16) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] >> b[i]
+	}
+}
+
+func VecShrU32(a []uint32, b []uint32) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] >> b[i]
+	}
+}
+
+func VecShrU64(a []uint64, b []uint64) {
+	a = a[:]
+	b = b[:len(a)]
+	for i := range a {
+		a[i] = a[i] >> b[i]
+	}
+}
+
+func AndIterB(a []bool, b []bool, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] && b[j]
+		}
+	}
+	return
+}
+
+func OrIterB(a []bool, b []bool, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] || b[j]
+		}
+	}
+	return
+}
+
+func XorIterB(a []bool, b []bool, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] != b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterI(a []int, b []int, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterI8(a []int8, b []int8, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterI16(a []int16, b []int16, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterI32(a []int32, b []int32, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterI64(a []int64, b []int64, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterU(a []uint, b []uint, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterU8(a []uint8, b []uint8, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterU16(a []uint16, b []uint16, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterU32(a []uint32, b []uint32, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitAndIterU64(a []uint64, b []uint64, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] & b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterI(a []int, b []int, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterI8(a []int8, b []int8, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterI16(a []int16, b []int16, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterI32(a []int32, b []int32, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterI64(a []int64, b []int64, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterU(a []uint, b []uint, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterU8(a []uint8, b []uint8, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterU16(a []uint16, b []uint16, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterU32(a []uint32, b []uint32, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitOrIterU64(a []uint64, b []uint64, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] | b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterI(a []int, b []int, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterI8(a []int8, b []int8, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterI16(a []int16, b []int16, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterI32(a []int32, b []int32, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterI64(a []int64, b []int64, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterU(a []uint, b []uint, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterU8(a []uint8, b []uint8, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterU16(a []uint16, b []uint16, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterU32(a []uint32, b []uint32, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func BitXorIterU64(a []uint64, b []uint64, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] ^ b[j]
+		}
+	}
+	return
+}
+
+func ShlIterI(a []int, b []int, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterI8(a []int8, b []int8, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterI16(a []int16, b []int16, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterI32(a []int32, b []int32, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterI64(a []int64, b []int64, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] << b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlIterU(a []uint, b []uint, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] << b[j]
+		}
+	}
+	return
+}
+
+func ShlIterU8(a []uint8, b []uint8, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] << b[j]
+		}
+	}
+	return
+}
+
+func ShlIterU16(a []uint16, b []uint16, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] << b[j]
+		}
+	}
+	return
+}
+
+func ShlIterU32(a []uint32, b []uint32, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] << b[j]
+		}
+	}
+	return
+}
+
+func ShlIterU64(a []uint64, b []uint64, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] << b[j]
+		}
+	}
+	return
+}
+
+func ShrIterI(a []int, b []int, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterI8(a []int8, b []int8, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterI16(a []int16, b []int16, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterI32(a []int32, b []int32, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterI64(a []int64, b []int64, ait Iterator, bit Iterator) (err error) {
+	var errs errorIndices
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			if b[j] < 0 {
+				errs = append(errs, i)
+				a[i] = 0
+				continue
+			}
+			a[i] = a[i] >> b[j]
+		}
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrIterU(a []uint, b []uint, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] >> b[j]
+		}
+	}
+	return
+}
+
+func ShrIterU8(a []uint8, b []uint8, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] >> b[j]
+		}
+	}
+	return
+}
+
+func ShrIterU16(a []uint16, b []uint16, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] >> b[j]
+		}
+	}
+	return
+}
+
+func ShrIterU32(a []uint32, b []uint32, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] >> b[j]
+		}
+	}
+	return
+}
+
+func ShrIterU64(a []uint64, b []uint64, ait Iterator, bit Iterator) (err error) {
+	var i, j int
+	var validi, validj bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if j, validj, err = bit.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi && validj {
+			a[i] = a[i] >> b[j]
+		}
+	}
+	return
+}
+
+func AndRecvB(a []bool, b []bool, recv []bool) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] && b[i]
+	}
+}
+
+func OrRecvB(a []bool, b []bool, recv []bool) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] || b[i]
+	}
+}
+
+func XorRecvB(a []bool, b []bool, recv []bool) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] != b[i]
+	}
+}
+
+func BitAndRecvI(a []int, b []int, recv []int) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvI8(a []int8, b []int8, recv []int8) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvI16(a []int16, b []int16, recv []int16) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvI32(a []int32, b []int32, recv []int32) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvI64(a []int64, b []int64, recv []int64) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvU(a []uint, b []uint, recv []uint) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvU8(a []uint8, b []uint8, recv []uint8) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvU16(a []uint16, b []uint16, recv []uint16) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvU32(a []uint32, b []uint32, recv []uint32) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitAndRecvU64(a []uint64, b []uint64, recv []uint64) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] & b[i]
+	}
+}
+
+func BitOrRecvI(a []int, b []int, recv []int) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvI8(a []int8, b []int8, recv []int8) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvI16(a []int16, b []int16, recv []int16) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvI32(a []int32, b []int32, recv []int32) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvI64(a []int64, b []int64, recv []int64) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvU(a []uint, b []uint, recv []uint) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvU8(a []uint8, b []uint8, recv []uint8) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvU16(a []uint16, b []uint16, recv []uint16) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvU32(a []uint32, b []uint32, recv []uint32) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitOrRecvU64(a []uint64, b []uint64, recv []uint64) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] | b[i]
+	}
+}
+
+func BitXorRecvI(a []int, b []int, recv []int) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvI8(a []int8, b []int8, recv []int8) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvI16(a []int16, b []int16, recv []int16) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvI32(a []int32, b []int32, recv []int32) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvI64(a []int64, b []int64, recv []int64) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvU(a []uint, b []uint, recv []uint) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvU8(a []uint8, b []uint8, recv []uint8) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvU16(a []uint16, b []uint16, recv []uint16) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvU32(a []uint32, b []uint32, recv []uint32) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func BitXorRecvU64(a []uint64, b []uint64, recv []uint64) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] ^ b[i]
+	}
+}
+
+func ShlRecvI(a []int, b []int, recv []int) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlRecvI8(a []int8, b []int8, recv []int8) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlRecvI16(a []int16, b []int16, recv []int16) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlRecvI32(a []int32, b []int32, recv []int32) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlRecvI64(a []int64, b []int64, recv []int64) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] << b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShlRecvU(a []uint, b []uint, recv []uint) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] << b[i]
+	}
+}
+
+func ShlRecvU8(a []uint8, b []uint8, recv []uint8) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] << b[i]
+	}
+}
+
+func ShlRecvU16(a []uint16, b []uint16, recv []uint16) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] << b[i]
+	}
+}
+
+func ShlRecvU32(a []uint32, b []uint32, recv []uint32) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] << b[i]
+	}
+}
+
+func ShlRecvU64(a []uint64, b []uint64, recv []uint64) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] << b[i]
+	}
+}
+
+func ShrRecvI(a []int, b []int, recv []int) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrRecvI8(a []int8, b []int8, recv []int8) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrRecvI16(a []int16, b []int16, recv []int16) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrRecvI32(a []int32, b []int32, recv []int32) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrRecvI64(a []int64, b []int64, recv []int64) (err error) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	var errs errorIndices
+	for i := range recv {
+		if b[i] < 0 {
+			errs = append(errs, i)
+			recv[i] = 0
+			continue
+		}
+		recv[i] = a[i] >> b[i]
+	}
+	if err != nil {
+		return
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ShrRecvU(a []uint, b []uint, recv []uint) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] >> b[i]
+	}
+}
+
+func ShrRecvU8(a []uint8, b []uint8, recv []uint8) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] >> b[i]
+	}
+}
+
+func ShrRecvU16(a []uint16, b []uint16, recv []uint16) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] >> b[i]
+	}
+}
+
+func ShrRecvU32(a []uint32, b []uint32, recv []uint32) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] >> b[i]
+	}
+}
+
+func ShrRecvU64(a []uint64, b []uint64, recv []uint64) {
+	a = a[:len(recv)]
+	b = b[:len(recv)]
+	for i := range recv {
+		recv[i] = a[i] >> b[i]
+	}
+}
@@ -501,6 +501,72 @@ func InvSqrtF64(a []float64) {
 	}
 }
 
+func NotB(a []bool) {
+	for i := range a {
+		a[i] = !a[i]
+	}
+}
+
+func BitNotI(a []int) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotI8(a []int8) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotI16(a []int16) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotI32(a []int32) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotI64(a []int64) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotU(a []uint) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotU8(a []uint8) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotU16(a []uint16) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotU32(a []uint32) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
+func BitNotU64(a []uint64) {
+	for i := range a {
+		a[i] = ^a[i]
+	}
+}
+
 func NegIterI(a []int, ait Iterator) (err error) {
 	var i int
 	var validi bool
@@ -1731,6 +1797,171 @@ func InvSqrtIterF64(a []float64, ait Iterator) (err error) {
 	return
 }
 
+func NotIterB(a []bool, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = !a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterI(a []int, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterI8(a []int8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterI16(a []int16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterI32(a []int32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterI64(a []int64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterU(a []uint, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterU8(a []uint8, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterU16(a []uint16, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterU32(a []uint32, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
+func BitNotIterU64(a []uint64, ait Iterator) (err error) {
+	var i int
+	var validi bool
+	for {
+		if i, validi, err = ait.NextValidity(); err != nil {
+			err = handleNoOp(err)
+			break
+		}
+		if validi {
+			a[i] = ^a[i]
+		}
+	}
+	return
+}
+
 func AbsI(a []int) {
 	for i := range a {
 		if a[i] < 0 {
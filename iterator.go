@@ -116,7 +116,8 @@ func iteratorLoadAP(it Iterator, ap *AP) {
 // FlatIterator is an iterator that iterates over Tensors according to the data's layout.
 // It utilizes the *AP of a Tensor to determine what the next index is.
 // This data structure is similar to Numpy's flatiter, with some standard Go based restrictions of course
-// (such as, not allowing negative indices)
+// (such as, not allowing negative indices). Negative strides are allowed: the iteration then starts
+// at the origin of the AP rather than at the start of the data.
 type FlatIterator struct {
 	*AP
 
@@ -150,6 +151,7 @@ func newFlatIterator(ap *AP) *FlatIterator {
 	return &FlatIterator{
 		AP:         ap,
 		track:      make([]int, len(ap.shape)),
+		nextIndex:  ap.origin(),
 		size:       ap.shape.TotalSize(),
 		veclikeDim: dim,
 
@@ -414,11 +416,6 @@ func (it *FlatIterator) Slice(sli Slice) (retVal []int, err error) {
 	end := sli.End()
 	step := sli.Step()
 
-	// sanity checks
-	if err = CheckSlice(sli, len(nexts)); err != nil {
-		return
-	}
-
 	if step < 0 {
 		// reverse the nexts
 		for i := len(nexts)/2 - 1; i >= 0; i-- {
@@ -428,6 +425,14 @@ func (it *FlatIterator) Slice(sli Slice) (retVal []int, err error) {
 		step = -step
 	}
 
+	// sanity checks. A negative step slices the reversed indices, so it is checked as a positive one.
+	if start, end, step, err = SliceDetails(makeRS(start, end, step), len(nexts)); err != nil {
+		return
+	}
+	if step == 0 {
+		step = 1 // a single index
+	}
+
 	// cleanup before loop
 	if end > len(nexts) {
 		end = len(nexts)
@@ -460,13 +465,13 @@ func (it *FlatIterator) Reset() {
 		// case it.IsColVec():
 		// 	it.nextIndex = (it.shape[0] - 1) * it.strides[0]
 		default:
-			it.nextIndex = 0
+			it.nextIndex = it.origin()
 			for i := range it.track {
 				it.nextIndex += (it.shape[i] - 1) * it.strides[i]
 			}
 		}
 	} else {
-		it.nextIndex = 0
+		it.nextIndex = it.origin()
 		for i := range it.track {
 			it.track[i] = 0
 		}
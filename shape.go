@@ -220,58 +220,18 @@ func (s Shape) DimSize(d int) (size int, err error) {
 	}
 }
 
-// S gives the new shape after a shape has been sliced. It's repeated from the AP S() method mainly because there are other functions in Gorgonia that uses only shape
+// S gives the new shape after a shape has been sliced. It uses the AP S() method on the shape laid out in row major order,
+// because there are other functions in Gorgonia that uses only shape
 func (s Shape) S(slices ...Slice) (retVal Shape, err error) {
-	opDims := len(s)
-	if len(slices) > opDims {
-		err = errors.Errorf(dimMismatch, opDims, len(slices))
-		return
+	ap := MakeAP(s.Clone(), s.CalcStrides(), 0, 0)
+	var sliced AP
+	if sliced, _, _, err = ap.S(s.TotalSize(), slices...); err != nil {
+		return nil, err
 	}
-
-	retVal = s.Clone()
-
-	for d, size := range s {
-		var sl Slice // default is a nil Slice
-		if d <= len(slices)-1 {
-			sl = slices[d]
-		}
-
-		var start, end, step int
-		if start, end, step, err = SliceDetails(sl, size); err != nil {
-			return
-		}
-
-		if step > 0 {
-			retVal[d] = (end - start) / step
-
-			//fix
-			if retVal[d] <= 0 {
-				retVal[d] = 1
-			}
-		} else {
-			retVal[d] = (end - start)
-		}
-
-	}
-
-	// drop any dimension with size 1, except the last dimension
-	offset := 0
-	dims := s.Dims()
-	for d := 0; d < dims; d++ {
-		if retVal[d] == 1 && offset+d <= len(slices)-1 && slices[offset+d] != nil /*&& d != t.dims-1  && dims > 2*/ {
-			retVal = append(retVal[:d], retVal[d+1:]...)
-			d--
-			dims--
-			offset++
-		}
-	}
-
-	if retVal.IsScalar() {
-		ReturnInts(retVal)
+	if sliced.IsScalar() {
 		return ScalarShape(), nil
 	}
-
-	return
+	return sliced.Shape().Clone(), nil
 }
 
 // Repeat returns the expected new shape given the repetition parameters.
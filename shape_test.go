@@ -214,7 +214,12 @@ var shapeSliceTests = []struct {
 	{"vec[0]", Shape{2}, []Slice{rs{0, 1, 0}}, ScalarShape(), false},
 	{"vec[3]", Shape{2}, []Slice{rs{3, 4, 0}}, nil, true},
 	{"vec[:, 0]", Shape{2}, []Slice{nil, rs{0, 1, 0}}, nil, true},
-	{"vec[1:4:2]", Shape{5}, []Slice{rs{1, 4, 2}}, ScalarShape(), false},
+	{"vec[1:4:2]", Shape{5}, []Slice{rs{1, 4, 2}}, Shape{2}, false},
+	{"vec[0:5:2]", Shape{5}, []Slice{rs{0, 5, 2}}, Shape{3}, false},
+	{"vec[::-1]", Shape{5}, []Slice{S(Open, Open, -1)}, Shape{5}, false},
+	{"mat[np.newaxis, ...]", Shape{2, 3}, []Slice{NewAxis, Ellipsis}, Shape{1, 2, 3}, false},
+	{"mat[..., ::-1]", Shape{2, 3}, []Slice{Ellipsis, S(Open, Open, -1)}, Shape{2, 3}, false},
+	{"mat[..., 0]", Shape{2, 3}, []Slice{Ellipsis, S(0)}, Shape{2}, false},
 	{"tensor[0, :, :]", Shape{1, 2, 2}, []Slice{rs{0, 1, 1}, nil, nil}, Shape{2, 2}, false},
 	{"tensor[:, 0, :]", Shape{1, 2, 2}, []Slice{nil, rs{0, 1, 1}, nil}, Shape{1, 2}, false},
 	{"tensor[0, :, :, :]", Shape{1, 1, 2, 2}, []Slice{rs{0, 1, 1}, nil, nil, nil}, Shape{1, 2, 2}, false},
@@ -1,5 +1,11 @@
 package tensor
 
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
 // A Slice represents a slicing operation for a Tensor.
 type Slice interface {
 	Start() int
@@ -45,6 +51,13 @@ type sli struct {
 // step is optional. It should be passed in as the second param of the optionals.
 //
 // Default end is start+1. Default step is 1, unless end == step+1, then it defaults to 0
+//
+// Like in Python, a negative start or end counts from the end of the axis, and a negative step walks the axis backwards,
+// creating a view with negative strides. Open may be passed as the start or the end to leave that side of the range open:
+//
+//	S(-3, Open)       // a[-3:]
+//	S(Open, Open, -1) // a[::-1]
+//	S(4, Open, -2)    // a[4::-2]
 func S(start int, opt ...int) Slice {
 	var end, step int
 	if len(opt) > 0 {
@@ -70,3 +83,65 @@ func S(start int, opt ...int) Slice {
 func (s *sli) Start() int { return s.start }
 func (s *sli) End() int   { return s.end }
 func (s *sli) Step() int  { return s.step }
+
+// Open may be passed to S as the start or the end of a range, to leave that side open, like an omitted bound in Python's a[start:end:step].
+const Open = math.MinInt
+
+// NewAxis is a Slice that inserts a new axis of size 1 into the view, like None (or np.newaxis) in Numpy.
+var NewAxis Slice = newAxis{}
+
+// Ellipsis is a Slice that stands for as many full slices as are needed to slice all the axes, like ... in Numpy.
+// There can be at most one Ellipsis in a list of slices.
+var Ellipsis Slice = ellipsis{}
+
+type newAxis struct{}
+
+func (newAxis) Start() int { return 0 }
+func (newAxis) End() int   { return 1 }
+func (newAxis) Step() int  { return 0 }
+
+type ellipsis struct{}
+
+func (ellipsis) Start() int { return 0 }
+func (ellipsis) End() int   { return 0 }
+func (ellipsis) Step() int  { return 0 }
+
+// expandSlices replaces the Ellipsis in slices (if any) by full slices, and takes out the NewAxis entries.
+// newAxes[i] holds the number of new axes to insert before the i-th axis of the result, with the last entry counting the trailing ones.
+func expandSlices(slices []Slice, dims int) (retVal []Slice, newAxes []int, err error) {
+	var n, ellipses int
+	for _, s := range slices {
+		switch s.(type) {
+		case newAxis:
+			n++
+		case ellipsis:
+			ellipses++
+		}
+	}
+	if n == 0 && ellipses == 0 {
+		return slices, nil, nil
+	}
+	if ellipses > 1 {
+		return nil, nil, errors.New("Slices can only have one Ellipsis")
+	}
+
+	fill := dims - (len(slices) - n - ellipses)
+	if fill < 0 {
+		return nil, nil, errors.Errorf(dimMismatch, dims, len(slices)-n-ellipses)
+	}
+	retVal = make([]Slice, 0, dims)
+	newAxes = make([]int, dims+1)
+	for _, s := range slices {
+		switch s.(type) {
+		case newAxis:
+			newAxes[len(retVal)]++
+		case ellipsis:
+			for i := 0; i < fill; i++ {
+				retVal = append(retVal, nil)
+			}
+		default:
+			retVal = append(retVal, s)
+		}
+	}
+	return retVal, newAxes, nil
+}
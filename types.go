@@ -190,6 +190,18 @@ var eqTypes = &typeclass{
 	},
 }
 
+var boolTypes = &typeclass{
+	name: "Bool",
+	set:  []Dtype{Bool},
+}
+
+var integerTypes = &typeclass{
+	name: "Integer",
+	set: []Dtype{
+		Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64,
+	},
+}
+
 var unsignedTypes = &typeclass{
 	name: "Unsigned",
 	set:  []Dtype{Uint, Uint8, Uint16, Uint32, Uint64},
@@ -199,49 +199,101 @@ func UnsafePermute(pattern []int, xs ...[]int) (err error) {
 
 // CheckSlice checks a slice to see if it's sane
 func CheckSlice(s Slice, size int) error {
-	start := s.Start()
-	end := s.End()
-	step := s.Step()
-
-	if start > end {
-		return errors.Errorf(invalidSliceIndex, start, end)
-	}
-
-	if start < 0 {
-		return errors.Errorf(invalidSliceIndex, start, 0)
-	}
-
-	if step == 0 && end-start > 1 {
-		return errors.Errorf("Slice has 0 steps. Start is %d and end is %d", start, end)
-	}
-
-	if start >= size {
-		return errors.Errorf("Start %d is greater than size %d", start, size)
-	}
-
-	return nil
+	_, _, _, err := resolveSlice(s, size)
+	return err
 }
 
 // SliceDetails is a function that takes a slice and spits out its details. The whole reason for this is to handle the nil Slice, which is this: a[:]
+//
+// Negative starts and ends are resolved, as are Open ones. For negative steps, end may be -1, meaning that the slice runs to the start of the axis.
 func SliceDetails(s Slice, size int) (start, end, step int, err error) {
 	if s == nil {
 		start = 0
 		end = size
 		step = 1
 	} else {
-		if err = CheckSlice(s, size); err != nil {
-			return
-		}
+		start, end, step, err = resolveSlice(s, size)
+	}
+	return
+}
 
-		start = s.Start()
-		end = s.End()
-		step = s.Step()
+// resolveSlice checks a slice, and resolves its negative and open starts and ends.
+func resolveSlice(s Slice, size int) (start, end, step int, err error) {
+	start = s.Start()
+	end = s.End()
+	step = s.Step()
 
+	switch {
+	case step == 0:
+		if start > end {
+			return 0, 0, 0, errors.Errorf(invalidSliceIndex, start, end)
+		}
+		if end-start > 1 {
+			return 0, 0, 0, errors.Errorf("Slice has 0 steps. Start is %d and end is %d", start, end)
+		}
+		if start < 0 && start != Open {
+			start += size
+		}
+		end = start + 1
+	case step > 0:
+		switch {
+		case start == Open:
+			start = 0
+		case start < 0:
+			start += size
+		}
+		switch {
+		case end == Open:
+			end = size
+		case end < 0:
+			end += size
+		}
 		if end > size {
 			end = size
 		}
+		if start > end {
+			return 0, 0, 0, errors.Errorf(invalidSliceIndex, s.Start(), s.End())
+		}
+	default:
+		// negative steps run from start down to, but excluding, end
+		switch {
+		case start == Open:
+			start = size - 1
+		case start < 0:
+			start += size
+		}
+		switch {
+		case end == Open:
+			end = -1
+		case end < 0:
+			end += size
+		}
+		if end < -1 {
+			end = -1
+		}
+		if end >= start {
+			return 0, 0, 0, errors.Errorf(invalidSliceIndex, s.Start(), s.End())
+		}
 	}
-	return
+
+	if start < 0 {
+		return 0, 0, 0, errors.Errorf(invalidSliceIndex, s.Start(), 0)
+	}
+	if start >= size {
+		return 0, 0, 0, errors.Errorf("Start %d is greater than size %d", s.Start(), size)
+	}
+	return start, end, step, nil
+}
+
+// sliceLen returns the number of elements that a resolved slice takes.
+func sliceLen(start, end, step int) int {
+	switch {
+	case step > 0:
+		return (end - start + step - 1) / step
+	case step < 0:
+		return (start - end - step - 1) / -step
+	}
+	return end - start
 }
 
 // reuseDenseCheck checks a reuse tensor, and reshapes it to be the correct one
@@ -291,6 +343,12 @@ func memsetBools(a []bool, v bool) {
 	}
 }
 
+func reverseInts(a []int) {
+	for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
+		a[i], a[j] = a[j], a[i]
+	}
+}
+
 func allones(a []int) bool {
 	for i := range a {
 		if a[i] != 1 {